@@ -0,0 +1,250 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"strings"
+)
+
+// runFetch dereferences an IRI and pretty-prints the response.
+func runFetch(c context.Context, cmd *CommandLineFlags, out io.Writer) error {
+	iri, err := parseIRI(cmd.args[0])
+	if err != nil {
+		return err
+	}
+	d, err := cmd.newDereferencer()
+	if err != nil {
+		return err
+	}
+	b, err := d.Dereference(c, iri)
+	if err != nil {
+		return err
+	}
+	var pretty bytes.Buffer
+	if err = json.Indent(&pretty, b, "", "  "); err != nil {
+		return fmt.Errorf("response of %s is not JSON: %s", iri, err)
+	}
+	pretty.WriteByte('\n')
+	_, err = pretty.WriteTo(out)
+	return err
+}
+
+// runWebFinger resolves a handle to the IRI of an actor.
+func runWebFinger(c context.Context, cmd *CommandLineFlags, out io.Writer) error {
+	handle := strings.TrimPrefix(strings.TrimPrefix(cmd.args[0], "acct:"), "@")
+	at := strings.LastIndex(handle, "@")
+	if at <= 0 || at == len(handle)-1 {
+		return fmt.Errorf("handle %q is not of the form user@host", cmd.args[0])
+	}
+	resolve := pub.NewWebFingerResolver(httpClient, cmd.agent)
+	iri, err := resolve(c, handle[:at], handle[at+1:])
+	if err != nil {
+		return err
+	} else if iri == nil {
+		return fmt.Errorf("WebFinger found no ActivityStreams actor for %s", handle)
+	}
+	_, err = fmt.Fprintln(out, iri)
+	return err
+}
+
+// runValidate checks that a file contains an ActivityStreams value.
+func runValidate(c context.Context, cmd *CommandLineFlags, out io.Writer) error {
+	t, _, err := readType(c, cmd.args[0])
+	if err != nil {
+		return err
+	}
+	id := "(no id)"
+	if iri, err := pub.GetId(t); err == nil {
+		id = iri.String()
+	}
+	_, err = fmt.Fprintf(out, "%s %s\n", t.GetTypeName(), id)
+	return err
+}
+
+// runDeliver signs and POSTs an activity to an inbox.
+func runDeliver(c context.Context, cmd *CommandLineFlags, out io.Writer) error {
+	t, b, err := readType(c, cmd.args[0])
+	if err != nil {
+		return err
+	}
+	if _, ok := t.(pub.Activity); !ok {
+		return fmt.Errorf("%s is a %s, which is not an activity", cmd.args[0], t.GetTypeName())
+	}
+	inbox, err := parseIRI(cmd.args[1])
+	if err != nil {
+		return err
+	}
+	tp, err := cmd.newTransport()
+	if err != nil {
+		return err
+	}
+	if err = tp.Deliver(c, b, inbox); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(out, "delivered %s to %s\n", t.GetTypeName(), inbox)
+	return err
+}
+
+// runWalk prints the ids of the items in a collection, following its pages.
+func runWalk(c context.Context, cmd *CommandLineFlags, out io.Writer) error {
+	iri, err := parseIRI(cmd.args[0])
+	if err != nil {
+		return err
+	}
+	d, err := cmd.newDereferencer()
+	if err != nil {
+		return err
+	}
+	t, err := dereferenceType(c, d, iri)
+	if err != nil {
+		return err
+	}
+	n := 0
+	visited := map[string]bool{iri.String(): true}
+	for t != nil {
+		items, page, err := collectionParts(t)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if cmd.limit > 0 && n >= cmd.limit {
+				return nil
+			}
+			id, err := pub.ToId(item)
+			if err != nil {
+				fmt.Fprintln(out, "(embedded value without id)")
+			} else {
+				fmt.Fprintln(out, id)
+			}
+			n++
+		}
+		t = nil
+		if page == nil {
+			break
+		} else if t = page.GetType(); t != nil {
+			continue
+		} else if !page.IsIRI() || visited[page.GetIRI().String()] {
+			break
+		}
+		visited[page.GetIRI().String()] = true
+		if t, err = dereferenceType(c, d, page.GetIRI()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectionParts obtains the items of a collection or collection page, and
+// the page to visit next.
+func collectionParts(t vocab.Type) (items []pub.IdProperty, page pub.IdProperty, err error) {
+	switch v := t.(type) {
+	case vocab.ActivityStreamsOrderedCollection:
+		items = orderedItems(v.GetActivityStreamsOrderedItems())
+		if p := v.GetActivityStreamsFirst(); p != nil {
+			page = p
+		}
+	case vocab.ActivityStreamsCollection:
+		items = unorderedItems(v.GetActivityStreamsItems())
+		if p := v.GetActivityStreamsFirst(); p != nil {
+			page = p
+		}
+	case vocab.ActivityStreamsOrderedCollectionPage:
+		items = orderedItems(v.GetActivityStreamsOrderedItems())
+		if p := v.GetActivityStreamsNext(); p != nil {
+			page = p
+		}
+	case vocab.ActivityStreamsCollectionPage:
+		items = unorderedItems(v.GetActivityStreamsItems())
+		if p := v.GetActivityStreamsNext(); p != nil {
+			page = p
+		}
+	default:
+		err = fmt.Errorf("%s is not a collection", t.GetTypeName())
+	}
+	return
+}
+
+// orderedItems lists the values of an 'orderedItems' property.
+func orderedItems(p vocab.ActivityStreamsOrderedItemsProperty) (items []pub.IdProperty) {
+	if p == nil {
+		return
+	}
+	for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+		items = append(items, iter)
+	}
+	return
+}
+
+// unorderedItems lists the values of an 'items' property.
+func unorderedItems(p vocab.ActivityStreamsItemsProperty) (items []pub.IdProperty) {
+	if p == nil {
+		return
+	}
+	for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+		items = append(items, iter)
+	}
+	return
+}
+
+// dereferenceType dereferences an IRI into an ActivityStreams value.
+func dereferenceType(c context.Context, d dereferencer, iri *url.URL) (vocab.Type, error) {
+	b, err := d.Dereference(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	t, err := toType(c, b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", iri, err)
+	}
+	return t, nil
+}
+
+// readType reads an ActivityStreams value from a file, returning both the
+// value and the raw bytes.
+func readType(c context.Context, file string) (vocab.Type, []byte, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	t, err := toType(c, b)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %s", file, err)
+	}
+	return t, b, nil
+}
+
+// toType deserializes JSON into an ActivityStreams value, tolerating the same
+// quirks of peers as the pub library does.
+func toType(c context.Context, b []byte) (vocab.Type, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("not a JSON object: %s", err)
+	}
+	pub.NormalizeJSON(m)
+	t, err := streams.ToType(c, m)
+	if streams.IsUnmatchedErr(err) {
+		return nil, fmt.Errorf("type %v is not an ActivityStreams type known to go-fed", m["type"])
+	} else if err != nil {
+		return nil, fmt.Errorf("not valid ActivityStreams: %s", err)
+	}
+	return t, nil
+}
+
+// parseIRI parses an absolute IRI.
+func parseIRI(s string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	} else if !u.IsAbs() || len(u.Host) == 0 {
+		return nil, fmt.Errorf("%q is not an absolute IRI", s)
+	}
+	return u, nil
+}
@@ -0,0 +1,354 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"github.com/go-fed/httpsig"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testKeyId = "https://example.com/users/me#main-key"
+	testNote  = `{"@context":"https://www.w3.org/ns/activitystreams","type":"Note","id":"https://example.com/notes/1","content":"hi"}`
+	testLike  = `{"@context":"https://www.w3.org/ns/activitystreams","type":"Like","id":"https://example.com/likes/1","actor":"https://example.com/users/me","object":"https://other.example.com/notes/1"}`
+)
+
+// testServer starts a server whose requests the commands send with the
+// server's client, and returns a function stopping it.
+func testServer(tls bool, h http.Handler) (*httptest.Server, func()) {
+	var srv *httptest.Server
+	if tls {
+		srv = httptest.NewTLSServer(h)
+	} else {
+		srv = httptest.NewServer(h)
+	}
+	old := httpClient
+	httpClient = srv.Client()
+	return srv, func() {
+		httpClient = old
+		srv.Close()
+	}
+}
+
+// testFiles writes files to a temporary directory, and returns the directory
+// along with a function removing it.
+func testFiles(t *testing.T, files map[string][]byte) (string, func()) {
+	dir, err := ioutil.TempDir("", "apcli")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, b := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), b, 0600); err != nil {
+			os.RemoveAll(dir)
+			t.Fatal(err)
+		}
+	}
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+// testKey generates an RSA key, returning it along with its PKCS#1 PEM
+// encoding.
+func testKey(t *testing.T) (*rsa.PrivateKey, []byte) {
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)})
+}
+
+// verifySignature checks that the request is signed by the key.
+func verifySignature(r *http.Request, k *rsa.PrivateKey) error {
+	v, err := httpsig.NewVerifier(r)
+	if err != nil {
+		return err
+	} else if v.KeyId() != testKeyId {
+		return fmt.Errorf("expected key id %q, got %q", testKeyId, v.KeyId())
+	}
+	return v.Verify(k.Public(), httpsig.RSA_SHA256)
+}
+
+func TestRunFetch(t *testing.T) {
+	t.Run("Unsigned", func(t *testing.T) {
+		srv, stop := testServer(false, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Accept") != acceptHeaderValue || r.Header.Get("User-Agent") != "apcli-test" {
+				http.Error(w, "unexpected headers", http.StatusBadRequest)
+				return
+			}
+			if len(r.Header.Get("Signature")) != 0 {
+				http.Error(w, "unexpected signature", http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"type":"Note","id":"https://example.com/notes/1"}`)
+		}))
+		defer stop()
+		cmd := &CommandLineFlags{agent: "apcli-test", args: []string{srv.URL + "/notes/1"}}
+		var out bytes.Buffer
+		if err := runFetch(context.Background(), cmd, &out); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		expected := "{\n  \"type\": \"Note\",\n  \"id\": \"https://example.com/notes/1\"\n}\n"
+		if out.String() != expected {
+			t.Fatalf("expected %q, got %q", expected, out.String())
+		}
+	})
+	t.Run("Signed", func(t *testing.T) {
+		k, p := testKey(t)
+		dir, cleanup := testFiles(t, map[string][]byte{"private.pem": p})
+		defer cleanup()
+		srv, stop := testServer(false, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := verifySignature(r, k); err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, testNote)
+		}))
+		defer stop()
+		cmd := &CommandLineFlags{
+			key:   filepath.Join(dir, "private.pem"),
+			keyId: testKeyId,
+			agent: "apcli-test",
+			args:  []string{srv.URL + "/notes/1"},
+		}
+		var out bytes.Buffer
+		if err := runFetch(context.Background(), cmd, &out); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !strings.Contains(out.String(), `"content": "hi"`) {
+			t.Fatalf("expected the pretty-printed note, got %q", out.String())
+		}
+	})
+	t.Run("ErrorStatus", func(t *testing.T) {
+		srv, stop := testServer(false, http.NotFoundHandler())
+		defer stop()
+		cmd := &CommandLineFlags{agent: "apcli-test", args: []string{srv.URL + "/notes/1"}}
+		if err := runFetch(context.Background(), cmd, ioutil.Discard); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("NotJSON", func(t *testing.T) {
+		srv, stop := testServer(false, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, "<html></html>")
+		}))
+		defer stop()
+		cmd := &CommandLineFlags{agent: "apcli-test", args: []string{srv.URL + "/notes/1"}}
+		if err := runFetch(context.Background(), cmd, ioutil.Discard); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("RelativeIRI", func(t *testing.T) {
+		cmd := &CommandLineFlags{agent: "apcli-test", args: []string{"/notes/1"}}
+		if err := runFetch(context.Background(), cmd, ioutil.Discard); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+}
+
+func TestRunWebFinger(t *testing.T) {
+	handler := func(links string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/.well-known/webfinger" || r.Header.Get("Accept") != "application/jrd+json" {
+				http.NotFound(w, r)
+				return
+			}
+			resource := r.URL.Query().Get("resource")
+			if !strings.HasPrefix(resource, "acct:alice@") {
+				http.NotFound(w, r)
+				return
+			}
+			fmt.Fprintf(w, `{"subject":%q,"links":%s}`, resource, links)
+		})
+	}
+	t.Run("ResolvesActor", func(t *testing.T) {
+		srv, stop := testServer(true, handler(`[
+			{"rel":"http://webfinger.net/rel/profile-page","type":"text/html","href":"https://example.com/@alice"},
+			{"rel":"self","type":"application/activity+json","href":"https://example.com/users/alice"}
+		]`))
+		defer stop()
+		u, _ := url.Parse(srv.URL)
+		cmd := &CommandLineFlags{agent: "apcli-test", args: []string{"@alice@" + u.Host}}
+		var out bytes.Buffer
+		if err := runWebFinger(context.Background(), cmd, &out); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if out.String() != "https://example.com/users/alice\n" {
+			t.Fatalf("expected the actor IRI, got %q", out.String())
+		}
+	})
+	t.Run("NoActivityStreamsLink", func(t *testing.T) {
+		srv, stop := testServer(true, handler(`[{"rel":"self","type":"text/html","href":"https://example.com/@alice"}]`))
+		defer stop()
+		u, _ := url.Parse(srv.URL)
+		cmd := &CommandLineFlags{agent: "apcli-test", args: []string{"acct:alice@" + u.Host}}
+		if err := runWebFinger(context.Background(), cmd, ioutil.Discard); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("InvalidHandle", func(t *testing.T) {
+		for _, handle := range []string{"alice", "@alice", "alice@", "@example.com"} {
+			cmd := &CommandLineFlags{agent: "apcli-test", args: []string{handle}}
+			if err := runWebFinger(context.Background(), cmd, ioutil.Discard); err == nil {
+				t.Fatalf("expected an error for %q", handle)
+			}
+		}
+	})
+}
+
+func TestRunValidate(t *testing.T) {
+	dir, cleanup := testFiles(t, map[string][]byte{
+		"note.json":    []byte(testNote),
+		"unknown.json": []byte(`{"@context":"https://www.w3.org/ns/activitystreams","type":"Frobnicate"}`),
+		"array.json":   []byte(`[]`),
+	})
+	defer cleanup()
+	t.Run("PrintsTypeAndId", func(t *testing.T) {
+		cmd := &CommandLineFlags{args: []string{filepath.Join(dir, "note.json")}}
+		var out bytes.Buffer
+		if err := runValidate(context.Background(), cmd, &out); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if out.String() != "Note https://example.com/notes/1\n" {
+			t.Fatalf("expected the type and id, got %q", out.String())
+		}
+	})
+	for _, file := range []string{"unknown.json", "array.json", "missing.json"} {
+		t.Run(file, func(t *testing.T) {
+			cmd := &CommandLineFlags{args: []string{filepath.Join(dir, file)}}
+			if err := runValidate(context.Background(), cmd, ioutil.Discard); err == nil {
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+}
+
+func TestRunDeliver(t *testing.T) {
+	k, p := testKey(t)
+	dir, cleanup := testFiles(t, map[string][]byte{
+		"private.pem": p,
+		"like.json":   []byte(testLike),
+		"note.json":   []byte(testNote),
+	})
+	defer cleanup()
+	t.Run("SignsAndPosts", func(t *testing.T) {
+		var received []byte
+		srv, stop := testServer(false, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "POST" || r.URL.Path != "/inbox" {
+				http.NotFound(w, r)
+				return
+			} else if err := verifySignature(r, k); err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			received, _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer stop()
+		cmd := &CommandLineFlags{
+			key:   filepath.Join(dir, "private.pem"),
+			keyId: testKeyId,
+			agent: "apcli-test",
+			args:  []string{filepath.Join(dir, "like.json"), srv.URL + "/inbox"},
+		}
+		var out bytes.Buffer
+		if err := runDeliver(context.Background(), cmd, &out); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if string(received) != testLike {
+			t.Fatalf("expected the activity to be posted, got %q", received)
+		}
+		if out.String() != "delivered Like to "+srv.URL+"/inbox\n" {
+			t.Fatalf("unexpected output %q", out.String())
+		}
+	})
+	t.Run("RejectedByInbox", func(t *testing.T) {
+		srv, stop := testServer(false, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+		}))
+		defer stop()
+		cmd := &CommandLineFlags{
+			key:   filepath.Join(dir, "private.pem"),
+			keyId: testKeyId,
+			agent: "apcli-test",
+			args:  []string{filepath.Join(dir, "like.json"), srv.URL + "/inbox"},
+		}
+		if err := runDeliver(context.Background(), cmd, ioutil.Discard); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("RequiresActivity", func(t *testing.T) {
+		cmd := &CommandLineFlags{
+			key:   filepath.Join(dir, "private.pem"),
+			keyId: testKeyId,
+			args:  []string{filepath.Join(dir, "note.json"), "https://example.com/inbox"},
+		}
+		if err := runDeliver(context.Background(), cmd, ioutil.Discard); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("RequiresKey", func(t *testing.T) {
+		cmd := &CommandLineFlags{args: []string{filepath.Join(dir, "like.json"), "https://example.com/inbox"}}
+		if err := runDeliver(context.Background(), cmd, ioutil.Discard); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+}
+
+func TestRunWalk(t *testing.T) {
+	// The second page links back to the first, which must not loop.
+	pages := map[string]string{
+		"/outbox": `{"@context":"https://www.w3.org/ns/activitystreams","type":"OrderedCollection","id":"%[1]s/outbox","first":"%[1]s/outbox/1"}`,
+		"/outbox/1": `{"@context":"https://www.w3.org/ns/activitystreams","type":"OrderedCollectionPage","id":"%[1]s/outbox/1",
+			"orderedItems":["https://example.com/a/1",{"type":"Note","id":"https://example.com/n/2"}],"next":"%[1]s/outbox/2"}`,
+		"/outbox/2": `{"@context":"https://www.w3.org/ns/activitystreams","type":"OrderedCollectionPage","id":"%[1]s/outbox/2",
+			"orderedItems":[{"type":"Note","content":"no id"},"https://example.com/a/3"],"next":"%[1]s/outbox/1"}`,
+		"/note": testNote,
+	}
+	var srv *httptest.Server
+	srv, stop := testServer(false, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, ok := pages[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, page, srv.URL)
+	}))
+	defer stop()
+	t.Run("FollowsPages", func(t *testing.T) {
+		cmd := &CommandLineFlags{agent: "apcli-test", args: []string{srv.URL + "/outbox"}}
+		var out bytes.Buffer
+		if err := runWalk(context.Background(), cmd, &out); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		expected := "https://example.com/a/1\nhttps://example.com/n/2\n(embedded value without id)\nhttps://example.com/a/3\n"
+		if out.String() != expected {
+			t.Fatalf("expected %q, got %q", expected, out.String())
+		}
+	})
+	t.Run("Limit", func(t *testing.T) {
+		cmd := &CommandLineFlags{agent: "apcli-test", limit: 1, args: []string{srv.URL + "/outbox"}}
+		var out bytes.Buffer
+		if err := runWalk(context.Background(), cmd, &out); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if out.String() != "https://example.com/a/1\n" {
+			t.Fatalf("expected a single item, got %q", out.String())
+		}
+	})
+	t.Run("NotCollection", func(t *testing.T) {
+		cmd := &CommandLineFlags{agent: "apcli-test", args: []string{srv.URL + "/note"}}
+		if err := runWalk(context.Background(), cmd, ioutil.Discard); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+}
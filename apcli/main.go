@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+const (
+	keyFlag     = "key"
+	keyIdFlag   = "keyid"
+	agentFlag   = "agent"
+	timeoutFlag = "timeout"
+	limitFlag   = "limit"
+	helpText    = `
+Usage: apcli [flags] <command> [arguments]
+
+The ActivityPub client tool (apcli) talks to ActivityPub servers the way a peer
+server does, to aid debugging federation. It is built on the same go-fed
+libraries used by applications.
+
+The commands are:
+
+    fetch <iri>
+        Dereferences the IRI and pretty-prints the ActivityStreams value.
+
+    webfinger <user@host>
+        Resolves a WebFinger handle, such as @alice@example.com, to the IRI
+        of the actor.
+
+    validate <file>
+        Checks that a JSON file is an ActivityStreams value understood by
+        go-fed, and prints its type and id.
+
+    deliver <file> <inbox iri>
+        Signs the JSON activity in the file and POSTs it to the inbox.
+
+    walk <collection iri>
+        Prints the id of each item in a collection or ordered collection,
+        following its pages.
+
+Requests are signed with HTTP Signatures when a private key is given, as many
+servers require a signed fetch. The key is an RSA private key in a PEM file,
+either PKCS#1 or PKCS#8, and the key id is the IRI of the matching public key
+of an actor, usually the actor's IRI with a "#main-key" fragment:
+
+    apcli -key private.pem -keyid https://example.com/users/me#main-key \
+        fetch https://peer.example/users/alice
+
+Delivering always requires a key.
+
+`
+)
+
+// At init time, set up the usage text, before main executes.
+func init() {
+	flag.Usage = func() {
+		_, _ = io.WriteString(flag.CommandLine.Output(), helpText)
+		flag.PrintDefaults()
+	}
+}
+
+// CommandLineFlags manages the flags defined by this tool.
+type CommandLineFlags struct {
+	// Flags
+	key     string
+	keyId   string
+	agent   string
+	timeout time.Duration
+	limit   int
+	// Command and its arguments
+	command string
+	args    []string
+}
+
+// NewCommandLineFlags defines the flags expected to be used by this tool on the
+// FlagSet, parses the arguments, and validates the flags, the command, and its
+// arguments. Returns an error if validation fails.
+func NewCommandLineFlags(fs *flag.FlagSet, arguments []string) (*CommandLineFlags, error) {
+	c := &CommandLineFlags{}
+	fs.StringVar(&c.key, keyFlag, "", "PEM file containing the RSA private key used to sign requests.")
+	fs.StringVar(&c.keyId, keyIdFlag, "", "IRI of the public key matching the private key, used as the key id of HTTP Signatures.")
+	fs.StringVar(&c.agent, agentFlag, "apcli", "User-Agent identifying this tool to peers.")
+	fs.DurationVar(&c.timeout, timeoutFlag, 30*time.Second, "Time limit of the whole command.")
+	fs.IntVar(&c.limit, limitFlag, 100, "Maximum number of items printed when walking a collection. Zero is unlimited.")
+	if err := fs.Parse(arguments); err != nil {
+		return nil, err
+	}
+	args := fs.Args()
+	if len(args) == 0 {
+		return nil, fmt.Errorf("apcli requires a command")
+	}
+	c.command = args[0]
+	c.args = args[1:]
+	return c, c.Validate()
+}
+
+// Validate applies custom validation logic to flags and returns an error if any
+// flags violate these rules.
+func (c *CommandLineFlags) Validate() error {
+	if (len(c.key) == 0) != (len(c.keyId) == 0) {
+		return fmt.Errorf("%q and %q flags must be set together", keyFlag, keyIdFlag)
+	}
+	if c.timeout <= 0 {
+		return fmt.Errorf("%q flag must be positive", timeoutFlag)
+	}
+	if c.limit < 0 {
+		return fmt.Errorf("%q flag must not be negative", limitFlag)
+	}
+	sub, ok := commands[c.command]
+	if !ok {
+		return fmt.Errorf("unknown command %q", c.command)
+	} else if len(c.args) != sub.nArgs {
+		return fmt.Errorf("usage: apcli [flags] %s", sub.usage)
+	}
+	return nil
+}
+
+// command is a subcommand of the tool.
+type command struct {
+	// nArgs is the number of arguments the command requires.
+	nArgs int
+	// usage describes the arguments.
+	usage string
+	// run executes the command, printing results to out.
+	run func(c context.Context, cmd *CommandLineFlags, out io.Writer) error
+}
+
+// commands are the subcommands of the tool, by name.
+var commands = map[string]command{
+	"fetch":     {1, "fetch <iri>", runFetch},
+	"webfinger": {1, "webfinger <user@host>", runWebFinger},
+	"validate":  {1, "validate <file>", runValidate},
+	"deliver":   {2, "deliver <file> <inbox iri>", runDeliver},
+	"walk":      {1, "walk <collection iri>", runWalk},
+}
+
+func main() {
+	// Read, Parse, and Validate command line flags
+	cmd, err := NewCommandLineFlags(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// Run the command
+	c, cancel := context.WithTimeout(context.Background(), cmd.timeout)
+	defer cancel()
+	if err := commands[cmd.command].run(c, cmd, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		cancel()
+		os.Exit(1)
+	}
+}
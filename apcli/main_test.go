@@ -0,0 +1,93 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+// parseArgs parses the arguments with a FlagSet of their own.
+func parseArgs(args ...string) (*CommandLineFlags, error) {
+	fs := flag.NewFlagSet("apcli", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	return NewCommandLineFlags(fs, args)
+}
+
+func TestNewCommandLineFlags(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		c, err := parseArgs("fetch", "https://example.com/alice")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if c.command != "fetch" {
+			t.Fatalf("expected command %q, got %q", "fetch", c.command)
+		}
+		if len(c.args) != 1 || c.args[0] != "https://example.com/alice" {
+			t.Fatalf("expected the IRI argument, got %v", c.args)
+		}
+		if c.agent != "apcli" || c.timeout != 30*time.Second || c.limit != 100 || len(c.key) != 0 || len(c.keyId) != 0 {
+			t.Fatalf("unexpected defaults: %+v", c)
+		}
+	})
+	t.Run("Flags", func(t *testing.T) {
+		c, err := parseArgs("-key", "private.pem", "-keyid", "https://example.com/me#main-key", "-agent", "test", "-timeout", "5s", "-limit", "0", "walk", "https://example.com/outbox")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if c.key != "private.pem" || c.keyId != "https://example.com/me#main-key" || c.agent != "test" || c.timeout != 5*time.Second || c.limit != 0 {
+			t.Fatalf("unexpected flags: %+v", c)
+		}
+		if c.command != "walk" || len(c.args) != 1 {
+			t.Fatalf("unexpected command %q with arguments %v", c.command, c.args)
+		}
+	})
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{
+			"No Command",
+			nil,
+		},
+		{
+			"Unknown Command",
+			[]string{"frobnicate", "https://example.com"},
+		},
+		{
+			"Too Few Arguments",
+			[]string{"deliver", "create.json"},
+		},
+		{
+			"Too Many Arguments",
+			[]string{"fetch", "https://example.com/a", "https://example.com/b"},
+		},
+		{
+			"Key Without Key Id",
+			[]string{"-key", "private.pem", "fetch", "https://example.com"},
+		},
+		{
+			"Key Id Without Key",
+			[]string{"-keyid", "https://example.com/me#main-key", "fetch", "https://example.com"},
+		},
+		{
+			"Zero Timeout",
+			[]string{"-timeout", "0s", "fetch", "https://example.com"},
+		},
+		{
+			"Negative Limit",
+			[]string{"-limit", "-1", "walk", "https://example.com"},
+		},
+		{
+			"Undefined Flag",
+			[]string{"-verbose", "fetch", "https://example.com"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := parseArgs(test.args...); err == nil {
+				t.Fatalf("expected an error parsing %v", test.args)
+			}
+		})
+	}
+}
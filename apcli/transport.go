@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/httpsig"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	// acceptHeaderValue is the Accept header of unsigned requests for
+	// ActivityStreams values.
+	acceptHeaderValue = "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\""
+)
+
+var (
+	// getHeaders are signed on GET requests.
+	getHeaders = []string{httpsig.RequestTarget, "date"}
+	// postHeaders are signed on POST requests.
+	postHeaders = []string{httpsig.RequestTarget, "date", "digest"}
+	// httpClient sends the requests of the tool.
+	httpClient = http.DefaultClient
+)
+
+// clock is the system's clock.
+type clock struct{}
+
+// Now returns the current time.
+func (clock) Now() time.Time {
+	return time.Now()
+}
+
+// dereferencer obtains ActivityStreams values, such as a pub.Transport.
+type dereferencer interface {
+	Dereference(c context.Context, iri *url.URL) ([]byte, error)
+}
+
+// newDereferencer creates a signing pub.Transport if a key is given, and an
+// unsigned dereferencer otherwise.
+func (c *CommandLineFlags) newDereferencer() (dereferencer, error) {
+	if len(c.key) == 0 {
+		return unsignedDereferencer{agent: c.agent}, nil
+	}
+	return c.newTransport()
+}
+
+// newTransport creates a pub.Transport signing requests with the key.
+func (c *CommandLineFlags) newTransport() (pub.Transport, error) {
+	if len(c.key) == 0 {
+		return nil, fmt.Errorf("%q and %q flags are required", keyFlag, keyIdFlag)
+	}
+	privKey, err := readPrivateKey(c.key)
+	if err != nil {
+		return nil, err
+	}
+	getSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, httpsig.DigestSha256, getHeaders, httpsig.Signature)
+	if err != nil {
+		return nil, err
+	}
+	postSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, httpsig.DigestSha256, postHeaders, httpsig.Signature)
+	if err != nil {
+		return nil, err
+	}
+	return pub.NewHttpSigTransport(httpClient, c.agent, clock{}, getSigner, postSigner, c.keyId, privKey), nil
+}
+
+// readPrivateKey reads a PKCS#1 or PKCS#8 private key from a PEM file.
+func readPrivateKey(file string) (crypto.PrivateKey, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in %s", file)
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q in %s", block.Type, file)
+	}
+}
+
+// unsignedDereferencer obtains ActivityStreams values without signing the
+// requests.
+type unsignedDereferencer struct {
+	agent string
+}
+
+// Dereference sends a GET request to obtain an ActivityStreams value.
+func (u unsignedDereferencer) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	return get(c, iri, acceptHeaderValue, u.agent)
+}
+
+// get sends a GET request accepting the given media type, and returns the body
+// of a successful response.
+func get(c context.Context, iri *url.URL, accept, agent string) ([]byte, error) {
+	req, err := http.NewRequest("GET", iri.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(c)
+	req.Header.Set("Accept", accept)
+	req.Header.Set("User-Agent", agent)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET request to %s failed (%d): %s", iri.String(), resp.StatusCode, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
@@ -461,6 +461,12 @@ func (c *Converter) convertToFiles(v vocabulary) (f []*File, e error) {
 		return
 	}
 	f = append(f, files...)
+	// Validation
+	files, e = c.validationFiles(c.GenRoot.PublicPackage(), v)
+	if e != nil {
+		return
+	}
+	f = append(f, files...)
 	return
 }
 
@@ -1218,6 +1224,7 @@ func (c *Converter) resolverFiles(pkg gen.Package, manGen *gen.ManagerGenerator,
 	})
 	// JSON resolver
 	file = jen.NewFilePath(pkg.Path())
+	file.Add(rg.ExtensionTypeResolver()).Line()
 	file.Add(jsonRes.Definition())
 	files = append(files, &File{
 		F:         file,
@@ -1243,6 +1250,21 @@ func (c *Converter) resolverFiles(pkg gen.Package, manGen *gen.ManagerGenerator,
 	return
 }
 
+// validationFiles creates the files for the validation of values.
+func (c *Converter) validationFiles(pkg gen.Package, root vocabulary) (files []*File, e error) {
+	vg := gen.NewValidationGenerator(pkg, root.allTypeArray())
+	file := jen.NewFilePath(pkg.Path())
+	for _, code := range vg.Definition() {
+		file.Add(code).Line()
+	}
+	files = append(files, &File{
+		F:         file,
+		FileName:  "gen_validate.go",
+		Directory: pkg.WriteDir(),
+	})
+	return
+}
+
 // constFiles creates the files for constants.
 func (c *Converter) constFiles(pkg gen.Package, types []*gen.TypeGenerator, props []*gen.PropertyGenerator) (files []*File, e error) {
 	consts := gen.GenerateConstants(types, props)
@@ -86,7 +86,10 @@ func VocabPackageComment(pkgName, vocabName string) string {
 		"types also have a \"LessThan\" method, it is an arbitrary "+
 		"sort. Do not use it if needing to sort on specific "+
 		"properties, such as publish time. It is best used for "+
-		"normalizing the type. Lastly, do not use the "+
+		"normalizing the type. The \"Clone\" method returns a deep "+
+		"copy of a type, which can be changed without changing the "+
+		"original, and the \"Equals\" method compares two types "+
+		"deeply. Lastly, do not use the "+
 		"\"GetUnknownProperties\" method in an application. Instead, "+
 		"use the go-fed tool to code generate the property needed. "+
 		"\n\n"+
@@ -104,7 +107,9 @@ func VocabPackageComment(pkgName, vocabName string) string {
 		"a property's \"Serialize\" and instead should use a type's "+
 		"\"Serialize\" instead. Like types, properties have an "+
 		"arbitrary \"LessThan\" comparison function that should not "+
-		"be used if needing to sort on specific values. Finally, "+
+		"be used if needing to sort on specific values, and a "+
+		"\"Clone\" method returning a deep copy and an \"Equals\" "+
+		"method comparing them deeply. Finally, "+
 		"applications should not use the \"KindIndex\" method as it "+
 		"is a comparison mechanism only for those looking to write an "+
 		"alternate implementation.\n\n"+
@@ -351,7 +351,7 @@ func (p *FunctionalPropertyGenerator) serializationFuncs() (*codegen.Method, *co
 			// This is a type with a Serialize method.
 			serializeFns = serializeFns.Block(
 				jen.Return(
-					jen.Id(codegen.This()).Dot(p.getFnName(i)).Call().Dot(serializeMethodName).Call(),
+					jen.Id(codegen.This()).Dot(p.getFnName(i)).Call().Dot(serializeMethod).Call(),
 				),
 			)
 		}
@@ -540,6 +540,10 @@ func (p *FunctionalPropertyGenerator) singleTypeDef() *codegen.Struct {
 	methods = append(methods, ser)
 	funcs = append(funcs, deser)
 	funcs = append(funcs, p.ConstructorFn())
+	funcs = append(funcs, cloneUnknownFunction(p.GetPrivatePackage().Path()))
+	funcs = append(funcs, equalsUnknownFunction(p.GetPrivatePackage().Path()))
+	methods = append(methods, p.cloneMethod())
+	methods = append(methods, p.equalsMethod())
 	methods = append(methods, p.singleTypeFuncs()...)
 	methods = append(methods, p.funcs()...)
 	methods = append(methods, p.commonMethods()...)
@@ -798,6 +802,10 @@ func (p *FunctionalPropertyGenerator) multiTypeDef() *codegen.Struct {
 	methods = append(methods, ser)
 	funcs = append(funcs, deser)
 	funcs = append(funcs, p.ConstructorFn())
+	funcs = append(funcs, cloneUnknownFunction(p.GetPrivatePackage().Path()))
+	funcs = append(funcs, equalsUnknownFunction(p.GetPrivatePackage().Path()))
+	methods = append(methods, p.cloneMethod())
+	methods = append(methods, p.equalsMethod())
 	methods = append(methods, p.multiTypeFuncs()...)
 	methods = append(methods, p.funcs()...)
 	methods = append(methods, p.commonMethods()...)
@@ -1033,6 +1041,116 @@ func (p *FunctionalPropertyGenerator) multiTypeFuncs() []*codegen.Method {
 	return methods
 }
 
+// cloneMethod returns the method deeply copying this property. An iterator
+// is instead copied into the clone of its parent by an unexported method.
+func (p *FunctionalPropertyGenerator) cloneMethod() *codegen.Method {
+	code := []jen.Code{
+		jen.Id("clone").Op(":=").Id(codegen.This()),
+	}
+	for i, kind := range p.kinds {
+		if c := kind.cloneCode(p.memberName(i)); c != nil {
+			code = append(code, c)
+		}
+	}
+	if !p.hasURIKind() {
+		code = append(code, jen.If(
+			jen.Id(codegen.This()).Dot(iriMember).Op("!=").Nil(),
+		).Block(
+			jen.Id("iri").Op(":=").Op("*").Id(codegen.This()).Dot(iriMember),
+			jen.Id("clone").Dot(iriMember).Op("=").Op("&").Id("iri"),
+		))
+	}
+	code = append(code, jen.Id("clone").Dot(unknownMemberName).Op("=").Id(cloneUnknownFnName).Call(
+		jen.Id(codegen.This()).Dot(unknownMemberName),
+	))
+	if p.asIterator {
+		code = append(code,
+			jen.Id("clone").Dot(parentMemberName).Op("=").Id(parentMemberName),
+			jen.Return(jen.Op("&").Id("clone")))
+		return codegen.NewCommentedValueMethod(
+			p.GetPrivatePackage().Path(),
+			iteratorCloneMethod,
+			p.StructName(),
+			[]jen.Code{jen.Id(parentMemberName).Qual(p.GetPublicPackage().Path(), p.parentTypeInterfaceName())},
+			[]jen.Code{jen.Op("*").Id(p.StructName())},
+			code,
+			fmt.Sprintf("%s returns a deep copy of this iterator belonging to the parent property.", iteratorCloneMethod))
+	}
+	code = append(code, jen.Return(jen.Op("&").Id("clone")))
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		cloneMethod,
+		p.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
+		code,
+		fmt.Sprintf("%s returns a deep copy of this property, which can be changed without changing this one.", cloneMethod))
+}
+
+// equalsMethod returns the method determining whether this property deeply
+// equals another.
+func (p *FunctionalPropertyGenerator) equalsMethod() *codegen.Method {
+	equalsCode := jen.Empty()
+	for i, kind := range p.kinds {
+		if i > 0 {
+			equalsCode = equalsCode.Else()
+		}
+		equalsCode = equalsCode.If(
+			jen.Id(codegen.This()).Dot(p.isMethodName(i)).Call().Op("&&").Id("o").Dot(p.isMethodName(i)).Call(),
+		).Block(
+			jen.Return(kind.equalsFnCode(jen.Id(codegen.This()).Dot(p.getFnName(i)).Call(), jen.Id("o").Dot(p.getFnName(i)).Call())),
+		).Else().If(
+			jen.Id(codegen.This()).Dot(p.isMethodName(i)).Call().Op("||").Id("o").Dot(p.isMethodName(i)).Call(),
+		).Block(
+			jen.Return(jen.False()),
+		)
+	}
+	if !p.hasURIKind() {
+		equalsCode = equalsCode.Else().If(
+			jen.Id(codegen.This()).Dot(isIRIMethod).Call().Op("&&").Id("o").Dot(isIRIMethod).Call(),
+		).Block(
+			jen.Return(
+				jen.Id(codegen.This()).Dot(iriMember).Dot("String").Call().Op("==").Id("o").Dot(getIRIMethod).Call().Dot("String").Call(),
+			),
+		).Else().If(
+			jen.Id(codegen.This()).Dot(isIRIMethod).Call().Op("||").Id("o").Dot(isIRIMethod).Call(),
+		).Block(
+			jen.Return(jen.False()),
+		)
+	}
+	unknownCode := []jen.Code{
+		jen.Commentf("Both are unknown values or neither is set."),
+		jen.List(jen.Id("lhs"), jen.Id("_")).Op(":=").Id(codegen.This()).Dot(serializeMethod).Call(),
+		jen.List(jen.Id("rhs"), jen.Id("_")).Op(":=").Id("o").Dot(serializeMethod).Call(),
+		jen.Return(jen.Id(equalsUnknownFnName).Call(jen.Id("lhs"), jen.Id("rhs"))),
+	}
+	if p.asIterator {
+		// Iterators do not serialize themselves publicly, so the other
+		// iterator's unknown value is only known if it is generated.
+		unknownCode = []jen.Code{
+			jen.Commentf("Both are unknown values or neither is set."),
+			jen.If(
+				jen.List(jen.Id("other"), jen.Id("ok")).Op(":=").Id("o").Assert(jen.Op("*").Id(p.StructName())),
+				jen.Id("ok"),
+			).Block(
+				jen.Return(jen.Id(equalsUnknownFnName).Call(
+					jen.Id(codegen.This()).Dot(unknownMemberName),
+					jen.Id("other").Dot(unknownMemberName),
+				)),
+			),
+			jen.Return(jen.Id(codegen.This()).Dot(unknownMemberName).Op("==").Nil()),
+		}
+	}
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		equalsMethod,
+		p.StructName(),
+		[]jen.Code{jen.Id("o").Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
+		[]jen.Code{jen.Bool()},
+		append([]jen.Code{equalsCode}, unknownCode...),
+		fmt.Sprintf("%s determines whether this property has the same value as another, comparing any types it holds deeply.", equalsMethod))
+}
+
 // unknownMemberDef returns the definition of a struct member that handles
 // a property whose type is unknown.
 func (p *FunctionalPropertyGenerator) unknownMemberDef() jen.Code {
@@ -87,6 +87,8 @@ func (p *NonFunctionalPropertyGenerator) Definitions() (*codegen.Struct, *codege
 		methods = append(methods, ser)
 		funcs = append(funcs, deser)
 		funcs = append(funcs, p.ConstructorFn())
+		methods = append(methods, p.cloneMethod())
+		methods = append(methods, p.equalsMethod())
 		methods = append(methods, p.funcs()...)
 		property := codegen.NewStruct(
 			fmt.Sprintf("%s is the non-functional property %q. It is permitted to have one or more values, and of different value types.", p.StructName(), p.PropertyName()),
@@ -125,6 +127,66 @@ func (p *NonFunctionalPropertyGenerator) elementTypeGenerator() *FunctionalPrope
 	}
 }
 
+// cloneMethod returns the method deeply copying this property and each of its
+// values.
+func (p *NonFunctionalPropertyGenerator) cloneMethod() *codegen.Method {
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		cloneMethod,
+		p.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
+		[]jen.Code{
+			jen.Id("clone").Op(":=").Op("&").Id(p.StructName()).Values(jen.Dict{
+				jen.Id(aliasMember): jen.Id(codegen.This()).Dot(aliasMember),
+				jen.Id(propertiesName): jen.Make(
+					jen.Index().Op("*").Id(p.iteratorTypeName().CamelName),
+					jen.Lit(0),
+					jen.Len(jen.Id(codegen.This()).Dot(propertiesName)),
+				),
+			}),
+			jen.For(
+				jen.List(jen.Id("_"), jen.Id("elem")).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
+			).Block(
+				jen.Id("clone").Dot(propertiesName).Op("=").Append(
+					jen.Id("clone").Dot(propertiesName),
+					jen.Id("elem").Dot(iteratorCloneMethod).Call(jen.Id("clone")),
+				),
+			),
+			jen.Return(jen.Id("clone")),
+		},
+		fmt.Sprintf("%s returns a deep copy of this property and its values, which can be changed without changing this one.", cloneMethod))
+}
+
+// equalsMethod returns the method determining whether this property deeply
+// equals another, value by value in order.
+func (p *NonFunctionalPropertyGenerator) equalsMethod() *codegen.Method {
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		equalsMethod,
+		p.StructName(),
+		[]jen.Code{jen.Id("o").Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
+		[]jen.Code{jen.Bool()},
+		[]jen.Code{
+			jen.If(
+				jen.Id(codegen.This()).Dot(lenMethod).Call().Op("!=").Id("o").Dot(lenMethod).Call(),
+			).Block(
+				jen.Return(jen.False()),
+			),
+			jen.For(
+				jen.List(jen.Id("i"), jen.Id("elem")).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
+			).Block(
+				jen.If(
+					jen.Op("!").Id("elem").Dot(equalsMethod).Call(jen.Id("o").Dot(atMethodName).Call(jen.Id("i"))),
+				).Block(
+					jen.Return(jen.False()),
+				),
+			),
+			jen.Return(jen.True()),
+		},
+		fmt.Sprintf("%s determines whether this property has the same values as another in the same order, comparing any types they hold deeply.", equalsMethod))
+}
+
 // funcs produces the methods needed for the NonFunctional property.
 func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 	var methods []*codegen.Method
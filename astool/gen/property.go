@@ -41,6 +41,11 @@ const (
 	beginMethod               = "Begin"
 	endMethod                 = "End"
 	emptyMethod               = "Empty"
+	cloneMethod               = "Clone"
+	iteratorCloneMethod       = "clone"
+	cloneUnknownFnName        = "cloneUnknown"
+	equalsMethod              = "Equals"
+	equalsUnknownFnName       = "equalsUnknown"
 	// Context string management
 	contextMethod = "JSONLDContext"
 	// Member names for generated code
@@ -169,6 +174,15 @@ func (k Kind) lessFnCode(this, other *jen.Statement) *jen.Statement {
 	return lessCall
 }
 
+// equalsFnCode creates the correct code determining whether two values of
+// this Kind are equal. Values are equal when neither is less than the other.
+func (k Kind) equalsFnCode(this, other *jen.Statement) *jen.Statement {
+	if k.isValue() {
+		return jen.Op("!").Add(k.lessFnCode(this, other)).Op("&&").Op("!").Add(k.lessFnCode(other, this))
+	}
+	return this.Clone().Dot(equalsMethod).Call(other.Clone())
+}
+
 // lessFnCode creates the correct code calling this Kind's deserialize function
 // depending on whether the Kind is a value or a type.
 func (k Kind) deserializeFnCode(m, ctx *jen.Statement) *jen.Statement {
@@ -181,6 +195,134 @@ func (k Kind) deserializeFnCode(m, ctx *jen.Statement) *jen.Statement {
 	}
 }
 
+// cloneCode creates the code deeply copying the member holding this Kind into
+// the same member of the clone, if set. It returns nil for the values that are
+// copied with the clone itself.
+func (k Kind) cloneCode(member string) *jen.Statement {
+	this := jen.Id(codegen.This()).Dot(member)
+	clone := jen.Id("clone").Dot(member)
+	if !k.isValue() {
+		return jen.If(this.Clone().Op("!=").Nil()).Block(
+			clone.Clone().Op("=").Add(this.Clone()).Dot(cloneMethod).Call(),
+		)
+	} else if k.IsURI {
+		return jen.If(this.Clone().Op("!=").Nil()).Block(
+			jen.Id("u").Op(":=").Op("*").Add(this.Clone()),
+			clone.Clone().Op("=").Op("&").Id("u"),
+		)
+	} else if k.Nilable {
+		// The nilable values other than IRIs are maps, such as the
+		// natural language maps of rdf:langString.
+		return jen.If(this.Clone().Op("!=").Nil()).Block(
+			clone.Clone().Op("=").Make(k.ConcreteKind.Clone(), jen.Len(this.Clone())),
+			jen.For(
+				jen.List(jen.Id("k"), jen.Id("v")).Op(":=").Range().Add(this.Clone()),
+			).Block(
+				clone.Clone().Index(jen.Id("k")).Op("=").Id("v"),
+			),
+		)
+	}
+	return nil
+}
+
+// cloneUnknownFunction returns the function deeply copying unknown values,
+// which are JSON values.
+func cloneUnknownFunction(pkg string) *codegen.Function {
+	return codegen.NewCommentedFunction(
+		pkg,
+		cloneUnknownFnName,
+		[]jen.Code{jen.Id("i").Interface()},
+		[]jen.Code{jen.Interface()},
+		[]jen.Code{
+			jen.Switch(jen.Id("v").Op(":=").Id("i").Assert(jen.Type())).Block(
+				jen.Case(jen.Map(jen.String()).Interface()).Block(
+					jen.Id("m").Op(":=").Make(jen.Map(jen.String()).Interface(), jen.Len(jen.Id("v"))),
+					jen.For(
+						jen.List(jen.Id("k"), jen.Id("elem")).Op(":=").Range().Id("v"),
+					).Block(
+						jen.Id("m").Index(jen.Id("k")).Op("=").Id(cloneUnknownFnName).Call(jen.Id("elem")),
+					),
+					jen.Return(jen.Id("m")),
+				),
+				jen.Case(jen.Index().Interface()).Block(
+					jen.Id("arr").Op(":=").Make(jen.Index().Interface(), jen.Len(jen.Id("v"))),
+					jen.For(
+						jen.List(jen.Id("idx"), jen.Id("elem")).Op(":=").Range().Id("v"),
+					).Block(
+						jen.Id("arr").Index(jen.Id("idx")).Op("=").Id(cloneUnknownFnName).Call(jen.Id("elem")),
+					),
+					jen.Return(jen.Id("arr")),
+				),
+				jen.Default().Block(
+					jen.Return(jen.Id("i")),
+				),
+			),
+		},
+		fmt.Sprintf("%s deeply copies an unknown JSON value.", cloneUnknownFnName))
+}
+
+// equalsUnknownFunction returns the function determining whether two unknown
+// values, which are JSON values, are equal. The @context of JSON objects is
+// ignored.
+func equalsUnknownFunction(pkg string) *codegen.Function {
+	return codegen.NewCommentedFunction(
+		pkg,
+		equalsUnknownFnName,
+		[]jen.Code{jen.List(jen.Id("lhs"), jen.Id("rhs")).Interface()},
+		[]jen.Code{jen.Bool()},
+		[]jen.Code{
+			jen.Switch(jen.Id("l").Op(":=").Id("lhs").Assert(jen.Type())).Block(
+				jen.Case(jen.Map(jen.String()).Interface()).Block(
+					jen.List(jen.Id("r"), jen.Id("ok")).Op(":=").Id("rhs").Assert(jen.Map(jen.String()).Interface()),
+					jen.If(jen.Op("!").Id("ok")).Block(
+						jen.Return(jen.False()),
+					),
+					jen.For(
+						jen.List(jen.Id("k"), jen.Id("v")).Op(":=").Range().Id("l"),
+					).Block(
+						jen.If(jen.Id("k").Op("==").Lit("@context")).Block(
+							jen.Continue(),
+						).Else().If(
+							jen.List(jen.Id("rv"), jen.Id("ok")).Op(":=").Id("r").Index(jen.Id("k")),
+							jen.Op("!").Id("ok").Op("||").Op("!").Id(equalsUnknownFnName).Call(jen.Id("v"), jen.Id("rv")),
+						).Block(
+							jen.Return(jen.False()),
+						),
+					),
+					jen.For(
+						jen.Id("k").Op(":=").Range().Id("r"),
+					).Block(
+						jen.If(
+							jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id("l").Index(jen.Id("k")),
+							jen.Op("!").Id("ok").Op("&&").Id("k").Op("!=").Lit("@context"),
+						).Block(
+							jen.Return(jen.False()),
+						),
+					),
+					jen.Return(jen.True()),
+				),
+				jen.Case(jen.Index().Interface()).Block(
+					jen.List(jen.Id("r"), jen.Id("ok")).Op(":=").Id("rhs").Assert(jen.Index().Interface()),
+					jen.If(jen.Op("!").Id("ok").Op("||").Len(jen.Id("l")).Op("!=").Len(jen.Id("r"))).Block(
+						jen.Return(jen.False()),
+					),
+					jen.For(
+						jen.List(jen.Id("idx"), jen.Id("v")).Op(":=").Range().Id("l"),
+					).Block(
+						jen.If(jen.Op("!").Id(equalsUnknownFnName).Call(jen.Id("v"), jen.Id("r").Index(jen.Id("idx")))).Block(
+							jen.Return(jen.False()),
+						),
+					),
+					jen.Return(jen.True()),
+				),
+				jen.Default().Block(
+					jen.Return(jen.Qual("reflect", "DeepEqual").Call(jen.Id("lhs"), jen.Id("rhs"))),
+				),
+			),
+		},
+		fmt.Sprintf("%s determines whether two unknown JSON values are equal, ignoring the @context of objects.", equalsUnknownFnName))
+}
+
 // isValue returns true if this Kind is a value, or false if it is a type.
 func (k Kind) isValue() bool {
 	// LessFn is not nil, this means it is a value.
@@ -26,6 +26,7 @@ const (
 	errorCannotTypeAssert            = "errCannotTypeAssertType"
 	isUnFnName                       = "IsUnmatchedErr"
 	toAliasMapFnName                 = "toAliasMap"
+	extensionTypeResolverName        = "extensionTypeResolver"
 )
 
 // ResolverGenerator generates the code required for the TypeResolver and the
@@ -72,7 +73,7 @@ func (r *ResolverGenerator) Definition() (jsonRes, typeRes, typePredRes *codegen
 				"its concrete ActivityStreams type", jsonResolverStructName),
 			jsonResolverStructName,
 			r.jsonResolverMethods(),
-			append(r.resolverFunctions(jsonResolverStructName,
+			append(r.resolverFunctions(jsonResolverStructName, true,
 				"creates a new Resolver that takes a "+
 					"JSON-deserialized generic map and determines "+
 					"the correct concrete Go type. The callback "+
@@ -83,9 +84,14 @@ func (r *ResolverGenerator) Definition() (jsonRes, typeRes, typePredRes *codegen
 					"the form:\n\n"+
 					"  func(context.Context, <TypeInterface>) error\n\n"+
 					"where TypeInterface is the code-generated "+
-					"interface for an ActivityStream type. An "+
-					"error is returned if a callback function "+
-					"does not match this signature."),
+					"interface for an ActivityStream type. A "+
+					"callback of the form:\n\n"+
+					"  func(context.Context, vocab.Type) error\n\n"+
+					"receives the types not handled by the "+
+					"generated code that are resolved by "+
+					"extensions, such as those registered at "+
+					"runtime. An error is returned if a callback "+
+					"function does not match these signatures."),
 				r.toAliasFunction()),
 			r.resolverMembers())
 		r.cachedType = codegen.NewStruct(
@@ -93,7 +99,7 @@ func (r *ResolverGenerator) Definition() (jsonRes, typeRes, typePredRes *codegen
 				"on their type name.", typeResolverStructName),
 			typeResolverStructName,
 			r.typeResolverMethods(),
-			r.resolverFunctions(typeResolverStructName,
+			r.resolverFunctions(typeResolverStructName, false,
 				"creates a new Resolver that examines the "+
 					"type of an ActivityStream value to determine "+
 					"what callback function to pass the concretely "+
@@ -141,14 +147,36 @@ func (r *ResolverGenerator) Definition() (jsonRes, typeRes, typePredRes *codegen
 		r.cachedResolverInterface = r.resolverInterface()
 	})
 	return r.cachedJSON, r.cachedType, r.cachedTypePredicate, []jen.Code{
-			r.cachedErrNoMatch,
-			r.cachedErrUnhandled,
-			r.cachedErrPredicateUnmatched,
-			r.cachedErrCannotTypeAssert,
-		}, r.cachedFns, []*codegen.Interface{
-			r.cachedASInterface,
-			r.cachedResolverInterface,
-		}
+		r.cachedErrNoMatch,
+		r.cachedErrUnhandled,
+		r.cachedErrPredicateUnmatched,
+		r.cachedErrCannotTypeAssert,
+	}, r.cachedFns, []*codegen.Interface{
+		r.cachedASInterface,
+		r.cachedResolverInterface,
+	}
+}
+
+// ExtensionTypeResolver returns the declaration of the function the
+// JSONResolver applies to the types not handled by the generated code. It is
+// nil unless set by code outside of the generated code.
+func (r *ResolverGenerator) ExtensionTypeResolver() jen.Code {
+	return jen.Commentf(
+		"%s deserializes the types not handled by the generated code, such as those of extension vocabularies registered at runtime. It returns %s if it cannot. It is nil unless set outside of the generated code.",
+		extensionTypeResolverName,
+		errorUnhandled,
+	).Line().Var().Id(extensionTypeResolverName).Func().Parens(
+		jen.List(
+			jen.Id("typeString").String(),
+			jen.Id("m").Map(jen.String()).Interface(),
+			jen.Id("aliasMap").Map(jen.String()).String(),
+		),
+	).Parens(
+		jen.List(
+			jen.Qual(r.types[0].PublicPackage().Path(), typeInterfaceName),
+			jen.Error(),
+		),
+	)
 }
 
 // errorNoMatch returns the declaration for the ErrNoMatch global value.
@@ -203,6 +231,21 @@ func (r *ResolverGenerator) fns() []*codegen.Function {
 			jen.Return(jen.Nil()),
 		))
 	}
+	allTypeFns = append(allTypeFns, jen.Func().Params(
+		jen.Id("ctx").Qual("context", "Context"),
+		jen.Id("i").Qual(r.types[0].PublicPackage().Path(), typeInterfaceName),
+	).Error().Block(
+		jen.Id("t").Op("=").Id("i"),
+		jen.Return(jen.Nil()),
+	))
+	cloneCases := make([]jen.Code, 0, len(r.types))
+	for _, t := range r.types {
+		cloneCases = append(cloneCases, jen.Case(
+			jen.Qual(t.PublicPackage().Path(), t.InterfaceName()),
+		).Block(
+			jen.Return(jen.Id("v").Dot(cloneMethod).Call()),
+		))
+	}
 	return []*codegen.Function{
 		codegen.NewCommentedFunction(
 			r.pkg.Path(),
@@ -258,7 +301,32 @@ func (r *ResolverGenerator) fns() []*codegen.Function {
 				),
 				jen.Return(),
 			},
-			fmt.Sprintf("To%s attempts to resolve the generic JSON map into a Type.", typeInterfaceName)),
+			fmt.Sprintf("To%s attempts to resolve the generic JSON map into a Type, including the types resolved by extensions.", typeInterfaceName)),
+		codegen.NewCommentedFunction(
+			r.types[0].PublicPackage().Path(),
+			cloneMethod,
+			[]jen.Code{
+				jen.Id("t").Qual(r.types[0].PublicPackage().Path(), typeInterfaceName),
+			},
+			[]jen.Code{
+				jen.Qual(r.types[0].PublicPackage().Path(), typeInterfaceName),
+			},
+			[]jen.Code{
+				jen.Switch(jen.Id("v").Op(":=").Id("t").Assert(jen.Type())).Block(cloneCases...),
+				jen.Commentf("Types not handled by the generated code, such as those of extensions."),
+				jen.If(
+					jen.List(jen.Id("c"), jen.Id("ok")).Op(":=").Id("t").Assert(
+						jen.Interface(
+							jen.Id(cloneMethod).Params().Qual(r.types[0].PublicPackage().Path(), typeInterfaceName),
+						),
+					),
+					jen.Id("ok"),
+				).Block(
+					jen.Return(jen.Id("c").Dot(cloneMethod).Call()),
+				),
+				jen.Return(jen.Nil()),
+			},
+			fmt.Sprintf("%s returns a deep copy of the value, which can be changed without changing the original. Values of types not handled by the generated code are copied if they have a \"%s() %s\" method, and are nil otherwise.", cloneMethod, cloneMethod, typeInterfaceName)),
 	}
 }
 
@@ -397,7 +465,51 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 				jen.Id("typeString").String(),
 			).Error().Block(
 				aliasFetching,
-				impl.Else().Block(
+				impl.Else().If(
+					jen.Id(extensionTypeResolverName).Op("!=").Nil(),
+				).Block(
+					jen.List(
+						jen.Id("v"),
+						jen.Err(),
+					).Op(":=").Id(extensionTypeResolverName).Call(
+						jen.Id("typeString"),
+						jen.Id("m"),
+						jen.Id("aliasMap"),
+					),
+					jen.If(
+						jen.Err().Op("!=").Nil(),
+					).Block(
+						jen.Return(jen.Err()),
+					),
+					jen.For(
+						jen.List(
+							jen.Id("_"),
+							jen.Id("i"),
+						).Op(":=").Range().Id(codegen.This()).Dot(callbackMember),
+					).Block(
+						jen.If(
+							jen.List(
+								jen.Id("fn"),
+								jen.Id("ok"),
+							).Op(":=").Id("i").Assert(
+								jen.Func().Parens(
+									jen.List(
+										jen.Qual("context", "Context"),
+										jen.Qual(r.types[0].PublicPackage().Path(), typeInterfaceName),
+									),
+								).Error(),
+							),
+							jen.Id("ok"),
+						).Block(
+							jen.Return(
+								jen.Id("fn").Call(jen.Id("ctx"), jen.Id("v")),
+							),
+						),
+					),
+					jen.Return(
+						jen.Id(errorNoMatch),
+					),
+				).Else().Block(
 					jen.Return(
 						jen.Id(errorUnhandled),
 					),
@@ -653,7 +765,10 @@ func (r *ResolverGenerator) typePredicatedResolverMethods() (m []*codegen.Method
 }
 
 // resolverFunctions returns the functions for the TypeResolver.
-func (r *ResolverGenerator) resolverFunctions(name, comment string) (f []*codegen.Function) {
+//
+// If extensible, the callbacks may also accept any Type, for the types resolved
+// by extensions.
+func (r *ResolverGenerator) resolverFunctions(name string, extensible bool, comment string) (f []*codegen.Function) {
 	f = append(f, codegen.NewCommentedFunction(
 		r.pkg.Path(),
 		fmt.Sprintf("%s%s", constructorName, name),
@@ -675,7 +790,7 @@ func (r *ResolverGenerator) resolverFunctions(name, comment string) (f []*codege
 				jen.Switch(
 					jen.Id("cb").Assert(jen.Type()),
 				).Block(
-					r.mustAssertToKnownTypes("cb"),
+					r.mustAssertToKnownTypes("cb", extensible),
 				),
 			),
 			jen.Return(
@@ -740,8 +855,9 @@ func (r *ResolverGenerator) predicateResolverMembers() (m []jen.Code) {
 
 // mustAssertToKnownTypes creates the type assertion switch statement that will
 // return an error if the parameter named does not match any of the expected
-// function signatures.
-func (r *ResolverGenerator) mustAssertToKnownTypes(paramName string) jen.Code {
+// function signatures. If extensible, a function accepting any Type is also
+// expected.
+func (r *ResolverGenerator) mustAssertToKnownTypes(paramName string, extensible bool) jen.Code {
 	c := jen.Empty()
 	for _, t := range r.types {
 		c = c.Case(
@@ -755,6 +871,18 @@ func (r *ResolverGenerator) mustAssertToKnownTypes(paramName string) jen.Code {
 			jen.Commentf("Do nothing, this callback has a correct signature."),
 		).Line()
 	}
+	if extensible {
+		c = c.Case(
+			jen.Func().Parens(
+				jen.List(
+					jen.Qual("context", "Context"),
+					jen.Qual(r.types[0].PublicPackage().Path(), typeInterfaceName),
+				),
+			).Error(),
+		).Block(
+			jen.Commentf("Do nothing, this callback accepts the types resolved by extensions."),
+		).Line()
+	}
 	c = c.Default().Block(
 		jen.Return(
 			jen.Nil(),
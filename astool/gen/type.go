@@ -383,6 +383,8 @@ func (t *TypeGenerator) Definition() *codegen.Struct {
 		members := t.members()
 		ser := t.serializationMethod()
 		less := t.lessMethod()
+		clone := t.cloneMethod()
+		equals := t.equalsMethod()
 		get := t.getUnknownMethod()
 		deser := t.deserializationFn()
 		extendsFn, extendsMethod := t.extendsDefinition()
@@ -400,6 +402,8 @@ func (t *TypeGenerator) Definition() *codegen.Struct {
 					extendsMethod,
 					ser,
 					less,
+					clone,
+					equals,
 					get,
 				},
 				ctxMethods...),
@@ -413,6 +417,8 @@ func (t *TypeGenerator) Definition() *codegen.Struct {
 				extendsFn,
 				t.disjointWithDefinition(),
 				deser,
+				cloneUnknownFunction(t.PrivatePackage().Path()),
+				equalsUnknownFunction(t.PrivatePackage().Path()),
 			},
 			members)
 	})
@@ -862,6 +868,78 @@ func (t *TypeGenerator) lessMethod() (less *codegen.Method) {
 	return
 }
 
+// cloneMethod returns the method deeply copying a type and its properties.
+func (t *TypeGenerator) cloneMethod() *codegen.Method {
+	code := []jen.Code{
+		jen.Id("clone").Op(":=").Id(codegen.This()),
+	}
+	for _, prop := range t.allProperties() {
+		member := t.memberName(prop)
+		code = append(code, jen.If(
+			jen.Id(codegen.This()).Dot(member).Op("!=").Nil(),
+		).Block(
+			jen.Id("clone").Dot(member).Op("=").Id(codegen.This()).Dot(member).Dot(cloneMethod).Call(),
+		))
+	}
+	code = append(code,
+		jen.Id("clone").Dot(unknownMember).Op("=").Id(cloneUnknownFnName).Call(
+			jen.Id(codegen.This()).Dot(unknownMember),
+		).Assert(jen.Map(jen.String()).Interface()),
+		jen.Return(jen.Op("&").Id("clone")))
+	return codegen.NewCommentedValueMethod(
+		t.PrivatePackage().Path(),
+		cloneMethod,
+		t.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.Qual(t.PublicPackage().Path(), t.InterfaceName())},
+		code,
+		fmt.Sprintf("%s returns a deep copy of this %s and its properties, which can be changed without changing this one.", cloneMethod, t.TypeName()))
+}
+
+// equalsMethod returns the method determining whether a type and its properties
+// deeply equal another.
+func (t *TypeGenerator) equalsMethod() *codegen.Method {
+	var code []jen.Code
+	for _, prop := range t.allProperties() {
+		code = append(code, jen.If(
+			jen.List(
+				jen.Id("lhs"),
+				jen.Id("rhs"),
+			).Op(":=").List(
+				jen.Id(codegen.This()).Dot(t.memberName(prop)),
+				jen.Id("o").Dot(
+					fmt.Sprintf(getMethodFormat, t.memberName(prop)),
+				).Call(),
+			),
+			jen.Id("lhs").Op("!=").Nil().Op("&&").Id("rhs").Op("!=").Nil(),
+		).Block(
+			jen.If(
+				jen.Op("!").Id("lhs").Dot(equalsMethod).Call(jen.Id("rhs")),
+			).Block(
+				jen.Return(jen.False()),
+			),
+		).Else().If(
+			jen.Id("lhs").Op("!=").Nil().Op("||").Id("rhs").Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.False()),
+		))
+	}
+	code = append(code, jen.Return(jen.Id(equalsUnknownFnName).Call(
+		jen.Id(codegen.This()).Dot(unknownMember),
+		jen.Id("o").Dot(getUnknownMethod).Call(),
+	)))
+	return codegen.NewCommentedValueMethod(
+		t.PrivatePackage().Path(),
+		equalsMethod,
+		t.StructName(),
+		[]jen.Code{
+			jen.Id("o").Qual(t.PublicPackage().Path(), t.InterfaceName()),
+		},
+		[]jen.Code{jen.Bool()},
+		code,
+		fmt.Sprintf("%s determines whether this %s has the same properties as another, comparing them deeply. The @context of deserialized values is ignored.", equalsMethod, t.TypeName()))
+}
+
 // deserializationFn returns free function reference that can be used to
 // treat a TypeGenerator as another property's Kind.
 func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
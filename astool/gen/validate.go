@@ -0,0 +1,338 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+	"sort"
+)
+
+const (
+	violationTypeName      = "Violation"
+	validateFnName         = "Validate"
+	validateTypeFnName     = "validate"
+	validationRulesVarName = "validationRules"
+	disjointTypesFnName    = "validateDisjointTypes"
+	typeNameMatchesFnName  = "typeNameMatches"
+	jsonLDTypePropertyName = "type"
+)
+
+// ValidationGenerator generates the validation of ActivityStreams values
+// against what their ontologies specify: the ranges of properties and the
+// disjointness of types.
+//
+// The rules of specifications that are not expressed in an ontology, such as
+// properties that are required, are left to hand-written code appending to
+// the generated rules variable.
+type ValidationGenerator struct {
+	pkg   Package
+	types []*TypeGenerator
+}
+
+// NewValidationGenerator creates a new generator for the validation of all
+// the types into the package.
+func NewValidationGenerator(pkg Package, types []*TypeGenerator) *ValidationGenerator {
+	return &ValidationGenerator{
+		pkg:   pkg,
+		types: types,
+	}
+}
+
+// Definition returns the code of the validation.
+func (v *ValidationGenerator) Definition() (c []jen.Code) {
+	c = append(c,
+		v.violationDefinition(),
+		v.rulesDefinition(),
+		v.validateFunction().Definition(),
+		v.validateTypeFunction().Definition(),
+		v.disjointTypesFunction().Definition(),
+		v.typeNameMatchesFunction().Definition())
+	for _, p := range v.allProperties() {
+		c = append(c, v.validatePropertyFunction(p).Definition())
+	}
+	return
+}
+
+// vocabPath returns the path of the package of the vocabulary interfaces.
+func (v *ValidationGenerator) vocabPath() string {
+	return v.types[0].PublicPackage().Path()
+}
+
+// allProperties returns the properties of all types, in a stable order.
+func (v *ValidationGenerator) allProperties() []Property {
+	seen := make(map[Property]bool)
+	var props sortedProperty
+	for _, t := range v.types {
+		for _, p := range t.allProperties() {
+			if !seen[p] {
+				seen[p] = true
+				props = append(props, p)
+			}
+		}
+	}
+	sort.Sort(props)
+	return props
+}
+
+// validatePropertyFnName returns the name of the function validating a
+// property.
+func (v *ValidationGenerator) validatePropertyFnName(p Property) string {
+	return fmt.Sprintf("%s%s", validateTypeFnName, p.StructName())
+}
+
+// violationDefinition returns the definition of a violation.
+func (v *ValidationGenerator) violationDefinition() jen.Code {
+	return jen.Commentf(
+		"%s is a way in which an ActivityStreams value does not follow its", violationTypeName,
+	).Line().Commentf("specification.").Line().Type().Id(violationTypeName).Struct(
+		jen.Commentf("Path is the JSON Pointer (RFC 6901) to the offending value in the").Line().
+			Commentf("serialized form, such as \"/object/totalItems\" or \"/tag/1/href\". The").Line().
+			Commentf("values of multi-valued properties are indexed. It is empty for the").Line().
+			Commentf("validated value itself.").Line().
+			Id("Path").String(),
+		jen.Commentf("Message describes the violation.").Line().
+			Id("Message").String(),
+	).Line().Line().Commentf(
+		"Error returns the path and the message of the violation.",
+	).Line().Func().Params(
+		jen.Id("v").Id(violationTypeName),
+	).Id("Error").Params().String().Block(
+		jen.If(jen.Len(jen.Id("v").Dot("Path")).Op("==").Lit(0)).Block(
+			jen.Return(jen.Id("v").Dot("Message")),
+		),
+		jen.Return(jen.Id("v").Dot("Path").Op("+").Lit(": ").Op("+").Id("v").Dot("Message")),
+	)
+}
+
+// rulesDefinition returns the variable of the rules not expressed in the
+// ontologies.
+func (v *ValidationGenerator) rulesDefinition() jen.Code {
+	return jen.Commentf(
+		"%s are the rules of specifications that are not expressed in", validationRulesVarName,
+	).Line().Commentf(
+		"their ontology, which are applied to the validated value and every value it",
+	).Line().Commentf(
+		"embeds. The paths of the violations they return are relative to the value.",
+	).Line().Var().Id(validationRulesVarName).Index().Func().Params(
+		jen.Id("t").Qual(v.vocabPath(), typeInterfaceName),
+	).Index().Id(violationTypeName)
+}
+
+// validateFunction returns the public function validating a value.
+func (v *ValidationGenerator) validateFunction() *codegen.Function {
+	return codegen.NewCommentedFunction(
+		v.pkg.Path(),
+		validateFnName,
+		[]jen.Code{jen.Id("t").Qual(v.vocabPath(), typeInterfaceName)},
+		[]jen.Code{jen.Index().Id(violationTypeName)},
+		[]jen.Code{
+			jen.Return(jen.Id(validateTypeFnName).Call(jen.Id("t"), jen.Lit(""), jen.Nil())),
+		},
+		fmt.Sprintf("%s returns the ways in which a value and the values it embeds do not follow their specification, or nil if they do. Values of properties outside of their range, such as a negative integer for an xsd:nonNegativeInteger, and types disjoint with the type of a value are violations. Values referred to by IRI are not validated.", validateFnName))
+}
+
+// validateTypeFunction returns the function validating a value at a path
+// with the rules and the ontology.
+func (v *ValidationGenerator) validateTypeFunction() *codegen.Function {
+	cases := make([]jen.Code, 0, len(v.types))
+	for _, t := range v.types {
+		var code []jen.Code
+		props := t.allProperties()
+		for _, p := range props {
+			if p.PropertyName() != jsonLDTypePropertyName {
+				continue
+			}
+			var disjoint []string
+			seen := make(map[string]bool)
+			for _, name := range t.getAllDisjointWith() {
+				if !seen[name] {
+					seen[name] = true
+					disjoint = append(disjoint, name)
+				}
+			}
+			if len(disjoint) == 0 {
+				continue
+			}
+			sort.Strings(disjoint)
+			lits := make([]jen.Code, 0, len(disjoint))
+			for _, name := range disjoint {
+				lits = append(lits, jen.Lit(name))
+			}
+			code = append(code, jen.Id("violations").Op("=").Id(disjointTypesFnName).Call(
+				jen.Id("v").Dot(fmt.Sprintf(getMethodFormat, t.memberName(p))).Call(),
+				jen.Id("path"),
+				jen.Id("v").Dot(typeNameMethod).Call(),
+				jen.Index().String().Values(lits...),
+				jen.Id("violations"),
+			))
+		}
+		for _, p := range props {
+			code = append(code, jen.Id("violations").Op("=").Id(v.validatePropertyFnName(p)).Call(
+				jen.Id("v").Dot(fmt.Sprintf(getMethodFormat, t.memberName(p))).Call(),
+				jen.Id("path"),
+				jen.Id("violations"),
+			))
+		}
+		cases = append(cases, jen.Case(
+			jen.Qual(t.PublicPackage().Path(), t.InterfaceName()),
+		).Block(code...))
+	}
+	return codegen.NewCommentedFunction(
+		v.pkg.Path(),
+		validateTypeFnName,
+		[]jen.Code{
+			jen.Id("t").Qual(v.vocabPath(), typeInterfaceName),
+			jen.Id("path").String(),
+			jen.Id("violations").Index().Id(violationTypeName),
+		},
+		[]jen.Code{jen.Index().Id(violationTypeName)},
+		[]jen.Code{
+			jen.For(
+				jen.List(jen.Id("_"), jen.Id("rule")).Op(":=").Range().Id(validationRulesVarName),
+			).Block(
+				jen.For(
+					jen.List(jen.Id("_"), jen.Id("violation")).Op(":=").Range().Id("rule").Call(jen.Id("t")),
+				).Block(
+					jen.Id("violation").Dot("Path").Op("=").Id("path").Op("+").Id("violation").Dot("Path"),
+					jen.Id("violations").Op("=").Append(jen.Id("violations"), jen.Id("violation")),
+				),
+			),
+			jen.Switch(jen.Id("v").Op(":=").Id("t").Assert(jen.Type())).Block(cases...),
+			jen.Return(jen.Id("violations")),
+		},
+		fmt.Sprintf("%s appends the violations of a value at a path, and of the values it embeds.", validateTypeFnName))
+}
+
+// disjointTypesFunction returns the function validating the types of a value
+// are not disjoint with its own.
+func (v *ValidationGenerator) disjointTypesFunction() *codegen.Function {
+	return codegen.NewCommentedFunction(
+		v.pkg.Path(),
+		disjointTypesFnName,
+		[]jen.Code{
+			jen.Id("p").Interface(
+				jen.Id(serializeMethod).Params().Params(jen.Interface(), jen.Error()),
+			),
+			jen.Id("path").String(),
+			jen.Id("typeName").String(),
+			jen.Id("disjoint").Index().String(),
+			jen.Id("violations").Index().Id(violationTypeName),
+		},
+		[]jen.Code{jen.Index().Id(violationTypeName)},
+		[]jen.Code{
+			jen.If(jen.Id("p").Op("==").Nil()).Block(
+				jen.Return(jen.Id("violations")),
+			),
+			jen.List(jen.Id("i"), jen.Err()).Op(":=").Id("p").Dot(serializeMethod).Call(),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Id("violations")),
+			),
+			jen.List(jen.Id("names"), jen.Id("ok")).Op(":=").Id("i").Assert(jen.Index().Interface()),
+			jen.If(jen.Op("!").Id("ok")).Block(
+				jen.Id("names").Op("=").Index().Interface().Values(jen.Id("i")),
+			),
+			jen.For(
+				jen.List(jen.Id("_"), jen.Id("name")).Op(":=").Range().Id("names"),
+			).Block(
+				jen.List(jen.Id("s"), jen.Id("_")).Op(":=").Id("name").Assert(jen.String()),
+				jen.For(
+					jen.List(jen.Id("_"), jen.Id("d")).Op(":=").Range().Id("disjoint"),
+				).Block(
+					jen.If(jen.Id(typeNameMatchesFnName).Call(jen.Id("s"), jen.Id("d"))).Block(
+						jen.Id("violations").Op("=").Append(jen.Id("violations"), jen.Id(violationTypeName).Values(jen.Dict{
+							jen.Id("Path"): jen.Id("path").Op("+").Lit("/type"),
+							jen.Id("Message"): jen.Qual("fmt", "Sprintf").Call(
+								jen.Lit("%s is disjoint with %s"),
+								jen.Id("d"),
+								jen.Id("typeName"),
+							),
+						})),
+					),
+				),
+			),
+			jen.Return(jen.Id("violations")),
+		},
+		fmt.Sprintf("%s appends a violation for each type of a value that is disjoint with its own.", disjointTypesFnName))
+}
+
+// typeNameMatchesFunction returns the function determining whether a type
+// of a value is the type with a name.
+func (v *ValidationGenerator) typeNameMatchesFunction() *codegen.Function {
+	return codegen.NewCommentedFunction(
+		v.pkg.Path(),
+		typeNameMatchesFnName,
+		[]jen.Code{jen.List(jen.Id("s"), jen.Id("name")).String()},
+		[]jen.Code{jen.Bool()},
+		[]jen.Code{
+			jen.Return(
+				jen.Id("s").Op("==").Id("name").Op("||").
+					Qual("strings", "HasSuffix").Call(jen.Id("s"), jen.Lit(":").Op("+").Id("name")).Op("||").
+					Qual("strings", "HasSuffix").Call(jen.Id("s"), jen.Lit("#").Op("+").Id("name")),
+			),
+		},
+		fmt.Sprintf("%s determines whether a type of a value, which may be prefixed by the alias or the IRI of its vocabulary, is the type with the name.", typeNameMatchesFnName))
+}
+
+// validatePropertyFunction returns the function validating the values of a
+// property are in its range, and validating the values it embeds.
+func (v *ValidationGenerator) validatePropertyFunction(p Property) *codegen.Function {
+	var pg *PropertyGenerator
+	var valueCode func(value, path jen.Code) []jen.Code
+	valueCode = func(value, path jen.Code) []jen.Code {
+		check := jen.If(
+			jen.Add(value).Dot(kindIndexMethod).Call().Op("==").Lit(-1),
+		).Block(
+			jen.Id("violations").Op("=").Append(jen.Id("violations"), jen.Id(violationTypeName).Values(jen.Dict{
+				jen.Id("Path"):    path,
+				jen.Id("Message"): jen.Lit(fmt.Sprintf("value is not in the range of %s", p.PropertyName())),
+			})),
+		)
+		if pg.hasTypeKind() {
+			check = check.Else().If(
+				jen.Id("t").Op(":=").Add(value).Dot(fmt.Sprintf("Get%s", typeInterfaceName)).Call(),
+				jen.Id("t").Op("!=").Nil(),
+			).Block(
+				jen.Id("violations").Op("=").Id(validateTypeFnName).Call(jen.Id("t"), path, jen.Id("violations")),
+			)
+		}
+		return []jen.Code{check}
+	}
+	var code []jen.Code
+	switch prop := p.(type) {
+	case *FunctionalPropertyGenerator:
+		pg = &prop.PropertyGenerator
+		code = valueCode(jen.Id("p"), jen.Id("path"))
+	case *NonFunctionalPropertyGenerator:
+		pg = &prop.PropertyGenerator
+		code = []jen.Code{
+			jen.For(
+				jen.Id("i").Op(":=").Lit(0),
+				jen.Id("i").Op("<").Id("p").Dot(lenMethod).Call(),
+				jen.Id("i").Op("++"),
+			).Block(
+				valueCode(
+					jen.Id("p").Dot(atMethodName).Call(jen.Id("i")),
+					jen.Id("path").Op("+").Lit("/").Op("+").Qual("strconv", "Itoa").Call(jen.Id("i")),
+				)...,
+			),
+		}
+	default:
+		panic("unknown property type")
+	}
+	return codegen.NewCommentedFunction(
+		v.pkg.Path(),
+		v.validatePropertyFnName(p),
+		[]jen.Code{
+			jen.Id("p").Qual(p.GetPublicPackage().Path(), p.InterfaceName()),
+			jen.Id("path").String(),
+			jen.Id("violations").Index().Id(violationTypeName),
+		},
+		[]jen.Code{jen.Index().Id(violationTypeName)},
+		append([]jen.Code{
+			jen.If(jen.Id("p").Op("==").Nil()).Block(
+				jen.Return(jen.Id("violations")),
+			),
+			jen.Id("path").Op("=").Id("path").Op("+").Lit("/").Op("+").Id("p").Dot(nameMethod).Call(),
+		}, append(code, jen.Return(jen.Id("violations")))...),
+		fmt.Sprintf("%s appends the violations of the values of the %q property that are not in its range, and of the values it embeds.", v.validatePropertyFnName(p), p.PropertyName()))
+}
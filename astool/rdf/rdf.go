@@ -295,13 +295,20 @@ func (r *RDFRegistry) getNode(s string) (n RDFNode, e error) {
 			return
 		}
 	} else if len(strs) == 1 {
+		// Prefer the most specific ontology, as one specification URI
+		// may be the prefix of another.
+		var match Ontology
 		for _, ontology := range r.ontologies {
-			if strings.HasPrefix(s, ontology.SpecURI()) {
-				n, e = ontology.GetByName(s)
-				return
+			if strings.HasPrefix(s, ontology.SpecURI()) &&
+				(match == nil || len(ontology.SpecURI()) > len(match.SpecURI())) {
+				match = ontology
 			}
 		}
-		e = fmt.Errorf("getNode could not find ontology for %s", s)
+		if match == nil {
+			e = fmt.Errorf("getNode could not find ontology for %s", s)
+			return
+		}
+		n, e = match.GetByName(s)
 		return
 	} else {
 		e = fmt.Errorf("getNode given unhandled node name: %s", s)
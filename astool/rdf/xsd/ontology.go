@@ -305,6 +305,18 @@ func (d *dateTime) Exit(key string, ctx *rdf.ParsingContext) (bool, error) {
 	return true, fmt.Errorf("xsd dateTime cannot be exited")
 }
 
+// lenientDateTimeLayouts are the layouts accepted for xsd:dateTime values after
+// RFC3339. Fractional seconds are accepted by all of them. Layouts without an
+// offset are interpreted as UTC.
+var lenientDateTimeLayouts = []string{
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+}
+
 // Apply adds the xsd:dateTime value Kind to the XML namespace.
 func (d *dateTime) Apply(key string, value interface{}, ctx *rdf.ParsingContext) (bool, error) {
 	v, err := ctx.GetResultReferenceWithDefaults(XmlSpec, XmlName)
@@ -346,30 +358,40 @@ func (d *dateTime) Apply(key string, value interface{}, ctx *rdf.ParsingContext)
 						).Op(":=").Id(codegen.This()).Assert(jen.String()),
 						jen.Id("ok"),
 					).Block(
-						jen.List(
-							jen.Id("tmp"),
-							jen.Err(),
-						).Op("=").Qual("time", "Parse").Call(
-							jen.Qual("time", "RFC3339"),
-							jen.Id("s"),
-						),
-						jen.If(
-							jen.Err().Op("!=").Nil(),
+						// Peers in the wild send timestamps that are
+						// not strictly RFC3339, such as offsets
+						// without a colon, a space instead of 'T', or
+						// no offset at all. Accept them leniently.
+						jen.For(
+							jen.List(
+								jen.Id("_"),
+								jen.Id("layout"),
+							).Op(":=").Range().Index().String().ValuesFunc(func(g *jen.Group) {
+								g.Qual("time", "RFC3339")
+								for _, l := range lenientDateTimeLayouts {
+									g.Lit(l)
+								}
+							}),
 						).Block(
 							jen.List(
 								jen.Id("tmp"),
 								jen.Err(),
 							).Op("=").Qual("time", "Parse").Call(
-								jen.Lit("2006-01-02T15:04Z07:00"),
+								jen.Id("layout"),
 								jen.Id("s"),
 							),
 							jen.If(
-								jen.Err().Op("!=").Nil(),
+								jen.Err().Op("==").Nil(),
 							).Block(
-								jen.Err().Op("=").Qual("fmt", "Errorf").Call(
-									jen.Lit("%v cannot be interpreted as xsd:datetime"),
-									jen.Id(codegen.This()),
-								),
+								jen.Break(),
+							),
+						),
+						jen.If(
+							jen.Err().Op("!=").Nil(),
+						).Block(
+							jen.Err().Op("=").Qual("fmt", "Errorf").Call(
+								jen.Lit("%v cannot be interpreted as xsd:datetime"),
+								jen.Id(codegen.This()),
 							),
 						),
 					).Else().Block(
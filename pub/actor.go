@@ -106,6 +106,10 @@ type FederatingActor interface {
 	//   - The activity is added to the specified outbox.
 	//   - The activity is prepared and delivered to recipients.
 	//
+	// The returned activity is the one added to the outbox, which keeps
+	// its 'bto' and 'bcc'. They are only removed from the copy delivered
+	// to recipients.
+	//
 	// Note that this function will only behave as expected if the
 	// implementation has been constructed to support federation. This
 	// method will guaranteed work for non-custom Actors. For custom actors,
@@ -0,0 +1,91 @@
+package pub
+
+// ActorOption customizes optional behavior of an Actor built by NewActor,
+// NewSocialActor, NewFederatingActor, or NewCustomActor.
+type ActorOption func(o *actorOptions)
+
+// actorOptions contains the optional configuration of an Actor.
+type actorOptions struct {
+	// observer is notified of the traffic handled by the Actor.
+	observer Observer
+	// inboxLimits bounds the requests to the Actor's inboxes. It is nil if
+	// unbounded.
+	inboxLimits *inboxLimiter
+	// recorder records the requests to the Actor's inboxes. It is nil if
+	// they are not recorded.
+	recorder *TrafficRecorder
+	// sanitizer sanitizes federated objects that are created or updated.
+	// It is nil if they are not sanitized.
+	sanitizer *Sanitizer
+	// tagger links the mentions and hashtags of outgoing objects. It is nil
+	// if they are not linked.
+	tagger *Tagger
+	// validate rejects activities POSTed to inboxes that do not follow the
+	// ActivityStreams specification.
+	validate bool
+}
+
+// newActorOptions applies the options over the default configuration.
+func newActorOptions(opts []ActorOption) actorOptions {
+	o := actorOptions{
+		observer: NoOpObserver{},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithObserver notifies the Observer of the requests handled by the Actor and
+// of the side effects applied for them.
+func WithObserver(obs Observer) ActorOption {
+	return func(o *actorOptions) {
+		o.observer = obs
+	}
+}
+
+// WithInboxLimits bounds the size of activities POSTed to the Actor's inboxes,
+// and the rate at which peers may deliver them.
+func WithInboxLimits(l InboxLimits) ActorOption {
+	return func(o *actorOptions) {
+		o.inboxLimits = newInboxLimiter(l)
+	}
+}
+
+// WithTrafficRecorder records the requests POSTed to the Actor's inboxes,
+// along with the status code of the responses.
+func WithTrafficRecorder(t *TrafficRecorder) ActorOption {
+	return func(o *actorOptions) {
+		o.recorder = t
+	}
+}
+
+// WithSanitizer sanitizes the HTML of the objects of Create and Update
+// activities received from federated peers, before they are stored in the
+// Database and passed to the application's wrapped callbacks.
+//
+// Applications replacing the default Create or Update behavior are responsible
+// for sanitizing those objects themselves, such as by calling Sanitize.
+func WithSanitizer(s *Sanitizer) ActorOption {
+	return func(o *actorOptions) {
+		o.sanitizer = s
+	}
+}
+
+// WithTagger links the '@user@host' mentions and '#tag' hashtags in the content
+// of the objects of Create and Update activities posted to the Actor's outbox or
+// sent by it, tagging the objects and addressing the mentioned actors.
+func WithTagger(t *Tagger) ActorOption {
+	return func(o *actorOptions) {
+		o.tagger = t
+	}
+}
+
+// WithValidation rejects the activities POSTed to the Actor's inboxes that do
+// not follow the ActivityStreams specification, as determined by
+// streams.Validate, with a Bad Request response.
+func WithValidation() ActorOption {
+	return func(o *actorOptions) {
+		o.validate = true
+	}
+}
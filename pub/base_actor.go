@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // baseActor must satisfy the Actor interface.
@@ -35,6 +36,19 @@ type baseActor struct {
 	enableFederatedProtocol bool
 	// clock simply tracks the current time.
 	clock Clock
+	// observer is notified of the requests being handled.
+	observer Observer
+	// inboxLimits bounds the requests to inboxes. It is nil if unbounded.
+	inboxLimits *inboxLimiter
+	// recorder records the requests to inboxes. It is nil if they are not
+	// recorded.
+	recorder *TrafficRecorder
+	// tagger links the mentions and hashtags of outgoing Create and Update
+	// activities. It is nil if they are not linked.
+	tagger *Tagger
+	// validate rejects activities POSTed to inboxes that do not follow the
+	// ActivityStreams specification.
+	validate bool
 }
 
 // baseActorFederating must satisfy the FederatingActor interface.
@@ -64,16 +78,24 @@ type baseActorFederating struct {
 func NewSocialActor(c CommonBehavior,
 	c2s SocialProtocol,
 	db Database,
-	clock Clock) Actor {
+	clock Clock,
+	opts ...ActorOption) Actor {
+	o := newActorOptions(opts)
 	return &baseActor{
 		delegate: &sideEffectActor{
-			common: c,
-			c2s:    c2s,
-			db:     db,
-			clock:  clock,
+			common:   c,
+			c2s:      c2s,
+			db:       db,
+			clock:    clock,
+			observer: o.observer,
 		},
 		enableSocialProtocol: true,
 		clock:                clock,
+		observer:             o.observer,
+		inboxLimits:          o.inboxLimits,
+		recorder:             o.recorder,
+		tagger:               o.tagger,
+		validate:             o.validate,
 	}
 }
 
@@ -92,17 +114,26 @@ func NewSocialActor(c CommonBehavior,
 func NewFederatingActor(c CommonBehavior,
 	s2s FederatingProtocol,
 	db Database,
-	clock Clock) FederatingActor {
+	clock Clock,
+	opts ...ActorOption) FederatingActor {
+	o := newActorOptions(opts)
 	return &baseActorFederating{
 		baseActor{
 			delegate: &sideEffectActor{
-				common: c,
-				s2s:    s2s,
-				db:     db,
-				clock:  clock,
+				common:    c,
+				s2s:       s2s,
+				db:        db,
+				clock:     clock,
+				observer:  o.observer,
+				sanitizer: o.sanitizer,
 			},
 			enableFederatedProtocol: true,
 			clock:                   clock,
+			observer:                o.observer,
+			inboxLimits:             o.inboxLimits,
+			recorder:                o.recorder,
+			tagger:                  o.tagger,
+			validate:                o.validate,
 		},
 	}
 }
@@ -120,19 +151,28 @@ func NewActor(c CommonBehavior,
 	c2s SocialProtocol,
 	s2s FederatingProtocol,
 	db Database,
-	clock Clock) FederatingActor {
+	clock Clock,
+	opts ...ActorOption) FederatingActor {
+	o := newActorOptions(opts)
 	return &baseActorFederating{
 		baseActor{
 			delegate: &sideEffectActor{
-				common: c,
-				c2s:    c2s,
-				s2s:    s2s,
-				db:     db,
-				clock:  clock,
+				common:    c,
+				c2s:       c2s,
+				s2s:       s2s,
+				db:        db,
+				clock:     clock,
+				observer:  o.observer,
+				sanitizer: o.sanitizer,
 			},
 			enableSocialProtocol:    true,
 			enableFederatedProtocol: true,
 			clock:                   clock,
+			observer:                o.observer,
+			inboxLimits:             o.inboxLimits,
+			recorder:                o.recorder,
+			tagger:                  o.tagger,
+			validate:                o.validate,
 		},
 	}
 }
@@ -146,15 +186,25 @@ func NewActor(c CommonBehavior,
 //
 // It is possible to create a DelegateActor that is not ActivityPub compliant.
 // Use with due care.
+//
+// Only the requests are reported to an Observer given as an option. The
+// delegate is responsible for reporting its own side effects.
 func NewCustomActor(delegate DelegateActor,
 	enableSocialProtocol, enableFederatedProtocol bool,
-	clock Clock) FederatingActor {
+	clock Clock,
+	opts ...ActorOption) FederatingActor {
+	o := newActorOptions(opts)
 	return &baseActorFederating{
 		baseActor{
 			delegate:                delegate,
 			enableSocialProtocol:    enableSocialProtocol,
 			enableFederatedProtocol: enableFederatedProtocol,
 			clock:                   clock,
+			observer:                o.observer,
+			inboxLimits:             o.inboxLimits,
+			recorder:                o.recorder,
+			tagger:                  o.tagger,
+			validate:                o.validate,
 		},
 	}
 }
@@ -163,6 +213,18 @@ func NewCustomActor(delegate DelegateActor,
 // actor's inbox independent on an application. It relies on a delegate to
 // implement application specific functionality.
 func (b *baseActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	if b.recorder == nil || !isActivityPubPost(r) {
+		return b.postInbox(c, w, r)
+	}
+	rw, done := b.recorder.recordInbound(w, r)
+	handled, err := b.postInbox(c, rw, r)
+	done(err)
+	return handled, err
+}
+
+// postInbox handles a POST request to an actor's inbox, after it has been set
+// up to be recorded.
+func (b *baseActor) postInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
 	// Do nothing if it is not an ActivityPub POST request.
 	if !isActivityPubPost(r) {
 		return false, nil
@@ -173,24 +235,67 @@ func (b *baseActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 		w.WriteHeader(http.StatusMethodNotAllowed)
 		return true, nil
 	}
+	c = observerOrDefault(b.observer).RequestReceived(c, EndpointPostInbox, r)
+	// Reject bodies that are declared to be too large, and bound the size
+	// of the rest before anything reads them.
+	var now time.Time
+	if b.inboxLimits != nil {
+		if b.inboxLimits.exceedsContentLength(r) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return true, nil
+		}
+		// Throttle domains delivering too many activities before the
+		// effort of authenticating them.
+		if b.inboxLimits.limitsRate() {
+			now = b.clock.Now()
+			if ok, wait := b.inboxLimits.allowDomain(r, now); !ok {
+				writeTooManyRequests(w, wait)
+				return true, nil
+			}
+		}
+		b.inboxLimits.limitBody(r)
+	}
 	// Check the peer request is authentic.
 	c, authenticated, err := b.delegate.AuthenticatePostInbox(c, w, r)
-	if err != nil {
+	observerOrDefault(b.observer).Authenticated(c, EndpointPostInbox, authenticated, err)
+	if err == errBodyTooLarge {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return true, nil
+	} else if err != nil {
 		return true, err
 	} else if !authenticated {
 		return true, nil
 	}
+	// Throttle signers delivering too many activities.
+	if b.inboxLimits != nil && b.inboxLimits.limitsRate() {
+		if ok, wait := b.inboxLimits.allowSigner(c, now); !ok {
+			writeTooManyRequests(w, wait)
+			return true, nil
+		}
+	}
 	// Begin processing the request, but have not yet applied
 	// authorization (ex: blocks). Obtain the activity reject unknown
 	// activities.
 	raw, err := ioutil.ReadAll(r.Body)
-	if err != nil {
+	if err == errBodyTooLarge {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return true, nil
+	} else if err != nil {
 		return true, err
 	}
+	if b.inboxLimits != nil {
+		if err = b.inboxLimits.checkJSON(raw); err == errJSONTooComplex {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return true, nil
+		} else if err != nil {
+			return true, err
+		}
+	}
 	var m map[string]interface{}
 	if err = json.Unmarshal(raw, &m); err != nil {
 		return true, err
 	}
+	NormalizeJSON(m)
 	asValue, err := streams.ToType(c, m)
 	if err != nil && !streams.IsUnmatchedErr(err) {
 		return true, err
@@ -207,6 +312,11 @@ func (b *baseActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 		w.WriteHeader(http.StatusBadRequest)
 		return true, nil
 	}
+	// Reject activities that do not follow the specification.
+	if b.validate && len(streams.Validate(activity)) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		return true, nil
+	}
 	// Allow server implementations to set context data with a hook.
 	c, err = b.delegate.PostInboxRequestBodyHook(c, r, activity)
 	if err != nil {
@@ -255,8 +365,10 @@ func (b *baseActor) GetInbox(c context.Context, w http.ResponseWriter, r *http.R
 	if !isActivityPubGet(r) {
 		return false, nil
 	}
+	c = observerOrDefault(b.observer).RequestReceived(c, EndpointGetInbox, r)
 	// Delegate authenticating and authorizing the request.
 	c, authenticated, err := b.delegate.AuthenticateGetInbox(c, w, r)
+	observerOrDefault(b.observer).Authenticated(c, EndpointGetInbox, authenticated, err)
 	if err != nil {
 		return true, err
 	} else if !authenticated {
@@ -308,8 +420,15 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 		w.WriteHeader(http.StatusMethodNotAllowed)
 		return true, nil
 	}
-	// Delegate authenticating and authorizing the request.
-	c, authenticated, err := b.delegate.AuthenticatePostOutbox(c, w, r)
+	c = observerOrDefault(b.observer).RequestReceived(c, EndpointPostOutbox, r)
+	// Delegate authenticating and authorizing the request, unless it was
+	// already done for the media upload posting it.
+	authenticated := isUploadAuthenticated(c)
+	var err error
+	if !authenticated {
+		c, authenticated, err = b.delegate.AuthenticatePostOutbox(c, w, r)
+	}
+	observerOrDefault(b.observer).Authenticated(c, EndpointPostOutbox, authenticated, err)
 	if err != nil {
 		return true, err
 	} else if !authenticated {
@@ -328,6 +447,7 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 	// not known to go-fed. This prevents accidentally wrapping an Activity
 	// type unknown to go-fed in a Create below. Instead,
 	// streams.ErrUnhandledType will be returned here.
+	NormalizeJSON(m)
 	asValue, err := streams.ToType(c, m)
 	if err != nil && !streams.IsUnmatchedErr(err) {
 		return true, err
@@ -369,8 +489,10 @@ func (b *baseActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.
 	if !isActivityPubGet(r) {
 		return false, nil
 	}
+	c = observerOrDefault(b.observer).RequestReceived(c, EndpointGetOutbox, r)
 	// Delegate authenticating and authorizing the request.
 	c, authenticated, err := b.delegate.AuthenticateGetOutbox(c, w, r)
+	observerOrDefault(b.observer).Authenticated(c, EndpointGetOutbox, authenticated, err)
 	if err != nil {
 		return true, err
 	} else if !authenticated {
@@ -431,6 +553,13 @@ func (b *baseActor) deliver(c context.Context, outbox *url.URL, asValue vocab.Ty
 		err = fmt.Errorf("activity streams value is not an Activity: %T", asValue)
 		return
 	}
+	// Link the mentions and hashtags in the content of new and updated
+	// objects, if configured to.
+	if b.tagger != nil && (streams.IsOrExtendsActivityStreamsCreate(activity) || streams.IsOrExtendsActivityStreamsUpdate(activity)) {
+		if err = b.tagger.Tag(c, activity); err != nil {
+			return
+		}
+	}
 	// Delegate generating new IDs for the activity and all new objects.
 	if err = b.delegate.AddNewIds(c, activity); err != nil {
 		return
@@ -2,6 +2,7 @@ package pub
 
 import (
 	"context"
+	"github.com/go-fed/activity/streams"
 	"github.com/go-fed/activity/streams/vocab"
 	"github.com/golang/mock/gomock"
 	"io/ioutil"
@@ -178,6 +179,20 @@ func TestBaseActorSocialProtocol(t *testing.T) {
 		assertEqual(t, handled, true)
 		assertEqual(t, resp.Code, http.StatusBadRequest)
 	})
+	t.Run("PostOutboxSkipsAuthenticationForUpload", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostOutboxUnknownRequest())
+		// Run the test
+		handled, err := a.PostOutbox(withUploadAuthenticated(ctx), resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
 	t.Run("PostOutboxRespondsWithDataAndHeaders", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
@@ -743,3 +758,58 @@ func TestBaseActor(t *testing.T) {
 		assertEqual(t, respV.Header.Get(locationHeader), testNewActivityIRI)
 	})
 }
+
+// TestBaseActorValidation tests that an Actor built with WithValidation rejects
+// activities to its inbox that do not follow the specification.
+func TestBaseActorValidation(t *testing.T) {
+	// Set up test case
+	setupData()
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (delegate *MockDelegateActor, a Actor) {
+		delegate = NewMockDelegateActor(ctl)
+		a = NewCustomActor(
+			delegate,
+			/*enableSocialProtocol=*/ false,
+			/*enableFederatedProtocol=*/ true,
+			NewMockClock(ctl),
+			WithValidation())
+		return
+	}
+	// Run tests
+	t.Run("RejectsInvalidActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl)
+		invalid := streams.Clone(testCreate).(vocab.ActivityStreamsCreate)
+		invalid.SetActivityStreamsActor(nil)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(invalid))
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+	t.Run("AcceptsValidActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(ctx, req, gomock.Any()).Return(ctx, nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, gomock.Any()).Return(true, nil)
+		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), gomock.Any()).Return(nil)
+		delegate.EXPECT().InboxForwarding(ctx, mustParse(testMyInboxIRI), gomock.Any()).Return(nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+}
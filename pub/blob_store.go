@@ -0,0 +1,122 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// BlobStore stores the binary media uploaded by C2S clients to the
+// uploadMedia endpoint.
+type BlobStore interface {
+	// Put stores the media, returning the IRI it is served at.
+	Put(c context.Context, mediaType string, r io.Reader) (*url.URL, error)
+	// Delete removes media stored by Put, such as when its object could
+	// not be posted to the outbox.
+	Delete(c context.Context, iri *url.URL) error
+}
+
+// FileBlobStore must satisfy the BlobStore interface.
+var _ BlobStore = &FileBlobStore{}
+
+// FileBlobStore is a BlobStore keeping media as files in a local directory.
+//
+// It also serves the files it stores, at the paths of the IRIs it returns.
+type FileBlobStore struct {
+	dir  string
+	base *url.URL
+}
+
+// NewFileBlobStore creates a FileBlobStore keeping media in the directory,
+// which must exist. The IRIs of the media are the base IRI followed by their
+// file names.
+func NewFileBlobStore(dir string, base *url.URL) *FileBlobStore {
+	b := *base
+	if !strings.HasSuffix(b.Path, "/") {
+		b.Path += "/"
+	}
+	return &FileBlobStore{
+		dir:  dir,
+		base: &b,
+	}
+}
+
+// Put writes the media to a new file with an unguessable name.
+func (f *FileBlobStore) Put(c context.Context, mediaType string, r io.Reader) (*url.URL, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	name := hex.EncodeToString(b[:])
+	// Media of other types get no extension, so that they are never served
+	// inline.
+	if isAllowedMediaType(mediaType) {
+		if exts, err := mime.ExtensionsByType(mediaType); err == nil && len(exts) > 0 {
+			name += exts[0]
+		}
+	}
+	file, err := os.OpenFile(filepath.Join(f.dir, name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.Copy(file, r); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, err
+	}
+	if err = file.Close(); err != nil {
+		os.Remove(file.Name())
+		return nil, err
+	}
+	iri := *f.base
+	iri.Path += name
+	return &iri, nil
+}
+
+// Delete removes the file of the media.
+func (f *FileBlobStore) Delete(c context.Context, iri *url.URL) error {
+	name, ok := f.name(iri.Path)
+	if !ok || iri.Host != f.base.Host {
+		return fmt.Errorf("%s was not stored in this FileBlobStore", iri)
+	}
+	return os.Remove(filepath.Join(f.dir, name))
+}
+
+// ServeHTTP serves the media at the path of its IRI. Browsers are prevented
+// from sniffing its type, and media that is not an image, audio, or video is
+// served as an attachment, so that no script can run from the application's
+// origin.
+func (f *FileBlobStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name, ok := f.name(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if mediaType, _, err := mime.ParseMediaType(mime.TypeByExtension(path.Ext(name))); err != nil || !isAllowedMediaType(mediaType) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", "attachment")
+	}
+	http.ServeFile(w, r, filepath.Join(f.dir, name))
+}
+
+// name obtains the file name of the media at the path.
+func (f *FileBlobStore) name(p string) (string, bool) {
+	if !strings.HasPrefix(p, f.base.Path) {
+		return "", false
+	}
+	name := p[len(f.base.Path):]
+	if len(name) == 0 || name != path.Base(name) || strings.HasPrefix(name, ".") {
+		return "", false
+	}
+	return name, true
+}
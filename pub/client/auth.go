@@ -0,0 +1,69 @@
+package client
+
+import (
+	"crypto"
+	"github.com/go-fed/httpsig"
+	"net/http"
+	"sync"
+)
+
+// Authenticator adds the credentials of the actor to the requests of a
+// Client.
+type Authenticator interface {
+	// Authenticate adds credentials to the request. The body is that of
+	// POST requests, and nil otherwise.
+	Authenticate(r *http.Request, body []byte) error
+}
+
+// bearerAuthenticator authenticates requests with an OAuth bearer token.
+type bearerAuthenticator struct {
+	token string
+}
+
+// NewBearerAuthenticator creates an Authenticator using an OAuth 2.0 access
+// token, such as one issued by a pub.OAuthServer.
+func NewBearerAuthenticator(token string) Authenticator {
+	return bearerAuthenticator{token: token}
+}
+
+// Authenticate sets the Authorization header.
+func (b bearerAuthenticator) Authenticate(r *http.Request, body []byte) error {
+	r.Header.Set("Authorization", "Bearer "+b.token)
+	return nil
+}
+
+// httpSigAuthenticator authenticates requests with HTTP Signatures.
+type httpSigAuthenticator struct {
+	getSigner    httpsig.Signer
+	getSignerMu  *sync.Mutex
+	postSigner   httpsig.Signer
+	postSignerMu *sync.Mutex
+	pubKeyId     string
+	privKey      crypto.PrivateKey
+}
+
+// NewHttpSigAuthenticator creates an Authenticator signing requests with the
+// actor's private key, like a pub.HttpSigTransport. The signers must sign the
+// Date header, which the Client sets.
+func NewHttpSigAuthenticator(getSigner, postSigner httpsig.Signer, pubKeyId string, privKey crypto.PrivateKey) Authenticator {
+	return &httpSigAuthenticator{
+		getSigner:    getSigner,
+		getSignerMu:  &sync.Mutex{},
+		postSigner:   postSigner,
+		postSignerMu: &sync.Mutex{},
+		pubKeyId:     pubKeyId,
+		privKey:      privKey,
+	}
+}
+
+// Authenticate signs the request.
+func (h *httpSigAuthenticator) Authenticate(r *http.Request, body []byte) error {
+	if r.Method == http.MethodPost {
+		h.postSignerMu.Lock()
+		defer h.postSignerMu.Unlock()
+		return h.postSigner.SignRequest(h.privKey, h.pubKeyId, r, body)
+	}
+	h.getSignerMu.Lock()
+	defer h.getSignerMu.Unlock()
+	return h.getSigner.SignRequest(h.privKey, h.pubKeyId, r, nil)
+}
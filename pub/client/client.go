@@ -0,0 +1,182 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// activityStreamsMediaType is the media type of ActivityStreams
+	// requests and responses.
+	activityStreamsMediaType = "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\""
+	// httpDate is the format of the Date header.
+	httpDate = "Mon, 02 Jan 2006 15:04:05"
+)
+
+// inboxer is an ActivityStreams type with an 'inbox' property.
+type inboxer interface {
+	GetActivityStreamsInbox() vocab.ActivityStreamsInboxProperty
+}
+
+// outboxer is an ActivityStreams type with an 'outbox' property.
+type outboxer interface {
+	GetActivityStreamsOutbox() vocab.ActivityStreamsOutboxProperty
+}
+
+// Actor is an actor discovered by a Client.
+type Actor struct {
+	// IRI is the 'id' of the actor.
+	IRI *url.URL
+	// Inbox is the IRI of the actor's inbox.
+	Inbox *url.URL
+	// Outbox is the IRI of the actor's outbox.
+	Outbox *url.URL
+	// Value is the actor as served by its server.
+	Value vocab.Type
+}
+
+// Client acts as an actor with the Social API of its server.
+//
+// It may be used concurrently.
+type Client struct {
+	client pub.HttpClient
+	auth   Authenticator
+	clock  pub.Clock
+	agent  string
+}
+
+// NewClient creates a Client sending requests with the HttpClient, such as
+// the standard library's, authenticated by the Authenticator.
+//
+// A nil Authenticator sends unauthenticated requests, which only suffices to
+// read public data. The appAgent identifies the application in the User-Agent
+// header.
+func NewClient(client pub.HttpClient, auth Authenticator, clock pub.Clock, appAgent string) *Client {
+	return &Client{
+		client: client,
+		auth:   auth,
+		clock:  clock,
+		agent:  appAgent,
+	}
+}
+
+// Discover fetches an actor to find its inbox and outbox.
+func (c *Client) Discover(ctx context.Context, actorIRI *url.URL) (*Actor, error) {
+	t, err := c.Get(ctx, actorIRI)
+	if err != nil {
+		return nil, err
+	}
+	a := &Actor{Value: t}
+	if a.IRI, err = pub.GetId(t); err != nil {
+		return nil, err
+	}
+	if v, ok := t.(inboxer); ok && v.GetActivityStreamsInbox() != nil {
+		if a.Inbox, err = pub.ToId(v.GetActivityStreamsInbox()); err != nil {
+			return nil, err
+		}
+	}
+	if v, ok := t.(outboxer); ok && v.GetActivityStreamsOutbox() != nil {
+		if a.Outbox, err = pub.ToId(v.GetActivityStreamsOutbox()); err != nil {
+			return nil, err
+		}
+	}
+	if a.Inbox == nil || a.Outbox == nil {
+		return nil, fmt.Errorf("%s is a %s without an inbox and outbox", actorIRI, t.GetTypeName())
+	}
+	return a, nil
+}
+
+// Get fetches an ActivityStreams value.
+func (c *Client) Get(ctx context.Context, iri *url.URL) (vocab.Type, error) {
+	resp, err := c.do(ctx, http.MethodGet, iri, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET request to %s failed (%d): %s", iri, resp.StatusCode, resp.Status)
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("GET request to %s returned invalid JSON: %s", iri, err)
+	}
+	pub.NormalizeJSON(m)
+	return streams.ToType(ctx, m)
+}
+
+// Post posts an activity to the actor's outbox, returning the IRI the server
+// assigned to it, if it indicated one.
+//
+// A value that is not an activity is first wrapped in a Create activity by
+// the actor, copying its addressing.
+func (c *Client) Post(ctx context.Context, a *Actor, t vocab.Type) (*url.URL, error) {
+	if _, ok := t.(pub.Activity); !ok {
+		create, err := pub.WrapInCreate(ctx, t, a.IRI)
+		if err != nil {
+			return nil, err
+		}
+		t = create
+	}
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.do(ctx, http.MethodPost, a.Outbox, b)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated &&
+		resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusAccepted {
+		return nil, fmt.Errorf("POST request to %s failed (%d): %s", a.Outbox, resp.StatusCode, resp.Status)
+	}
+	location, err := resp.Location()
+	if err == http.ErrNoLocation {
+		return nil, nil
+	}
+	return location, err
+}
+
+// do sends an authenticated request.
+func (c *Client) do(ctx context.Context, method string, iri *url.URL, body []byte) (*http.Response, error) {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, iri.String(), r)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if body != nil {
+		req.Header.Set("Content-Type", activityStreamsMediaType)
+	}
+	req.Header.Set("Accept", activityStreamsMediaType)
+	req.Header.Set("Accept-Charset", "utf-8")
+	req.Header.Set("Date", c.clock.Now().UTC().Format(httpDate)+" GMT")
+	req.Header.Set("User-Agent", c.agent)
+	if c.auth != nil {
+		if err = c.auth.Authenticate(req, body); err != nil {
+			return nil, err
+		}
+	}
+	return c.client.Do(req)
+}
@@ -0,0 +1,247 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/httpsig"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testClock is a Clock at a fixed time.
+type testClock struct{}
+
+func (testClock) Now() time.Time {
+	return time.Date(2000, 2, 3, 4, 5, 6, 0, time.UTC)
+}
+
+// testServer is a C2S server with one actor, whose inbox has two pages.
+type testServer struct {
+	*httptest.Server
+	posted        map[string]interface{}
+	authorization string
+	signature     string
+}
+
+func newTestServer(t *testing.T) *testServer {
+	s := &testServer{}
+	mux := http.NewServeMux()
+	serve := func(path string, v func(base string) map[string]interface{}) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			s.authorization = r.Header.Get("Authorization")
+			s.signature = r.Header.Get("Signature")
+			if r.Method == http.MethodPost {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err = json.Unmarshal(b, &s.posted); err != nil {
+					t.Fatal(err)
+				}
+				w.Header().Set("Location", s.URL+"/activities/1")
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+			w.Header().Set("Content-Type", activityStreamsMediaType)
+			json.NewEncoder(w).Encode(v(s.URL))
+		})
+	}
+	serve("/alice", func(base string) map[string]interface{} {
+		return map[string]interface{}{
+			"@context": "https://www.w3.org/ns/activitystreams",
+			"type":     "Person",
+			"id":       base + "/alice",
+			"inbox":    base + "/alice/inbox",
+			"outbox":   base + "/alice/outbox",
+		}
+	})
+	serve("/alice/inbox", func(base string) map[string]interface{} {
+		return map[string]interface{}{
+			"@context":   "https://www.w3.org/ns/activitystreams",
+			"type":       "OrderedCollection",
+			"id":         base + "/alice/inbox",
+			"totalItems": 3,
+			"first":      base + "/alice/inbox/1",
+		}
+	})
+	serve("/alice/inbox/1", func(base string) map[string]interface{} {
+		return map[string]interface{}{
+			"@context": "https://www.w3.org/ns/activitystreams",
+			"type":     "OrderedCollectionPage",
+			"id":       base + "/alice/inbox/1",
+			"next":     base + "/alice/inbox/2",
+			"orderedItems": []interface{}{
+				map[string]interface{}{
+					"type":  "Like",
+					"id":    base + "/activities/3",
+					"actor": base + "/bob",
+				},
+				base + "/activities/2",
+			},
+		}
+	})
+	serve("/alice/inbox/2", func(base string) map[string]interface{} {
+		return map[string]interface{}{
+			"@context":     "https://www.w3.org/ns/activitystreams",
+			"type":         "OrderedCollectionPage",
+			"id":           base + "/alice/inbox/2",
+			"orderedItems": []interface{}{base + "/activities/1"},
+		}
+	})
+	serve("/alice/outbox", nil)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// TestClient tests acting as an actor with a C2S server.
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Discover", func(t *testing.T) {
+		// Setup
+		s := newTestServer(t)
+		defer s.Close()
+		c := NewClient(http.DefaultClient, nil, testClock{}, "test")
+		// Run the test
+		a, err := c.Discover(ctx, mustParse(s.URL+"/alice"))
+		// Verify results
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.Inbox.String() != s.URL+"/alice/inbox" || a.Outbox.String() != s.URL+"/alice/outbox" {
+			t.Fatalf("unexpected inbox %s and outbox %s", a.Inbox, a.Outbox)
+		}
+	})
+	t.Run("PostWrapsInCreate", func(t *testing.T) {
+		// Setup
+		s := newTestServer(t)
+		defer s.Close()
+		c := NewClient(http.DefaultClient, NewBearerAuthenticator("token"), testClock{}, "test")
+		a, err := c.Discover(ctx, mustParse(s.URL+"/alice"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		note := streams.NewActivityStreamsNote()
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString("hello")
+		note.SetActivityStreamsContent(content)
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(pub.PublicActivityPubIRI))
+		note.SetActivityStreamsTo(to)
+		// Run the test
+		location, err := c.Post(ctx, a, note)
+		// Verify results
+		if err != nil {
+			t.Fatal(err)
+		}
+		if location.String() != s.URL+"/activities/1" {
+			t.Fatalf("unexpected location %s", location)
+		}
+		if s.authorization != "Bearer token" {
+			t.Fatalf("unexpected Authorization header %q", s.authorization)
+		}
+		if s.posted["type"] != "Create" || s.posted["actor"] != s.URL+"/alice" || s.posted["to"] != pub.PublicActivityPubIRI {
+			t.Fatalf("unexpected activity posted: %v", s.posted)
+		}
+		if obj, ok := s.posted["object"].(map[string]interface{}); !ok || obj["content"] != "hello" {
+			t.Fatalf("unexpected object posted: %v", s.posted["object"])
+		}
+	})
+	t.Run("PostActivity", func(t *testing.T) {
+		// Setup
+		s := newTestServer(t)
+		defer s.Close()
+		c := NewClient(http.DefaultClient, nil, testClock{}, "test")
+		a, err := c.Discover(ctx, mustParse(s.URL+"/alice"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		like := streams.NewActivityStreamsLike()
+		obj := streams.NewActivityStreamsObjectProperty()
+		obj.AppendIRI(mustParse(s.URL + "/notes/1"))
+		like.SetActivityStreamsObject(obj)
+		// Run the test
+		_, err = c.Post(ctx, a, like)
+		// Verify results
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.posted["type"] != "Like" {
+			t.Fatalf("unexpected activity posted: %v", s.posted)
+		}
+	})
+	t.Run("ReadInboxPages", func(t *testing.T) {
+		// Setup
+		s := newTestServer(t)
+		defer s.Close()
+		c := NewClient(http.DefaultClient, nil, testClock{}, "test")
+		a, err := c.Discover(ctx, mustParse(s.URL+"/alice"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Run the test
+		var ids []string
+		var embedded int
+		page, err := c.ReadInbox(ctx, a)
+		for ; err == nil && page != nil; page, err = c.NextPage(ctx, page) {
+			for _, item := range page.Items {
+				ids = append(ids, strings.TrimPrefix(item.IRI.String(), s.URL))
+				if item.Value != nil {
+					embedded++
+				}
+			}
+		}
+		// Verify results
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fmt.Sprint(ids) != "[/activities/3 /activities/2 /activities/1]" || embedded != 1 {
+			t.Fatalf("unexpected items %v with %d embedded", ids, embedded)
+		}
+	})
+	t.Run("HttpSigAuthenticator", func(t *testing.T) {
+		// Setup
+		s := newTestServer(t)
+		defer s.Close()
+		key, err := rsa.GenerateKey(rand.Reader, 1024)
+		if err != nil {
+			t.Fatal(err)
+		}
+		headers := []string{httpsig.RequestTarget, "date"}
+		getSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, httpsig.DigestSha256, headers, httpsig.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		postSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, httpsig.DigestSha256, append(headers, "digest"), httpsig.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c := NewClient(http.DefaultClient, NewHttpSigAuthenticator(getSigner, postSigner, s.URL+"/alice#main-key", key), testClock{}, "test")
+		// Run the test
+		_, err = c.Discover(ctx, mustParse(s.URL+"/alice"))
+		// Verify results
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(s.signature, "keyId=\""+s.URL+"/alice#main-key\"") {
+			t.Fatalf("unexpected Signature header %q", s.signature)
+		}
+	})
+}
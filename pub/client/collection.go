@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// Item is an item of a collection, either embedded or referenced by IRI.
+type Item struct {
+	// IRI is the 'id' of the item.
+	IRI *url.URL
+	// Value is the embedded item. It is nil if only its IRI was given;
+	// fetch it with the Client's Get.
+	Value vocab.Type
+}
+
+// Page is a page of the items of a collection.
+type Page struct {
+	// Items are the items in the page.
+	Items []Item
+	// Next is the IRI of the next page, or nil if this is the last one.
+	Next *url.URL
+}
+
+// ReadInbox fetches the first page of the actor's inbox.
+func (c *Client) ReadInbox(ctx context.Context, a *Actor) (*Page, error) {
+	return c.ReadCollection(ctx, a.Inbox)
+}
+
+// ReadOutbox fetches the first page of the actor's outbox.
+func (c *Client) ReadOutbox(ctx context.Context, a *Actor) (*Page, error) {
+	return c.ReadCollection(ctx, a.Outbox)
+}
+
+// ReadCollection fetches the first page of a collection, or the page at the
+// IRI.
+func (c *Client) ReadCollection(ctx context.Context, iri *url.URL) (*Page, error) {
+	t, err := c.Get(ctx, iri)
+	if err != nil {
+		return nil, err
+	}
+	var first pub.IdProperty
+	switch v := t.(type) {
+	case vocab.ActivityStreamsOrderedCollection:
+		if p := v.GetActivityStreamsFirst(); p != nil {
+			first = p
+		} else {
+			return orderedPage(v.GetActivityStreamsOrderedItems(), nil)
+		}
+	case vocab.ActivityStreamsCollection:
+		if p := v.GetActivityStreamsFirst(); p != nil {
+			first = p
+		} else {
+			return unorderedPage(v.GetActivityStreamsItems(), nil)
+		}
+	default:
+		return toPage(t)
+	}
+	if ft := first.GetType(); ft != nil {
+		return toPage(ft)
+	}
+	firstIRI, err := pub.ToId(first)
+	if err != nil {
+		return nil, err
+	}
+	t, err = c.Get(ctx, firstIRI)
+	if err != nil {
+		return nil, err
+	}
+	return toPage(t)
+}
+
+// NextPage fetches the page after the given one, returning nil if it is the
+// last one.
+func (c *Client) NextPage(ctx context.Context, p *Page) (*Page, error) {
+	if p.Next == nil {
+		return nil, nil
+	}
+	t, err := c.Get(ctx, p.Next)
+	if err != nil {
+		return nil, err
+	}
+	return toPage(t)
+}
+
+// toPage obtains the items of a collection page.
+func toPage(t vocab.Type) (*Page, error) {
+	switch v := t.(type) {
+	case vocab.ActivityStreamsOrderedCollectionPage:
+		return orderedPage(v.GetActivityStreamsOrderedItems(), v.GetActivityStreamsNext())
+	case vocab.ActivityStreamsCollectionPage:
+		return unorderedPage(v.GetActivityStreamsItems(), v.GetActivityStreamsNext())
+	default:
+		return nil, fmt.Errorf("%s is not a collection page", t.GetTypeName())
+	}
+}
+
+// orderedPage creates a page from an 'orderedItems' property.
+func orderedPage(items vocab.ActivityStreamsOrderedItemsProperty, next vocab.ActivityStreamsNextProperty) (*Page, error) {
+	var ids []pub.IdProperty
+	if items != nil {
+		for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+			ids = append(ids, iter)
+		}
+	}
+	return newPage(ids, next)
+}
+
+// unorderedPage creates a page from an 'items' property.
+func unorderedPage(items vocab.ActivityStreamsItemsProperty, next vocab.ActivityStreamsNextProperty) (*Page, error) {
+	var ids []pub.IdProperty
+	if items != nil {
+		for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+			ids = append(ids, iter)
+		}
+	}
+	return newPage(ids, next)
+}
+
+// newPage creates a page from its items and the 'next' property.
+func newPage(items []pub.IdProperty, next vocab.ActivityStreamsNextProperty) (*Page, error) {
+	p := &Page{}
+	for _, item := range items {
+		id, err := pub.ToId(item)
+		if err != nil {
+			return nil, err
+		}
+		p.Items = append(p.Items, Item{IRI: id, Value: item.GetType()})
+	}
+	if next != nil {
+		var err error
+		if p.Next, err = pub.ToId(next); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
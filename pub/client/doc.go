@@ -0,0 +1,22 @@
+// Package client is a client of the ActivityPub Social API (Client-to-Server
+// or C2S), for writing bots and clients that act as an actor on any C2S
+// server.
+//
+// A Client discovers an actor's inbox and outbox, posts objects to the outbox,
+// and reads collections such as the inbox page by page:
+//
+//	c := client.NewClient(http.DefaultClient, client.NewBearerAuthenticator(token), clock, "mybot")
+//	me, err := c.Discover(ctx, actorIRI)
+//	note := streams.NewActivityStreamsNote()
+//	// Set the note's content and addressing.
+//	location, err := c.Post(ctx, me, note)
+//	for page, err := c.ReadInbox(ctx, me); err == nil && page != nil; page, err = c.NextPage(ctx, page) {
+//		for _, item := range page.Items {
+//			// Use item.Value, or item.IRI if it was not embedded.
+//		}
+//	}
+//
+// Objects that are not activities are wrapped in a Create activity before they
+// are posted, as the server would. Requests are authenticated with an OAuth
+// bearer token or with HTTP Signatures by an Authenticator.
+package client
@@ -86,4 +86,17 @@ type CommonBehavior interface {
 	// returned Transport so that any private credentials are able to be
 	// garbage collected.
 	NewTransport(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (t Transport, err error)
+	// NewInstanceTransport returns a new Transport on behalf of the
+	// instance actor, which represents this server as a whole instead of
+	// any particular user.
+	//
+	// It is used for requests that no single local actor is responsible
+	// for, such as verifying activities received through a relay. Any
+	// authentication scheme applied on the request must be based on the
+	// instance actor, such as a HTTP Signature using the instance actor's
+	// key. See NewInstanceActor.
+	//
+	// The same guidance on user agents, rate-limiting, retries, and
+	// credential lifetimes as NewTransport applies.
+	NewInstanceTransport(c context.Context, gofedAgent string) (t Transport, err error)
 }
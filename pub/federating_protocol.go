@@ -48,7 +48,9 @@ type FederatingProtocol interface {
 	//
 	// Finally, if the authentication and authorization succeeds, then
 	// authenticated must be true and error nil. The request will continue
-	// to be processed.
+	// to be processed. The returned context should record the id of the
+	// verified key with WithSigner, so that the Actor rate limits the
+	// request by its signer when WithInboxLimits is used.
 	AuthenticatePostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (out context.Context, authenticated bool, err error)
 	// Blocked should determine whether to permit a set of actors given by
 	// their ids are able to interact with this particular end user due to
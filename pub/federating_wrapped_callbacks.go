@@ -123,6 +123,37 @@ type FederatingWrappedCallbacks struct {
 	// received from a federated peer, as delivering Blocks explicitly
 	// deviates from the original ActivityPub specification.
 	Block func(context.Context, vocab.ActivityStreamsBlock) error
+	// IsSubscribedRelay determines whether the given actor is an
+	// ActivityPub relay that this inbox is subscribed to.
+	//
+	// When an Announce is received from a subscribed relay, every announced
+	// activity is dereferenced from its origin to verify it, and then
+	// handled as though it had been delivered to this inbox directly. This
+	// happens even if the announced activity is not addressed to any local
+	// actor. The dereferencing is done on behalf of the instance actor.
+	//
+	// Announced activities are skipped if the FederatingProtocol's Blocked
+	// reports either the origin of their 'id', as an IRI with only a scheme
+	// and host, or their actors as blocked.
+	//
+	// If nil, Announces from relays receive no special treatment.
+	IsSubscribedRelay func(c context.Context, actorIRI *url.URL) (bool, error)
+	// RelayedActivitySkipped is called when an activity announced by a
+	// subscribed relay is not handled, because it could not be
+	// dereferenced or did not pass verification. The iri is nil if the
+	// announced value has no id. The rest of the Announce is still
+	// handled.
+	//
+	// If nil, such activities are skipped silently.
+	RelayedActivitySkipped func(c context.Context, iri *url.URL, err error)
+	// RelayPublicActivities turns this inbox into the inbox of a relay.
+	//
+	// Every public Create, Update, Delete, and Announce received is wrapped
+	// in an Announce and delivered to the followers of the inbox's actor,
+	// after all other side effects have completed. Relay subscriptions are
+	// Follow activities, so OnFollow should be set to
+	// OnFollowAutomaticallyAccept as well.
+	RelayPublicActivities bool
 
 	// Sidechannel data -- this is set at request handling time. These must
 	// be set before the callbacks are used.
@@ -138,6 +169,15 @@ type FederatingWrappedCallbacks struct {
 	deliver func(c context.Context, outboxIRI *url.URL, activity Activity) error
 	// newTransport creates a new Transport.
 	newTransport func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (t Transport, err error)
+	// newInstanceTransport creates a new Transport for the instance actor.
+	newInstanceTransport func(c context.Context, gofedAgent string) (t Transport, err error)
+	// ingest handles an activity as if it were delivered to an inbox.
+	ingest func(c context.Context, inboxIRI *url.URL, activity Activity) error
+	// blocked determines whether any of the actors are blocked.
+	blocked func(c context.Context, actorIRIs []*url.URL) (bool, error)
+	// sanitize removes unsafe HTML from a created or updated object. It is
+	// nil if objects are not sanitized.
+	sanitize func(t vocab.Type)
 }
 
 // callbacks returns the WrappedCallbacks members into a single interface slice
@@ -251,6 +291,7 @@ func (w FederatingWrappedCallbacks) create(c context.Context, a vocab.ActivitySt
 			if err = json.Unmarshal(b, &m); err != nil {
 				return err
 			}
+			NormalizeJSON(m)
 			t, err = streams.ToType(c, m)
 			if err != nil {
 				return err
@@ -258,6 +299,9 @@ func (w FederatingWrappedCallbacks) create(c context.Context, a vocab.ActivitySt
 		} else if t == nil {
 			return fmt.Errorf("cannot handle federated create: object is neither a value nor IRI")
 		}
+		if w.sanitize != nil {
+			w.sanitize(t)
+		}
 		id, err := GetId(t)
 		if err != nil {
 			return err
@@ -299,6 +343,9 @@ func (w FederatingWrappedCallbacks) update(c context.Context, a vocab.ActivitySt
 		if t == nil {
 			return fmt.Errorf("update requires an object to be wholly provided")
 		}
+		if w.sanitize != nil {
+			w.sanitize(t)
+		}
 		id, err := GetId(t)
 		if err != nil {
 			return err
@@ -511,6 +558,7 @@ func (w FederatingWrappedCallbacks) accept(c context.Context, a vocab.ActivitySt
 				if err = json.Unmarshal(b, &m); err != nil {
 					return err
 				}
+				NormalizeJSON(m)
 				t, err = streams.ToType(c, m)
 				if err != nil {
 					return err
@@ -794,6 +842,17 @@ func (w FederatingWrappedCallbacks) announce(c context.Context, a vocab.Activity
 		return err
 	}
 	op := a.GetActivityStreamsObject()
+	// Announces from subscribed relays carry activities that need to be
+	// handled on their own.
+	if w.IsSubscribedRelay != nil {
+		if relayed, err := w.isFromSubscribedRelay(c, a); err != nil {
+			return err
+		} else if relayed {
+			if err := w.ingestRelayed(c, op); err != nil {
+				return err
+			}
+		}
+	}
 	// Create anonymous loop function to be able to properly scope the defer
 	// for the database lock at each iteration.
 	loopFn := func(iter vocab.ActivityStreamsObjectPropertyIterator) error {
@@ -84,3 +84,53 @@ func NewActivityStreamsHandler(db Database, clock Clock) HandlerFunc {
 		return
 	}
 }
+
+// NewNegotiatingHandler creates a HandlerFunc serving ActivityStreams values
+// in the representation preferred by the Accept header of GET requests: either
+// as ActivityStreams like NewActivityStreamsHandler, or in one of the
+// Representations, such as one created by HTMLRepresentation.
+//
+// Requests preferring no representation it serves are not handled, so the
+// caller may serve them otherwise.
+//
+// Strips retrieved ActivityStreams values of sensitive fields ('bto' and 'bcc')
+// before rendering them. Callers are responsible for authorized access to this
+// resource.
+func NewNegotiatingHandler(db Database, clock Clock, reps ...Representation) HandlerFunc {
+	asHandler := NewActivityStreamsHandler(db, clock)
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		if r.Method != "GET" {
+			return
+		}
+		isAS, rep := negotiateGet(r, reps)
+		if isAS {
+			return asHandler(c, w, r)
+		} else if rep == nil {
+			return
+		}
+		isASRequest = true
+		id := requestId(r)
+		// Lock and obtain a copy of the requested ActivityStreams value
+		err = db.Lock(c, id)
+		if err != nil {
+			return
+		}
+		// WARNING: Unlock not deferred
+		t, err := db.Get(c, id)
+		if err != nil {
+			db.Unlock(c, id)
+			return
+		}
+		db.Unlock(c, id)
+		// Unlock must have been called by this point and in every
+		// branch above
+		//
+		// Remove sensitive fields.
+		clearSensitiveFields(t)
+		// Render the fetched value.
+		w.Header().Set(contentTypeHeader, rep.MediaType)
+		addVaryAccept(w.Header())
+		err = rep.Render(c, w, r, t)
+		return
+	}
+}
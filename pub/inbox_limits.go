@@ -0,0 +1,311 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-fed/httpsig"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxTrackedRateLimitKeys bounds the number of actors, domains, and hosts whose
+// rate limits are remembered before idle ones are forgotten.
+const maxTrackedRateLimitKeys = 10000
+
+var (
+	// errBodyTooLarge indicates a request body exceeds the configured limit.
+	errBodyTooLarge = errors.New("request body is too large")
+	// errJSONTooComplex indicates a JSON payload exceeds the configured
+	// nesting depth or array length.
+	errJSONTooComplex = errors.New("JSON payload is too deeply nested or has too many elements")
+)
+
+// InboxLimits bounds the resources a peer server may consume by POSTing to
+// an actor's inbox. Zero values disable the corresponding limit.
+//
+// Domain rate limits apply before authentication, to the host of the keyId of
+// the request's HTTP Signature, or to the request's remote address if it has
+// no signature. Actor rate limits apply after authentication, to the signer
+// of a request, which AuthenticatePostInbox records with WithSigner after
+// verifying the request's signature. The actors claimed by the activity
+// itself are never trusted.
+//
+// Requests exceeding a size limit are answered with a 413 Request Entity Too
+// Large status, and requests exceeding a rate limit with a 429 Too Many
+// Requests status. In both cases, no callbacks of the application are called
+// after authentication, and requests exceeding a domain rate limit are not
+// authenticated at all.
+type InboxLimits struct {
+	// MaxBodyBytes is the maximum size of a request body.
+	MaxBodyBytes int64
+	// MaxJSONDepth is the maximum nesting depth of JSON objects and arrays
+	// in a request body.
+	MaxJSONDepth int
+	// MaxJSONArrayLength is the maximum number of elements of any single
+	// JSON array in a request body.
+	MaxJSONArrayLength int
+	// ActivitiesPerMinutePerActor is the rate at which a single signer is
+	// permitted to deliver activities on average.
+	ActivitiesPerMinutePerActor float64
+	// ActivitiesPerMinutePerDomain is the rate at which all requests from
+	// a single domain are permitted to deliver activities on average.
+	ActivitiesPerMinutePerDomain float64
+	// Burst is the number of activities permitted above the average rates
+	// after a period of inactivity. Values less than one are treated as
+	// one.
+	Burst int
+}
+
+// signerKey is the context key of the signer of a request.
+type signerKey struct{}
+
+// WithSigner returns a context recording the id of the key whose signature of
+// a request was verified. AuthenticatePostInbox implementations call it so
+// that the request is rate limited as coming from the key's owner.
+func WithSigner(c context.Context, keyId *url.URL) context.Context {
+	return context.WithValue(c, signerKey{}, keyId)
+}
+
+// SignerFromContext returns the id of the key that signed a request, as
+// recorded by WithSigner.
+func SignerFromContext(c context.Context) (*url.URL, bool) {
+	keyId, ok := c.Value(signerKey{}).(*url.URL)
+	return keyId, ok && keyId != nil
+}
+
+// inboxLimiter enforces InboxLimits.
+type inboxLimiter struct {
+	limits  InboxLimits
+	actors  *keyedBuckets
+	domains *keyedBuckets
+}
+
+// newInboxLimiter creates an inboxLimiter.
+func newInboxLimiter(l InboxLimits) *inboxLimiter {
+	burst := float64(l.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	return &inboxLimiter{
+		limits:  l,
+		actors:  newKeyedBuckets(l.ActivitiesPerMinutePerActor/60, burst),
+		domains: newKeyedBuckets(l.ActivitiesPerMinutePerDomain/60, burst),
+	}
+}
+
+// exceedsContentLength determines whether the declared length of the body is
+// already too large.
+func (i *inboxLimiter) exceedsContentLength(r *http.Request) bool {
+	return i.limits.MaxBodyBytes > 0 && r.ContentLength > i.limits.MaxBodyBytes
+}
+
+// limitBody replaces the request body with one that fails with
+// errBodyTooLarge when it is read past the limit, including when read by the
+// application during authentication.
+func (i *inboxLimiter) limitBody(r *http.Request) {
+	if i.limits.MaxBodyBytes > 0 {
+		r.Body = &limitedBody{ReadCloser: r.Body, remaining: i.limits.MaxBodyBytes}
+	}
+}
+
+// checkJSON determines whether the raw body is within the JSON limits. It
+// does not allocate the decoded payload, so a hostile payload is rejected
+// before it is ever unmarshalled.
+func (i *inboxLimiter) checkJSON(raw []byte) error {
+	if i.limits.MaxJSONDepth <= 0 && i.limits.MaxJSONArrayLength <= 0 {
+		return nil
+	}
+	return checkJSONComplexity(raw, i.limits.MaxJSONDepth, i.limits.MaxJSONArrayLength)
+}
+
+// limitsRate determines whether any rate limit is enabled.
+func (i *inboxLimiter) limitsRate() bool {
+	return i.limits.ActivitiesPerMinutePerActor > 0 || i.limits.ActivitiesPerMinutePerDomain > 0
+}
+
+// allowDomain takes a token for the domain the request comes from, which is
+// not yet authenticated. Otherwise, it returns false along with how long to
+// wait before trying again.
+func (i *inboxLimiter) allowDomain(r *http.Request, now time.Time) (bool, time.Duration) {
+	return i.domains.allow(requestDomain(r), now)
+}
+
+// allowSigner takes a token for the signer of the request recorded in the
+// context by AuthenticatePostInbox, if any. Otherwise, it returns false along
+// with how long to wait before trying again.
+func (i *inboxLimiter) allowSigner(c context.Context, now time.Time) (bool, time.Duration) {
+	keyId, ok := SignerFromContext(c)
+	if !ok {
+		return true, 0
+	}
+	return i.actors.allow(keyId.String(), now)
+}
+
+// writeTooManyRequests responds with a 429 status and a Retry-After header.
+func writeTooManyRequests(w http.ResponseWriter, wait time.Duration) {
+	secs := int64(math.Ceil(wait.Seconds()))
+	if secs < 1 {
+		secs = 1
+	}
+	w.Header().Set("Retry-After", strconv.FormatInt(secs, 10))
+	w.WriteHeader(http.StatusTooManyRequests)
+}
+
+// limitedBody is a request body that refuses to be read past a limit.
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+// Read reads from the underlying body, failing with errBodyTooLarge once more
+// than the limit has been read.
+func (l *limitedBody) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, errBodyTooLarge
+	}
+	// Read one byte past the limit to detect an oversized body.
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.ReadCloser.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, errBodyTooLarge
+	}
+	return n, err
+}
+
+// checkJSONComplexity scans the JSON tokens of raw, failing if objects and
+// arrays are nested deeper than maxDepth or an array has more than
+// maxArrayLength elements. Non-positive limits are ignored.
+func checkJSONComplexity(raw []byte, maxDepth, maxArrayLength int) error {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	// lengths has an entry per open container: the number of elements so
+	// far for arrays, and -1 for objects.
+	var lengths []int
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if n := len(lengths); n > 0 && lengths[n-1] >= 0 {
+			if d, ok := tok.(json.Delim); !ok || (d != ']' && d != '}') {
+				lengths[n-1]++
+				if maxArrayLength > 0 && lengths[n-1] > maxArrayLength {
+					return errJSONTooComplex
+				}
+			}
+		}
+		d, ok := tok.(json.Delim)
+		if !ok {
+			continue
+		}
+		switch d {
+		case '[':
+			lengths = append(lengths, 0)
+		case '{':
+			lengths = append(lengths, -1)
+		case ']', '}':
+			lengths = lengths[:len(lengths)-1]
+		}
+		if maxDepth > 0 && len(lengths) > maxDepth {
+			return errJSONTooComplex
+		}
+	}
+}
+
+// keyedBuckets is a set of token buckets, one per key, sharing the same
+// rate. A zero rate permits everything.
+type keyedBuckets struct {
+	rate    float64
+	burst   float64
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// tokenBucket is the state of a single key's bucket.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newKeyedBuckets creates buckets refilling at rate tokens per second up to
+// burst tokens.
+func newKeyedBuckets(rate, burst float64) *keyedBuckets {
+	return &keyedBuckets{
+		rate:    rate,
+		burst:   burst,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// allow takes a token from the key's bucket if one is available. Otherwise,
+// it returns how long until one becomes available.
+func (k *keyedBuckets) allow(key string, now time.Time) (bool, time.Duration) {
+	if k.rate <= 0 {
+		return true, 0
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	b, ok := k.buckets[key]
+	if !ok {
+		if len(k.buckets) >= maxTrackedRateLimitKeys {
+			k.forgetIdle(now)
+		}
+		b = &tokenBucket{tokens: k.burst, last: now}
+		k.buckets[key] = b
+	}
+	k.refill(b, now)
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / k.rate * float64(time.Second))
+	}
+	b.tokens--
+	return true, 0
+}
+
+// refill adds the tokens accrued since the bucket was last used.
+func (k *keyedBuckets) refill(b *tokenBucket, now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * k.rate
+		if b.tokens > k.burst {
+			b.tokens = k.burst
+		}
+		b.last = now
+	}
+}
+
+// forgetIdle removes the buckets that have refilled completely, as they are
+// indistinguishable from new ones.
+func (k *keyedBuckets) forgetIdle(now time.Time) {
+	for key, b := range k.buckets {
+		k.refill(b, now)
+		if b.tokens >= k.burst {
+			delete(k.buckets, key)
+		}
+	}
+}
+
+// requestDomain obtains the domain a request claims to come from: the host of
+// the keyId of its HTTP Signature, or else its remote address.
+func requestDomain(r *http.Request) string {
+	if v, err := httpsig.NewVerifier(r); err == nil {
+		if keyId, err := url.Parse(v.KeyId()); err == nil && len(keyId.Host) > 0 {
+			return keyId.Host
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return host
+}
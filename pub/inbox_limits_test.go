@@ -0,0 +1,255 @@
+package pub
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestCheckJSONComplexity tests bounding the shape of JSON payloads.
+func TestCheckJSONComplexity(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		maxDepth       int
+		maxArrayLength int
+		expected       error
+	}{
+		{"Flat Object", `{"a":1,"b":"c"}`, 1, 1, nil},
+		{"Nested Within Depth", `{"a":{"b":[1]}}`, 3, 1, nil},
+		{"Nested Too Deeply", `{"a":{"b":[1]}}`, 2, 0, errJSONTooComplex},
+		{"Array Within Length", `{"a":[1,{"b":2},[3]]}`, 0, 3, nil},
+		{"Array Too Long", `{"a":[1,{"b":2},[3],4]}`, 0, 3, errJSONTooComplex},
+		{"Object Keys Are Not Elements", `{"a":1,"b":2,"c":3}`, 0, 1, nil},
+		{"No Limits", `[[[[[1,2,3,4]]]]]`, 0, 0, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkJSONComplexity([]byte(test.input), test.maxDepth, test.maxArrayLength)
+			assertEqual(t, err, test.expected)
+		})
+	}
+}
+
+// TestKeyedBuckets tests the token buckets used to rate limit peers.
+func TestKeyedBuckets(t *testing.T) {
+	now := time.Date(2000, 2, 3, 4, 5, 6, 0, time.UTC)
+	k := newKeyedBuckets(1, 2)
+	ok, _ := k.allow("a", now)
+	assertEqual(t, ok, true)
+	ok, _ = k.allow("a", now)
+	assertEqual(t, ok, true)
+	ok, wait := k.allow("a", now)
+	assertEqual(t, ok, false)
+	assertEqual(t, wait, time.Second)
+	// Other keys are independent.
+	ok, _ = k.allow("b", now)
+	assertEqual(t, ok, true)
+	// Tokens are refilled over time.
+	ok, _ = k.allow("a", now.Add(time.Second))
+	assertEqual(t, ok, true)
+}
+
+// TestKeyedBucketsConcurrently tests that concurrent requests take no more
+// tokens than the burst.
+func TestKeyedBucketsConcurrently(t *testing.T) {
+	now := time.Date(2000, 2, 3, 4, 5, 6, 0, time.UTC)
+	k := newKeyedBuckets(1, 5)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	allowed := 0
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if ok, _ := k.allow("a", now); ok {
+				mu.Lock()
+				allowed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assertEqual(t, allowed, 5)
+}
+
+// TestBaseActorInboxLimits tests that an Actor rejects abusive requests to
+// its inbox before calling the application.
+func TestBaseActorInboxLimits(t *testing.T) {
+	// Set up test case
+	setupData()
+	ctx := context.Background()
+	now := time.Date(2000, 2, 3, 4, 5, 6, 0, time.UTC)
+	setupFn := func(ctl *gomock.Controller, l InboxLimits) (delegate *MockDelegateActor, clock *MockClock, a Actor) {
+		delegate = NewMockDelegateActor(ctl)
+		clock = NewMockClock(ctl)
+		a = NewCustomActor(
+			delegate,
+			/*enableSocialProtocol=*/ false,
+			/*enableFederatedProtocol=*/ true,
+			clock,
+			WithInboxLimits(l))
+		return
+	}
+	// Run tests
+	t.Run("RejectsDeclaredLargeBody", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, a := setupFn(ctl, InboxLimits{MaxBodyBytes: 10})
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusRequestEntityTooLarge)
+	})
+	t.Run("RejectsUndeclaredLargeBody", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, _, a := setupFn(ctl, InboxLimits{MaxBodyBytes: 10})
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		req.ContentLength = -1
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusRequestEntityTooLarge)
+	})
+	t.Run("RejectsDeeplyNestedBody", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, _, a := setupFn(ctl, InboxLimits{MaxJSONDepth: 1})
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusRequestEntityTooLarge)
+	})
+	t.Run("RejectsTooManyActivitiesFromActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, clock, a := setupFn(ctl, InboxLimits{ActivitiesPerMinutePerActor: 1})
+		clock.EXPECT().Now().Return(now).Times(2)
+		signed := WithSigner(ctx, mustParse(testFederatedActorIRI+"#main-key"))
+		delegate.EXPECT().AuthenticatePostInbox(gomock.Any(), gomock.Any(), gomock.Any()).Return(signed, true, nil).Times(2)
+		delegate.EXPECT().PostInboxRequestBodyHook(gomock.Any(), gomock.Any(), gomock.Any()).Return(ctx, nil)
+		delegate.EXPECT().AuthorizePostInbox(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+		delegate.EXPECT().PostInbox(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		delegate.EXPECT().InboxForwarding(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		post := func() *httptest.ResponseRecorder {
+			resp := httptest.NewRecorder()
+			req := toAPRequest(toPostInboxRequest(testCreate))
+			_, err := a.PostInbox(ctx, resp, req)
+			assertEqual(t, err, nil)
+			return resp
+		}
+		// Run the test
+		first := post()
+		second := post()
+		// Verify results
+		assertEqual(t, first.Code, http.StatusOK)
+		assertEqual(t, second.Code, http.StatusTooManyRequests)
+		assertEqual(t, second.Header().Get("Retry-After"), "60")
+	})
+	t.Run("DoesNotChargeSpoofedActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, clock, a := setupFn(ctl, InboxLimits{ActivitiesPerMinutePerActor: 1})
+		clock.EXPECT().Now().Return(now).Times(2)
+		attacker := WithSigner(ctx, mustParse("https://evil.example.com/mallory#main-key"))
+		victim := WithSigner(ctx, mustParse(testFederatedActorIRI+"#main-key"))
+		gomock.InOrder(
+			delegate.EXPECT().AuthenticatePostInbox(gomock.Any(), gomock.Any(), gomock.Any()).Return(attacker, true, nil),
+			delegate.EXPECT().AuthenticatePostInbox(gomock.Any(), gomock.Any(), gomock.Any()).Return(victim, true, nil),
+		)
+		delegate.EXPECT().PostInboxRequestBodyHook(gomock.Any(), gomock.Any(), gomock.Any()).Return(ctx, nil).Times(2)
+		delegate.EXPECT().AuthorizePostInbox(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+		delegate.EXPECT().PostInbox(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		delegate.EXPECT().InboxForwarding(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		post := func() *httptest.ResponseRecorder {
+			resp := httptest.NewRecorder()
+			// The activity claims to be by the victim.
+			req := toAPRequest(toPostInboxRequest(testCreate))
+			_, err := a.PostInbox(ctx, resp, req)
+			assertEqual(t, err, nil)
+			return resp
+		}
+		// Run the test
+		spoofed := post()
+		genuine := post()
+		// Verify results
+		assertEqual(t, spoofed.Code, http.StatusOK)
+		assertEqual(t, genuine.Code, http.StatusOK)
+	})
+	t.Run("RejectsTooManyActivitiesFromDomainBeforeAuthenticating", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, clock, a := setupFn(ctl, InboxLimits{ActivitiesPerMinutePerDomain: 1})
+		clock.EXPECT().Now().Return(now).Times(3)
+		signed := WithSigner(ctx, mustParse(testFederatedActorIRI+"#main-key"))
+		delegate.EXPECT().AuthenticatePostInbox(gomock.Any(), gomock.Any(), gomock.Any()).Return(signed, true, nil).Times(2)
+		delegate.EXPECT().PostInboxRequestBodyHook(gomock.Any(), gomock.Any(), gomock.Any()).Return(ctx, nil).Times(2)
+		delegate.EXPECT().AuthorizePostInbox(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+		delegate.EXPECT().PostInbox(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		delegate.EXPECT().InboxForwarding(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		post := func(keyId string) *httptest.ResponseRecorder {
+			resp := httptest.NewRecorder()
+			req := toAPRequest(toPostInboxRequest(testCreate))
+			req.Header.Set("Signature", `keyId="`+keyId+`",algorithm="rsa-sha256",headers="date",signature="c2lnbmF0dXJl"`)
+			_, err := a.PostInbox(ctx, resp, req)
+			assertEqual(t, err, nil)
+			return resp
+		}
+		// Run the test
+		accepted := post(testFederatedActorIRI + "#main-key")
+		limited := post(testFederatedActorIRI2 + "#main-key")
+		otherDomain := post("https://another.example.com/actor#main-key")
+		// Verify results
+		assertEqual(t, accepted.Code, http.StatusOK)
+		assertEqual(t, limited.Code, http.StatusTooManyRequests)
+		assertEqual(t, limited.Header().Get("Retry-After"), "60")
+		assertEqual(t, otherDomain.Code, http.StatusOK)
+	})
+}
+
+// TestRequestDomain tests keying domain rate limits on the signature of a
+// request.
+func TestRequestDomain(t *testing.T) {
+	r := httptest.NewRequest("POST", testMyInboxIRI, nil)
+	r.RemoteAddr = "192.0.2.1:1234"
+	assertEqual(t, requestDomain(r), "192.0.2.1")
+	r.Header.Set("Signature", `keyId="`+testFederatedActorIRI+`#main-key",algorithm="rsa-sha256",headers="date",signature="c2lnbmF0dXJl"`)
+	assertEqual(t, requestDomain(r), "other.example.com")
+}
+
+// TestLimitedBody tests reading a request body up to a limit.
+func TestLimitedBody(t *testing.T) {
+	l := &limitedBody{ReadCloser: ioutil.NopCloser(strings.NewReader("12345")), remaining: 5}
+	b, err := ioutil.ReadAll(l)
+	assertEqual(t, err, nil)
+	assertEqual(t, string(b), "12345")
+	l = &limitedBody{ReadCloser: ioutil.NopCloser(strings.NewReader("123456")), remaining: 5}
+	_, err = ioutil.ReadAll(l)
+	assertEqual(t, err, errBodyTooLarge)
+}
@@ -0,0 +1,98 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/http"
+	"net/url"
+)
+
+// NewInstanceActor creates the instance actor: an Application actor that
+// represents this server as a whole instead of any of its users.
+//
+// Peers use the instance actor's public key to verify the HTTP Signatures on
+// requests made by the server in general, such as dereferencing objects when
+// peers require authorized fetches, fetching keys, or subscribing to relays.
+// The matching private key is used by the Transport returned from
+// CommonBehavior's NewInstanceTransport.
+//
+// The inbox and outbox are required by the ActivityPub specification for all
+// actors, and must be handled by the application like any other actor's.
+//
+// Serve the returned value with NewInstanceActorHandler.
+func NewInstanceActor(id, inbox, outbox *url.URL, preferredUsername string, publicKeyId *url.URL, publicKeyPem string) vocab.ActivityStreamsApplication {
+	actor := streams.NewActivityStreamsApplication()
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.Set(id)
+	actor.SetJSONLDId(idProp)
+	inboxProp := streams.NewActivityStreamsInboxProperty()
+	inboxProp.SetIRI(inbox)
+	actor.SetActivityStreamsInbox(inboxProp)
+	outboxProp := streams.NewActivityStreamsOutboxProperty()
+	outboxProp.SetIRI(outbox)
+	actor.SetActivityStreamsOutbox(outboxProp)
+	username := streams.NewActivityStreamsPreferredUsernameProperty()
+	username.SetXMLSchemaString(preferredUsername)
+	actor.SetActivityStreamsPreferredUsername(username)
+	// The key is embedded so that peers need only a single request to
+	// verify signatures made by the instance actor.
+	key := streams.NewW3IDSecurityV1PublicKey()
+	keyId := streams.NewJSONLDIdProperty()
+	keyId.Set(publicKeyId)
+	key.SetJSONLDId(keyId)
+	owner := streams.NewW3IDSecurityV1OwnerProperty()
+	owner.Set(id)
+	key.SetW3IDSecurityV1Owner(owner)
+	pem := streams.NewW3IDSecurityV1PublicKeyPemProperty()
+	pem.Set(publicKeyPem)
+	key.SetW3IDSecurityV1PublicKeyPem(pem)
+	keyProp := streams.NewW3IDSecurityV1PublicKeyProperty()
+	keyProp.AppendW3IDSecurityV1PublicKey(key)
+	actor.SetW3IDSecurityV1PublicKey(keyProp)
+	return actor
+}
+
+// NewInstanceActorHandler creates a HandlerFunc serving the instance actor
+// created by NewInstanceActor at its 'id'.
+//
+// Requests for other IRIs, or that are not ActivityStreams GET requests, are
+// not handled. Unlike NewActivityStreamsHandler, no authorization is expected
+// from the caller: the instance actor must be publicly available so peers can
+// fetch its key, even when they require signed fetches themselves.
+func NewInstanceActorHandler(actor vocab.ActivityStreamsApplication, clock Clock) HandlerFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		// Do nothing if it is not an ActivityPub GET request
+		if !isActivityPubGet(r) {
+			return
+		}
+		id, err := GetId(actor)
+		if err != nil {
+			return
+		}
+		if requestId(r).String() != id.String() {
+			return
+		}
+		isASRequest = true
+		m, err := streams.Serialize(actor)
+		if err != nil {
+			return
+		}
+		raw, err := json.Marshal(m)
+		if err != nil {
+			return
+		}
+		addResponseHeaders(w.Header(), clock, raw)
+		w.WriteHeader(http.StatusOK)
+		n, err := w.Write(raw)
+		if err != nil {
+			return
+		} else if n != len(raw) {
+			err = fmt.Errorf("only wrote %d of %d bytes", n, len(raw))
+			return
+		}
+		return
+	}
+}
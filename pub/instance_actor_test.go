@@ -0,0 +1,74 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	testInstanceActorIRI = "https://example.com/actor"
+	testInstanceKeyIRI   = "https://example.com/actor#main-key"
+	testInstancePem      = "-----BEGIN PUBLIC KEY-----\ntest\n-----END PUBLIC KEY-----"
+)
+
+// TestInstanceActorHandler tests serving the instance actor.
+func TestInstanceActorHandler(t *testing.T) {
+	ctx := context.Background()
+	newActorFn := func() vocab.ActivityStreamsApplication {
+		return NewInstanceActor(
+			mustParse(testInstanceActorIRI),
+			mustParse("https://example.com/actor/inbox"),
+			mustParse("https://example.com/actor/outbox"),
+			"example.com",
+			mustParse(testInstanceKeyIRI),
+			testInstancePem)
+	}
+	t.Run("ServesActorWithKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(time.Date(2000, 2, 3, 4, 5, 6, 7, time.UTC))
+		h := NewInstanceActorHandler(newActorFn(), cl)
+		req := toAPRequest(httptest.NewRequest("GET", testInstanceActorIRI, nil))
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := h(ctx, resp, req)
+		// Verify
+		assertEqual(t, isAS, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+		var m map[string]interface{}
+		if err := json.Unmarshal(resp.Body.Bytes(), &m); err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, m["type"], "Application")
+		key, ok := m["publicKey"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("publicKey is not embedded: %v", m["publicKey"])
+		}
+		assertEqual(t, key["id"], testInstanceKeyIRI)
+		assertEqual(t, key["owner"], testInstanceActorIRI)
+		assertEqual(t, key["publicKeyPem"], testInstancePem)
+	})
+	t.Run("IgnoresOtherIRIs", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		h := NewInstanceActorHandler(newActorFn(), cl)
+		req := toAPRequest(httptest.NewRequest("GET", testNoteId1, nil))
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := h(ctx, resp, req)
+		// Verify
+		assertEqual(t, isAS, false)
+		assertEqual(t, err, nil)
+	})
+}
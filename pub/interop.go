@@ -0,0 +1,85 @@
+package pub
+
+import (
+	"strings"
+)
+
+// activityStreamsContext is the JSON-LD context of ActivityStreams.
+const activityStreamsContext = "https://www.w3.org/ns/activitystreams"
+
+// activityStreamsTypePrefixes are the ways peers spell out the namespace of an
+// ActivityStreams type name.
+var activityStreamsTypePrefixes = []string{
+	activityStreamsContext + "#",
+	"http://www.w3.org/ns/activitystreams#",
+	"as:",
+}
+
+// NormalizeJSON rewrites quirks found in the payloads of real-world servers
+// into the form expected by streams.ToType. The map is modified in place.
+//
+// The following quirks are handled:
+//   - A missing '@context' is assumed to be the ActivityStreams one.
+//   - The JSON-LD keywords '@id' and '@type' are treated as 'id' and 'type'.
+//   - Type names written as compact or full ActivityStreams IRIs, such as
+//     "as:Note", are shortened to the plain name.
+//
+// Nested values are normalized too. Other quirks, such as single-element
+// arrays, multiple types, unknown properties, and timestamps that are not
+// strictly RFC3339, are already tolerated by the streams package.
+//
+// The library normalizes every payload it receives before deserializing it,
+// so applications only need to call NormalizeJSON on payloads they obtain by
+// other means.
+func NormalizeJSON(m map[string]interface{}) {
+	if _, ok := m[jsonLDContext]; !ok {
+		m[jsonLDContext] = activityStreamsContext
+	}
+	normalizeValue(m)
+}
+
+// normalizeValue recursively normalizes a JSON value.
+func normalizeValue(v interface{}) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for _, keyword := range []string{"id", "type"} {
+			if val, ok := t["@"+keyword]; ok {
+				if _, exists := t[keyword]; !exists {
+					t[keyword] = val
+				}
+				delete(t, "@"+keyword)
+			}
+		}
+		if typ, ok := t["type"]; ok {
+			t["type"] = normalizeTypeNames(typ)
+		}
+		for k, val := range t {
+			if k == jsonLDContext {
+				continue
+			}
+			normalizeValue(val)
+		}
+	case []interface{}:
+		for _, elem := range t {
+			normalizeValue(elem)
+		}
+	}
+}
+
+// normalizeTypeNames shortens ActivityStreams type IRIs to type names, in a
+// single name or an array of them.
+func normalizeTypeNames(v interface{}) interface{} {
+	switch t := v.(type) {
+	case string:
+		for _, prefix := range activityStreamsTypePrefixes {
+			if strings.HasPrefix(t, prefix) {
+				return strings.TrimPrefix(t, prefix)
+			}
+		}
+	case []interface{}:
+		for i, elem := range t {
+			t[i] = normalizeTypeNames(elem)
+		}
+	}
+	return v
+}
@@ -0,0 +1,251 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// interopFixtures are payloads of real-world servers, in testdata/interop,
+// along with the type each is expected to deserialize as. An empty type name
+// means the type is expected to be unmatched.
+var interopFixtures = []struct {
+	file     string
+	typeName string
+	activity bool
+}{
+	{"mastodon_create_note.json", "Create", true},
+	{"mastodon_person.json", "Person", false},
+	{"pleroma_announce.json", "Announce", true},
+	{"akkoma_emoji_react.json", "", false},
+	{"misskey_create_note.json", "Create", true},
+	{"misskey_like.json", "Like", true},
+	{"peertube_create_video.json", "Create", true},
+	{"lemmy_announce_create_page.json", "Announce", true},
+	{"writefreely_create_article.json", "Create", true},
+	{"quirks_compact_types.json", "Create", true},
+	{"quirks_missing_context.json", "Follow", true},
+	{"quirks_dates.json", "Create", true},
+}
+
+// readInteropFixture reads a fixture from testdata/interop.
+func readInteropFixture(t *testing.T, file string) []byte {
+	b, err := ioutil.ReadFile(filepath.Join("testdata", "interop", file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// normalizedInteropFixture reads a fixture and normalizes it.
+func normalizedInteropFixture(t *testing.T, file string) map[string]interface{} {
+	var m map[string]interface{}
+	if err := json.Unmarshal(readInteropFixture(t, file), &m); err != nil {
+		t.Fatal(err)
+	}
+	NormalizeJSON(m)
+	return m
+}
+
+// serializeToJSON serializes a type into its canonical JSON bytes.
+func serializeToJSON(t *testing.T, v vocab.Type) []byte {
+	m, err := streams.Serialize(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// TestNormalizeJSON tests rewriting the quirks of real-world payloads.
+func TestNormalizeJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Adds Missing Context",
+			input:    `{"type":"Note"}`,
+			expected: `{"@context":"https://www.w3.org/ns/activitystreams","type":"Note"}`,
+		},
+		{
+			name:     "Keeps Existing Context",
+			input:    `{"@context":["https://www.w3.org/ns/activitystreams",{"as":"https://www.w3.org/ns/activitystreams#"}],"type":"Note"}`,
+			expected: `{"@context":["https://www.w3.org/ns/activitystreams",{"as":"https://www.w3.org/ns/activitystreams#"}],"type":"Note"}`,
+		},
+		{
+			name:     "Renames JSON-LD Keywords",
+			input:    `{"@context":"https://www.w3.org/ns/activitystreams","@id":"https://example.com/1","@type":"Note"}`,
+			expected: `{"@context":"https://www.w3.org/ns/activitystreams","id":"https://example.com/1","type":"Note"}`,
+		},
+		{
+			name:     "Prefers Plain Keywords",
+			input:    `{"@context":"https://www.w3.org/ns/activitystreams","@id":"https://example.com/1","id":"https://example.com/2"}`,
+			expected: `{"@context":"https://www.w3.org/ns/activitystreams","id":"https://example.com/2"}`,
+		},
+		{
+			name:     "Shortens Type IRIs",
+			input:    `{"@context":"https://www.w3.org/ns/activitystreams","type":["as:Create","http://www.w3.org/ns/activitystreams#Note","https://example.com/ns#Post"]}`,
+			expected: `{"@context":"https://www.w3.org/ns/activitystreams","type":["Create","Note","https://example.com/ns#Post"]}`,
+		},
+		{
+			name:     "Normalizes Nested Values",
+			input:    `{"@context":"https://www.w3.org/ns/activitystreams","type":"Create","object":[{"@type":"as:Note"}]}`,
+			expected: `{"@context":"https://www.w3.org/ns/activitystreams","object":[{"type":"Note"}],"type":"Create"}`,
+		},
+		{
+			name:     "Leaves Context Alone",
+			input:    `{"@context":[{"focalPoint":{"@container":"@list","@id":"toot:focalPoint"}}],"type":"Note"}`,
+			expected: `{"@context":[{"focalPoint":{"@container":"@list","@id":"toot:focalPoint"}}],"type":"Note"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Setup
+			var m map[string]interface{}
+			if err := json.Unmarshal([]byte(test.input), &m); err != nil {
+				t.Fatal(err)
+			}
+			// Run
+			NormalizeJSON(m)
+			// Verify
+			b, err := json.Marshal(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, string(b), test.expected)
+		})
+	}
+}
+
+// TestInteropFixturesToType tests that payloads of real-world servers
+// deserialize as the expected type, and survive a round trip unchanged.
+func TestInteropFixturesToType(t *testing.T) {
+	ctx := context.Background()
+	for _, test := range interopFixtures {
+		t.Run(test.file, func(t *testing.T) {
+			// Setup
+			m := normalizedInteropFixture(t, test.file)
+			// Run
+			v, err := streams.ToType(ctx, m)
+			// Verify
+			if test.typeName == "" {
+				assertEqual(t, streams.IsUnmatchedErr(err), true)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, v.GetTypeName(), test.typeName)
+			first := serializeToJSON(t, v)
+			var again map[string]interface{}
+			if err := json.Unmarshal(first, &again); err != nil {
+				t.Fatal(err)
+			}
+			v, err = streams.ToType(ctx, again)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertByteEqual(t, serializeToJSON(t, v), first)
+		})
+	}
+}
+
+// TestInteropFixtureDates tests that timestamps which are not strictly RFC3339
+// are still understood.
+func TestInteropFixtureDates(t *testing.T) {
+	// Setup
+	m := normalizedInteropFixture(t, "quirks_dates.json")
+	expected := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	// Run
+	v, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Verify
+	create := v.(vocab.ActivityStreamsCreate)
+	published := create.GetActivityStreamsPublished()
+	assertEqual(t, published.IsXMLSchemaDateTime(), true)
+	assertEqual(t, published.Get().Equal(expected), true)
+	note := create.GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+	assertEqual(t, note.GetActivityStreamsPublished().IsXMLSchemaDateTime(), true)
+	assertEqual(t, note.GetActivityStreamsPublished().Get().Equal(expected), true)
+	assertEqual(t, note.GetActivityStreamsUpdated().IsXMLSchemaDateTime(), true)
+	assertEqual(t, note.GetActivityStreamsUpdated().Get().Equal(expected), true)
+}
+
+// TestInteropFixturesPostInbox tests that payloads of real-world servers are
+// accepted by a federating Actor's inbox, and are dispatched to the wrapped
+// callback of their type as their normalized form.
+func TestInteropFixturesPostInbox(t *testing.T) {
+	ctx := context.Background()
+	for _, test := range interopFixtures {
+		if !test.activity {
+			continue
+		}
+		t.Run(test.file, func(t *testing.T) {
+			// Setup
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			common := NewMockCommonBehavior(ctl)
+			fp := NewMockFederatingProtocol(ctl)
+			db := NewMockDatabase(ctl)
+			a := NewFederatingActor(common, fp, db, NewMockClock(ctl))
+			expected, err := streams.ToType(ctx, normalizedInteropFixture(t, test.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req := toAPRequest(httptest.NewRequest("POST", testMyInboxIRI, bytes.NewBuffer(readInteropFixture(t, test.file))))
+			resp := httptest.NewRecorder()
+			inboxIRI := mustParse(testMyInboxIRI)
+			var dispatched vocab.Type
+			record := func(c context.Context, v vocab.Type) error {
+				dispatched = v
+				return nil
+			}
+			fp.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+			fp.EXPECT().PostInboxRequestBodyHook(ctx, req, gomock.Any()).Return(ctx, nil)
+			fp.EXPECT().Blocked(ctx, gomock.Any()).Return(false, nil)
+			fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{
+				Create:   func(c context.Context, v vocab.ActivityStreamsCreate) error { return record(c, v) },
+				Announce: func(c context.Context, v vocab.ActivityStreamsAnnounce) error { return record(c, v) },
+				Like:     func(c context.Context, v vocab.ActivityStreamsLike) error { return record(c, v) },
+				Follow:   func(c context.Context, v vocab.ActivityStreamsFollow) error { return record(c, v) },
+				OnFollow: OnFollowDoNothing,
+			}, nil, nil)
+			db.EXPECT().Lock(ctx, gomock.Any()).Return(nil).AnyTimes()
+			db.EXPECT().Unlock(ctx, gomock.Any()).Return(nil).AnyTimes()
+			db.EXPECT().InboxContains(ctx, inboxIRI, gomock.Any()).Return(false, nil)
+			db.EXPECT().GetInbox(ctx, inboxIRI).Return(streams.NewActivityStreamsOrderedCollectionPage(), nil)
+			db.EXPECT().SetInbox(ctx, gomock.Any()).Return(nil)
+			db.EXPECT().ActorForInbox(ctx, inboxIRI).Return(mustParse(testPersonIRI), nil).AnyTimes()
+			db.EXPECT().Owns(ctx, gomock.Any()).Return(false, nil).AnyTimes()
+			db.EXPECT().Create(ctx, gomock.Any()).Return(nil).AnyTimes()
+			db.EXPECT().Exists(ctx, gomock.Any()).Return(false, nil).AnyTimes()
+			// Run
+			handled, err := a.PostInbox(ctx, resp, req)
+			// Verify
+			assertEqual(t, err, nil)
+			assertEqual(t, handled, true)
+			assertEqual(t, resp.Code, http.StatusOK)
+			if dispatched == nil {
+				t.Fatal("activity was not dispatched to a callback")
+			}
+			assertEqual(t, dispatched.GetTypeName(), test.typeName)
+			assertEqual(t, string(serializeToJSON(t, dispatched)), string(serializeToJSON(t, expected)))
+		})
+	}
+}
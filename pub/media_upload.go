@@ -0,0 +1,299 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultMaxMediaSize is the default limit of the size of uploads.
+	defaultMaxMediaSize = 40 << 20
+	// maxMediaMemory is the size of uploads kept in memory rather than in
+	// temporary files while parsing them.
+	maxMediaMemory = 1 << 20
+	// mediaFileField is the multipart field of the uploaded media.
+	mediaFileField = "file"
+	// mediaObjectField is the multipart field of the shell object.
+	mediaObjectField = "object"
+)
+
+// MediaInfo describes uploaded media.
+type MediaInfo struct {
+	// Width is the width in pixels of images and videos, or zero if
+	// unknown.
+	Width int
+	// Height is the height in pixels of images and videos, or zero if
+	// unknown.
+	Height int
+	// Duration is the length of audio and videos, or zero if unknown.
+	Duration time.Duration
+}
+
+// MediaProber describes uploaded media of the media type.
+type MediaProber func(mediaType string, r io.Reader) (MediaInfo, error)
+
+// ProbeImage is a MediaProber obtaining the dimensions of GIF, JPEG, and PNG
+// images. It describes other media as unknown.
+func ProbeImage(mediaType string, r io.Reader) (MediaInfo, error) {
+	switch mediaType {
+	case "image/gif", "image/jpeg", "image/png":
+		cfg, _, err := image.DecodeConfig(r)
+		if err != nil {
+			return MediaInfo{}, err
+		}
+		return MediaInfo{Width: cfg.Width, Height: cfg.Height}, nil
+	default:
+		return MediaInfo{}, nil
+	}
+}
+
+// MediaUploadConfig configures the handler of an uploadMedia endpoint.
+type MediaUploadConfig struct {
+	// Store stores the uploaded media.
+	Store BlobStore
+	// Authenticate authenticates and authorizes the upload before the
+	// media is stored. It has the semantics of SocialProtocol's
+	// AuthenticatePostOutbox, which is typically used. The Actor's
+	// PostOutbox is passed the context it returns, and Actors created by
+	// this package do not authenticate the posted object again, since
+	// credentials such as HTTP Signatures cover the original upload rather
+	// than the object.
+	Authenticate func(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error)
+	// Outbox returns the IRI of the outbox of the actor uploading the
+	// media, given the request to their uploadMedia endpoint.
+	Outbox func(c context.Context, r *http.Request) (*url.URL, error)
+	// Probe describes the media. Defaults to ProbeImage.
+	Probe MediaProber
+	// MaxSize is the largest size of an upload in bytes. Defaults to 40
+	// MiB.
+	MaxSize int64
+}
+
+// NewMediaUploadHandler creates a HandlerFunc serving an actor's uploadMedia
+// endpoint, with which C2S clients upload binary media.
+//
+// It handles multipart/form-data POST requests, whose "file" part is the media
+// and whose optional "object" part is the JSON of a shell object describing
+// it. The media type is detected from the contents of the media, and only
+// images, audio, and videos are accepted. The media is stored in the
+// BlobStore, and the shell object, or else a new Image, Video, Audio, or
+// Document, gets its 'mediaType', 'url', 'width', 'height', and 'duration'
+// properties set. The object is then posted to the actor's outbox with the
+// Actor's PostOutbox, which wraps it in a Create and responds to the client.
+//
+// The stored media is deleted if the object is not posted.
+func NewMediaUploadHandler(a Actor, cfg MediaUploadConfig) HandlerFunc {
+	if cfg.Probe == nil {
+		cfg.Probe = ProbeImage
+	}
+	if cfg.MaxSize <= 0 {
+		cfg.MaxSize = defaultMaxMediaSize
+	}
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		// Do nothing if it is not a media upload.
+		if r.Method != "POST" {
+			return
+		} else if mediaType, _, err := mime.ParseMediaType(r.Header.Get(contentTypeHeader)); err != nil || mediaType != "multipart/form-data" {
+			return false, nil
+		}
+		isASRequest = true
+		// Bound the body before anything, including authentication,
+		// reads it.
+		r.Body = http.MaxBytesReader(w, r.Body, cfg.MaxSize)
+		c, authenticated, err := cfg.Authenticate(c, w, r)
+		if err != nil || !authenticated {
+			return
+		}
+		outbox, err := cfg.Outbox(c, r)
+		if err != nil {
+			return
+		}
+		if err = r.ParseMultipartForm(maxMediaMemory); err != nil {
+			http.Error(w, "malformed upload", http.StatusBadRequest)
+			return true, nil
+		}
+		defer r.MultipartForm.RemoveAll()
+		file, _, err := r.FormFile(mediaFileField)
+		if err != nil {
+			http.Error(w, "missing file", http.StatusBadRequest)
+			return true, nil
+		}
+		defer file.Close()
+		mediaType, err := uploadedMediaType(file)
+		if err != nil {
+			return
+		} else if !isAllowedMediaType(mediaType) {
+			http.Error(w, "unsupported media type", http.StatusUnsupportedMediaType)
+			return true, nil
+		}
+		info, err := cfg.Probe(mediaType, file)
+		if err != nil {
+			http.Error(w, "unreadable media", http.StatusBadRequest)
+			return true, nil
+		} else if _, err = file.Seek(0, io.SeekStart); err != nil {
+			return
+		}
+		t, err := mediaObject(c, r.FormValue(mediaObjectField), mediaType)
+		if err != nil {
+			http.Error(w, "invalid object", http.StatusBadRequest)
+			return true, nil
+		}
+		iri, err := cfg.Store.Put(c, mediaType, file)
+		if err != nil {
+			return
+		}
+		setMediaProperties(t, iri, mediaType, info)
+		handled, err := postMediaObject(c, a, w, r, outbox, t)
+		if err != nil || !handled {
+			cfg.Store.Delete(c, iri)
+		}
+		return
+	}
+}
+
+// uploadedMediaType determines the media type of the upload from its contents.
+// The Content-Type declared by the client is not trusted, since media is served
+// from the application's origin.
+func uploadedMediaType(file io.ReadSeeker) (string, error) {
+	var b [512]byte
+	n, err := io.ReadFull(file, b[:])
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", err
+	} else if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(b[:n]))
+	return mediaType, err
+}
+
+// isAllowedMediaType determines whether media of the type may be uploaded and
+// served inline: images, audio, and videos, but not SVG images which may
+// contain scripts.
+func isAllowedMediaType(mediaType string) bool {
+	if mediaType == "image/svg+xml" {
+		return false
+	}
+	return strings.HasPrefix(mediaType, "image/") ||
+		strings.HasPrefix(mediaType, "audio/") ||
+		strings.HasPrefix(mediaType, "video/")
+}
+
+// mediaObject creates the object describing uploaded media, from the shell
+// object if there is one.
+func mediaObject(c context.Context, shell, mediaType string) (vocab.Type, error) {
+	if len(shell) > 0 {
+		var m map[string]interface{}
+		if err := json.Unmarshal([]byte(shell), &m); err != nil {
+			return nil, err
+		}
+		NormalizeJSON(m)
+		return streams.ToType(c, m)
+	}
+	switch {
+	case strings.HasPrefix(mediaType, "image/"):
+		return streams.NewActivityStreamsImage(), nil
+	case strings.HasPrefix(mediaType, "video/"):
+		return streams.NewActivityStreamsVideo(), nil
+	case strings.HasPrefix(mediaType, "audio/"):
+		return streams.NewActivityStreamsAudio(), nil
+	default:
+		return streams.NewActivityStreamsDocument(), nil
+	}
+}
+
+// setMediaProperties describes the stored media in the object. The 'url' is a
+// Link with the media type and dimensions, since only Images have 'width' and
+// 'height' properties themselves.
+func setMediaProperties(t vocab.Type, iri *url.URL, mediaType string, info MediaInfo) {
+	link := streams.NewActivityStreamsLink()
+	href := streams.NewActivityStreamsHrefProperty()
+	href.Set(iri)
+	link.SetActivityStreamsHref(href)
+	mt := streams.NewActivityStreamsMediaTypeProperty()
+	mt.Set(mediaType)
+	link.SetActivityStreamsMediaType(mt)
+	if v, ok := t.(mediaTyper); ok {
+		mt = streams.NewActivityStreamsMediaTypeProperty()
+		mt.Set(mediaType)
+		v.SetActivityStreamsMediaType(mt)
+	}
+	if info.Width > 0 && info.Height > 0 {
+		width := streams.NewActivityStreamsWidthProperty()
+		width.Set(info.Width)
+		link.SetActivityStreamsWidth(width)
+		height := streams.NewActivityStreamsHeightProperty()
+		height.Set(info.Height)
+		link.SetActivityStreamsHeight(height)
+		if v, ok := t.(dimensioner); ok {
+			v.SetActivityStreamsWidth(width)
+			v.SetActivityStreamsHeight(height)
+		}
+	}
+	if v, ok := t.(durationer); ok && info.Duration > 0 {
+		d := streams.NewActivityStreamsDurationProperty()
+		d.Set(info.Duration)
+		v.SetActivityStreamsDuration(d)
+	}
+	if v, ok := t.(urler); ok {
+		u := streams.NewActivityStreamsUrlProperty()
+		u.AppendActivityStreamsLink(link)
+		v.SetActivityStreamsUrl(u)
+	}
+}
+
+// uploadAuthenticatedKey is the context key marking outbox requests made for
+// an authenticated media upload.
+type uploadAuthenticatedKey struct{}
+
+// withUploadAuthenticated returns a context marking the outbox request as made
+// for an authenticated media upload.
+func withUploadAuthenticated(c context.Context) context.Context {
+	return context.WithValue(c, uploadAuthenticatedKey{}, true)
+}
+
+// isUploadAuthenticated determines whether the outbox request is made for an
+// authenticated media upload, so must not be authenticated again.
+func isUploadAuthenticated(c context.Context) bool {
+	authenticated, _ := c.Value(uploadAuthenticatedKey{}).(bool)
+	return authenticated
+}
+
+// postMediaObject posts the object to the outbox with the Actor on behalf of
+// the authenticated client. The request keeps the headers of the upload, but
+// is not authenticated again, since signatures over the upload do not match
+// it.
+func postMediaObject(c context.Context, a Actor, w http.ResponseWriter, r *http.Request, outbox *url.URL, t vocab.Type) (bool, error) {
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return true, err
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return true, err
+	}
+	u := *outbox
+	c = withUploadAuthenticated(c)
+	post := r.WithContext(c)
+	post.URL = &u
+	post.Host = outbox.Host
+	post.Header = cloneHeader(r.Header)
+	post.Header.Set(contentTypeHeader, contentTypeHeaderValue)
+	post.Body = ioutil.NopCloser(bytes.NewReader(b))
+	post.ContentLength = int64(len(b))
+	post.Form, post.PostForm, post.MultipartForm = nil, nil, nil
+	return a.PostOutbox(c, w, post)
+}
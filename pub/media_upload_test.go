@@ -0,0 +1,266 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+// uploadTestActor is an Actor recording the object POSTed to its outbox.
+type uploadTestActor struct {
+	routerTestActor
+	posted        map[string]interface{}
+	path          string
+	authenticated bool
+}
+
+// PostOutbox records the posted object.
+func (a *uploadTestActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	a.path = requestId(r).String()
+	a.authenticated = isUploadAuthenticated(c)
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return true, err
+	}
+	if err = json.Unmarshal(b, &a.posted); err != nil {
+		return true, err
+	}
+	return a.record("PostOutbox", c, w)
+}
+
+// testPNG encodes a PNG image of the given dimensions.
+func testPNG(t *testing.T, width, height int) []byte {
+	var b bytes.Buffer
+	if err := png.Encode(&b, image.NewGray(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+// testMP4 is the start of an MP4 video, enough for its type to be detected.
+var testMP4 = []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom")
+
+// testUpload creates a multipart upload of the media and shell object.
+func testUpload(t *testing.T, media []byte, mediaType, object string) *http.Request {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", `form-data; name="file"; filename="upload"`)
+	h.Set(contentTypeHeader, mediaType)
+	part, err := mw.CreatePart(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(media)
+	if len(object) > 0 {
+		mw.WriteField("object", object)
+	}
+	mw.Close()
+	r := httptest.NewRequest("POST", "https://example.com/addison/uploadMedia", &body)
+	r.Header.Set(contentTypeHeader, mw.FormDataContentType())
+	r.Header.Set("Authorization", "Bearer token")
+	return r
+}
+
+// TestProbeImage tests obtaining the dimensions of images.
+func TestProbeImage(t *testing.T) {
+	info, err := ProbeImage("image/png", bytes.NewReader(testPNG(t, 2, 3)))
+	assertEqual(t, err, nil)
+	assertEqual(t, info, MediaInfo{Width: 2, Height: 3})
+	info, err = ProbeImage("video/mp4", strings.NewReader("not probed"))
+	assertEqual(t, err, nil)
+	assertEqual(t, info, MediaInfo{})
+}
+
+// TestFileBlobStore tests storing, serving, and deleting media files.
+func TestFileBlobStore(t *testing.T) {
+	// Setup
+	dir, err := ioutil.TempDir("", "blobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	f := NewFileBlobStore(dir, mustParse("https://example.com/media"))
+	c := context.Background()
+	// Run the test
+	iri, err := f.Put(c, "text/plain", strings.NewReader("hello"))
+	assertEqual(t, err, nil)
+	resp := httptest.NewRecorder()
+	f.ServeHTTP(resp, httptest.NewRequest("GET", iri.String(), nil))
+	imageIRI, err := f.Put(c, "image/png", bytes.NewReader(testPNG(t, 1, 1)))
+	assertEqual(t, err, nil)
+	imageResp := httptest.NewRecorder()
+	f.ServeHTTP(imageResp, httptest.NewRequest("GET", imageIRI.String(), nil))
+	traversal := httptest.NewRecorder()
+	f.ServeHTTP(traversal, httptest.NewRequest("GET", "https://example.com/media/../blobs", nil))
+	// Verify results
+	assertEqual(t, strings.HasPrefix(iri.String(), "https://example.com/media/"), true)
+	assertEqual(t, resp.Code, http.StatusOK)
+	assertEqual(t, resp.Body.String(), "hello")
+	assertEqual(t, resp.Header().Get("X-Content-Type-Options"), "nosniff")
+	assertEqual(t, resp.Header().Get("Content-Disposition"), "attachment")
+	assertEqual(t, resp.Header().Get(contentTypeHeader), "application/octet-stream")
+	assertEqual(t, imageResp.Code, http.StatusOK)
+	assertEqual(t, imageResp.Header().Get("X-Content-Type-Options"), "nosniff")
+	assertEqual(t, imageResp.Header().Get("Content-Disposition"), "")
+	assertEqual(t, imageResp.Header().Get(contentTypeHeader), "image/png")
+	assertEqual(t, traversal.Code, http.StatusNotFound)
+	assertEqual(t, f.Delete(c, iri), nil)
+	assertEqual(t, f.Delete(c, imageIRI), nil)
+	files, _ := ioutil.ReadDir(dir)
+	assertEqual(t, len(files), 0)
+	assertEqual(t, f.Delete(c, mustParse("https://other.example.com/media/x")) != nil, true)
+}
+
+// TestMediaUploadHandler tests uploading media to the uploadMedia endpoint.
+func TestMediaUploadHandler(t *testing.T) {
+	// Set up test case
+	setupData()
+	c := context.Background()
+	setupFn := func(t *testing.T, authenticated bool) (a *uploadTestActor, dir string, hf HandlerFunc) {
+		var err error
+		dir, err = ioutil.TempDir("", "blobs")
+		if err != nil {
+			t.Fatal(err)
+		}
+		a = &uploadTestActor{routerTestActor: routerTestActor{handled: true}}
+		hf = NewMediaUploadHandler(a, MediaUploadConfig{
+			Store: NewFileBlobStore(dir, mustParse("https://example.com/media/")),
+			Authenticate: func(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+				if !authenticated {
+					w.WriteHeader(http.StatusUnauthorized)
+				}
+				return c, authenticated, nil
+			},
+			Outbox: func(c context.Context, r *http.Request) (*url.URL, error) {
+				return mustParse(testMyOutboxIRI), nil
+			},
+		})
+		return
+	}
+	countFiles := func(dir string) int {
+		files, _ := ioutil.ReadDir(dir)
+		return len(files)
+	}
+	// Run tests
+	t.Run("PostsImageToOutbox", func(t *testing.T) {
+		// Setup
+		a, dir, hf := setupFn(t, true)
+		defer os.RemoveAll(dir)
+		resp := httptest.NewRecorder()
+		req := testUpload(t, testPNG(t, 2, 3), "image/png", `{"type": "Image", "name": "a cat"}`)
+		// Run the test
+		handled, err := hf(c, resp, req)
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, a.path, testMyOutboxIRI)
+		assertEqual(t, a.authenticated, true)
+		assertEqual(t, a.posted["type"], "Image")
+		assertEqual(t, a.posted["name"], "a cat")
+		assertEqual(t, a.posted["mediaType"], "image/png")
+		assertEqual(t, a.posted["width"], float64(2))
+		assertEqual(t, a.posted["height"], float64(3))
+		link, ok := a.posted["url"].(map[string]interface{})
+		assertEqual(t, ok, true)
+		assertEqual(t, strings.HasPrefix(link["href"].(string), "https://example.com/media/"), true)
+		assertEqual(t, link["mediaType"], "image/png")
+		assertEqual(t, countFiles(dir), 1)
+	})
+	t.Run("CreatesObjectByMediaType", func(t *testing.T) {
+		// Setup
+		a, dir, hf := setupFn(t, true)
+		defer os.RemoveAll(dir)
+		resp := httptest.NewRecorder()
+		req := testUpload(t, testMP4, "application/octet-stream", "")
+		// Run the test
+		handled, err := hf(c, resp, req)
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, a.posted["type"], "Video")
+		assertEqual(t, a.posted["mediaType"], "video/mp4")
+		_, hasWidth := a.posted["width"]
+		assertEqual(t, hasWidth, false)
+	})
+	t.Run("RejectsOtherMediaTypes", func(t *testing.T) {
+		for _, test := range []struct {
+			name      string
+			media     []byte
+			mediaType string
+		}{
+			{"HTMLDeclaredAsImage", []byte("<html><script>alert(1)</script></html>"), "image/png"},
+			{"HTML", []byte("<html><script>alert(1)</script></html>"), "text/html"},
+			{"SVG", []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`), "image/svg+xml"},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				// Setup
+				a, dir, hf := setupFn(t, true)
+				defer os.RemoveAll(dir)
+				resp := httptest.NewRecorder()
+				req := testUpload(t, test.media, test.mediaType, "")
+				// Run the test
+				handled, err := hf(c, resp, req)
+				// Verify results
+				assertEqual(t, handled, true)
+				assertEqual(t, err, nil)
+				assertEqual(t, resp.Code, http.StatusUnsupportedMediaType)
+				assertEqual(t, a.called, "")
+				assertEqual(t, countFiles(dir), 0)
+			})
+		}
+	})
+	t.Run("DeletesMediaWhenNotPosted", func(t *testing.T) {
+		// Setup
+		a, dir, hf := setupFn(t, true)
+		defer os.RemoveAll(dir)
+		a.err = errors.New("expected")
+		resp := httptest.NewRecorder()
+		req := testUpload(t, testPNG(t, 2, 3), "image/png", "")
+		// Run the test
+		handled, err := hf(c, resp, req)
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, a.err)
+		assertEqual(t, countFiles(dir), 0)
+	})
+	t.Run("StoresNothingUnauthenticated", func(t *testing.T) {
+		// Setup
+		a, dir, hf := setupFn(t, false)
+		defer os.RemoveAll(dir)
+		resp := httptest.NewRecorder()
+		req := testUpload(t, testPNG(t, 2, 3), "image/png", "")
+		// Run the test
+		handled, err := hf(c, resp, req)
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+		assertEqual(t, a.called, "")
+		assertEqual(t, countFiles(dir), 0)
+	})
+	t.Run("IgnoresOtherRequests", func(t *testing.T) {
+		// Setup
+		_, dir, hf := setupFn(t, true)
+		defer os.RemoveAll(dir)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("POST", testMyOutboxIRI, nil))
+		// Run the test
+		handled, err := hf(c, resp, req)
+		// Verify results
+		assertEqual(t, handled, false)
+		assertEqual(t, err, nil)
+	})
+}
@@ -97,3 +97,18 @@ func (mr *MockCommonBehaviorMockRecorder) NewTransport(c, actorBoxIRI, gofedAgen
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTransport", reflect.TypeOf((*MockCommonBehavior)(nil).NewTransport), c, actorBoxIRI, gofedAgent)
 }
+
+// NewInstanceTransport mocks base method
+func (m *MockCommonBehavior) NewInstanceTransport(c context.Context, gofedAgent string) (Transport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewInstanceTransport", c, gofedAgent)
+	ret0, _ := ret[0].(Transport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewInstanceTransport indicates an expected call of NewInstanceTransport
+func (mr *MockCommonBehaviorMockRecorder) NewInstanceTransport(c, gofedAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewInstanceTransport", reflect.TypeOf((*MockCommonBehavior)(nil).NewInstanceTransport), c, gofedAgent)
+}
@@ -0,0 +1,250 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// HTMLMediaType is the media type of HTML documents.
+	HTMLMediaType = "text/html"
+	// activityJSONMediaType is the ActivityStreams media type without a
+	// profile.
+	activityJSONMediaType = "application/activity+json"
+	// The Vary header.
+	varyHeader = "Vary"
+	// profileParam is the media type parameter distinguishing the JSON-LD
+	// profiles of a media type.
+	profileParam = "profile"
+)
+
+// activityStreamsOffers are the ActivityStreams media types served, in order
+// of preference.
+var activityStreamsOffers = []string{
+	contentTypeHeaderValue,
+	activityJSONMediaType,
+}
+
+// RenderFunc writes a representation of an ActivityStreams value other than
+// its ActivityStreams one, such as an HTML page, to the ResponseWriter. It is
+// responsible for writing the status code.
+type RenderFunc func(c context.Context, w http.ResponseWriter, r *http.Request, t vocab.Type) error
+
+// Representation is a format in which ActivityStreams values are served in
+// addition to the ActivityStreams media types, chosen by the Accept header of
+// requests.
+type Representation struct {
+	// MediaType is the media type of the format, such as "text/html". It
+	// is the Content-Type of responses, unless Render sets another.
+	MediaType string
+	// Render writes the value in the format.
+	Render RenderFunc
+}
+
+// HTMLRepresentation serves ActivityStreams values as HTML pages rendered by
+// the RenderFunc, so that links to them work in web browsers.
+func HTMLRepresentation(render RenderFunc) Representation {
+	return Representation{
+		MediaType: HTMLMediaType,
+		Render:    render,
+	}
+}
+
+// NegotiateMediaType chooses the offered media type most preferred by an
+// Accept header, following RFC 7231 §5.3.2. An empty header accepts any media
+// type.
+//
+// Each offer is weighted by the quality value of the most specific media range
+// matching it. Ties between offers of equal quality are broken by the
+// specificity of their media ranges, then by the order of the offers. It
+// returns false if no offer is acceptable.
+func NegotiateMediaType(accept string, offers []string) (string, bool) {
+	i := negotiate(accept, offers, 0)
+	if i < 0 {
+		return "", false
+	}
+	return offers[i], true
+}
+
+// negotiateGet determines which representation a GET request prefers. It
+// returns true if the ActivityStreams representation is preferred, or else the
+// preferred Representation, or neither if none is acceptable.
+//
+// Unlike other representations, an ActivityStreams one is only chosen when
+// the Accept header names it: wildcards such as "*/*" do not select it, so
+// that web browsers and other generic clients are not served JSON.
+func negotiateGet(r *http.Request, reps []Representation) (isAS bool, rep *Representation) {
+	offers := make([]string, 0, len(activityStreamsOffers)+len(reps))
+	offers = append(offers, activityStreamsOffers...)
+	for _, rp := range reps {
+		offers = append(offers, rp.MediaType)
+	}
+	i := negotiate(strings.Join(r.Header[acceptHeader], ","), offers, len(activityStreamsOffers))
+	if i < 0 {
+		return false, nil
+	} else if i < len(activityStreamsOffers) {
+		return true, nil
+	}
+	return false, &reps[i-len(activityStreamsOffers)]
+}
+
+// negotiate returns the index of the offer most preferred by the Accept
+// header, or -1 if none is acceptable. The first nExplicit offers are only
+// acceptable if a media range without wildcards matches them.
+func negotiate(accept string, offers []string, nExplicit int) int {
+	ranges := parseAccept(accept)
+	if len(strings.TrimSpace(accept)) == 0 {
+		ranges = []mediaRange{{mediaType: "*/*", q: 1}}
+	}
+	best, bestQ, bestSpec := -1, 0.0, -1
+	for i, o := range offers {
+		offerType, offerParams := parseMediaType(o)
+		q, spec := 0.0, -1
+		for _, mr := range ranges {
+			if s, ok := mr.match(offerType, offerParams); ok && s > spec {
+				q, spec = mr.q, s
+			}
+		}
+		if spec < 0 || q <= 0 || (i < nExplicit && spec < mediaRangeExplicit) {
+			continue
+		}
+		if q > bestQ || (q == bestQ && spec > bestSpec) {
+			best, bestQ, bestSpec = i, q, spec
+		}
+	}
+	return best
+}
+
+// mediaRangeExplicit is the specificity of a media range without wildcards.
+const mediaRangeExplicit = 2
+
+// mediaRange is a media range of an Accept header.
+type mediaRange struct {
+	// mediaType is the lowercase type and subtype, either of which may be
+	// the "*" wildcard.
+	mediaType string
+	// params are the parameters other than the quality value.
+	params map[string]string
+	// q is the quality value.
+	q float64
+}
+
+// match determines whether the media range matches the media type, returning
+// its specificity.
+func (mr mediaRange) match(mediaType string, params map[string]string) (int, bool) {
+	var spec int
+	if mr.mediaType == "*/*" {
+		spec = 0
+	} else if strings.HasSuffix(mr.mediaType, "/*") {
+		if !strings.HasPrefix(mediaType, mr.mediaType[:len(mr.mediaType)-1]) {
+			return 0, false
+		}
+		spec = 1
+	} else if mr.mediaType == mediaType {
+		spec = mediaRangeExplicit
+	} else {
+		return 0, false
+	}
+	// Only the profile distinguishes the offers: other parameters, such as
+	// the charset, are ignored.
+	if profile, ok := mr.params[profileParam]; ok {
+		if params[profileParam] != profile {
+			return 0, false
+		}
+		spec++
+	}
+	return spec, true
+}
+
+// parseAccept parses the media ranges of an Accept header, skipping malformed
+// ones.
+//
+// Note we don't try to build a comprehensive parser and instead tolerate the
+// unquoted profiles and whitespace that peers send.
+func parseAccept(accept string) []mediaRange {
+	var ranges []mediaRange
+	for _, s := range splitUnquoted(accept, ',') {
+		mediaType, params := parseMediaType(s)
+		if !strings.Contains(mediaType, "/") {
+			continue
+		}
+		mr := mediaRange{
+			mediaType: mediaType,
+			params:    params,
+			q:         1,
+		}
+		if qs, ok := params["q"]; ok {
+			q, err := strconv.ParseFloat(qs, 64)
+			if err != nil || q < 0 || q > 1 {
+				continue
+			}
+			mr.q = q
+			delete(params, "q")
+		}
+		ranges = append(ranges, mr)
+	}
+	return ranges
+}
+
+// parseMediaType parses a media type, or media range, into its lowercase type
+// and subtype and its parameters.
+func parseMediaType(s string) (string, map[string]string) {
+	parts := splitUnquoted(s, ';')
+	if len(parts) == 0 {
+		return "", nil
+	}
+	mediaType := strings.ToLower(strings.TrimSpace(parts[0]))
+	var params map[string]string
+	for _, p := range parts[1:] {
+		eq := strings.IndexByte(p, '=')
+		if eq < 0 {
+			continue
+		}
+		if params == nil {
+			params = make(map[string]string, len(parts)-1)
+		}
+		k := strings.ToLower(strings.TrimSpace(p[:eq]))
+		v := strings.TrimSpace(p[eq+1:])
+		if len(v) >= 2 && v[0] == '"' && v[len(v)-1] == '"' {
+			v = v[1 : len(v)-1]
+		}
+		params[k] = v
+	}
+	return mediaType, params
+}
+
+// splitUnquoted splits the string around the separator where it is not within
+// a quoted string, omitting empty parts.
+func splitUnquoted(s string, sep byte) []string {
+	var parts []string
+	quoted := false
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		if i < len(s) && s[i] == '"' {
+			quoted = !quoted
+		}
+		if i == len(s) || (s[i] == sep && !quoted) {
+			if p := strings.TrimSpace(s[start:i]); len(p) > 0 {
+				parts = append(parts, p)
+			}
+			start = i + 1
+		}
+	}
+	return parts
+}
+
+// addVaryAccept indicates the response depends on the Accept header of the
+// request, keeping other values of the Vary header.
+func addVaryAccept(h http.Header) {
+	for _, v := range h[varyHeader] {
+		for _, f := range strings.Split(v, ",") {
+			if f = strings.TrimSpace(f); f == "*" || strings.EqualFold(f, acceptHeader) {
+				return
+			}
+		}
+	}
+	h.Add(varyHeader, acceptHeader)
+}
@@ -0,0 +1,180 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestNegotiateMediaType tests choosing among offered media types.
+func TestNegotiateMediaType(t *testing.T) {
+	offers := []string{contentTypeHeaderValue, activityJSONMediaType, HTMLMediaType}
+	tests := []struct {
+		name     string
+		accept   string
+		expected string
+		ok       bool
+	}{
+		{"Empty", "", contentTypeHeaderValue, true},
+		{"Exact", "text/html", HTMLMediaType, true},
+		{"Quality", "text/html;q=0.5, application/activity+json", activityJSONMediaType, true},
+		{"Quality Reversed", "application/activity+json;q=0.5, text/html", HTMLMediaType, true},
+		{"Specific Over Wildcard", "text/*;q=0.2, text/html;q=0.9, */*;q=0.1", HTMLMediaType, true},
+		{"Refused", "application/activity+json;q=0, */*", contentTypeHeaderValue, true},
+		{"Profile", "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\", text/html", contentTypeHeaderValue, true},
+		{"Unquoted Profile", "text/html, application/ld+json;profile=https://www.w3.org/ns/activitystreams", contentTypeHeaderValue, true},
+		{"Other Profile", "application/ld+json; profile=\"https://example.com\"", "", false},
+		{"Charset", "application/activity+json; charset=utf-8", activityJSONMediaType, true},
+		{"Profile And Charset", "text/html, application/ld+json; charset=utf-8; profile=\"https://www.w3.org/ns/activitystreams\"", contentTypeHeaderValue, true},
+		{"Case", "TEXT/HTML", HTMLMediaType, true},
+		{"Malformed Quality", "text/html;q=2, application/activity+json;q=0.1", activityJSONMediaType, true},
+		{"None", "image/png", "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, ok := NegotiateMediaType(test.accept, offers)
+			assertEqual(t, ok, test.ok)
+			assertEqual(t, actual, test.expected)
+		})
+	}
+}
+
+// TestIsActivityPubGet tests detecting requests preferring ActivityStreams.
+func TestIsActivityPubGet(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		accept   []string
+		expected bool
+	}{
+		{"No Accept", "GET", nil, false},
+		{"Activity JSON", "GET", []string{"application/activity+json"}, true},
+		{"Browser", "GET", []string{"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8"}, false},
+		{"Wildcard", "GET", []string{"*/*"}, false},
+		{"Among Others", "GET", []string{"text/html, application/activity+json"}, true},
+		{"Refused", "GET", []string{"application/activity+json;q=0"}, false},
+		{"Charset", "GET", []string{"application/activity+json; charset=utf-8"}, true},
+		{"Multiple Headers", "GET", []string{"text/html", "application/activity+json"}, true},
+		{"Not GET", "POST", []string{"application/activity+json"}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(test.method, testNoteId1, nil)
+			if test.accept != nil {
+				r.Header[acceptHeader] = test.accept
+			}
+			assertEqual(t, isActivityPubGet(r), test.expected)
+		})
+	}
+}
+
+// TestAddVaryAccept tests adding to the Vary header.
+func TestAddVaryAccept(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing []string
+		expected []string
+	}{
+		{"Empty", nil, []string{"Accept"}},
+		{"Other", []string{"Origin"}, []string{"Origin", "Accept"}},
+		{"Present", []string{"Origin, accept"}, []string{"Origin, accept"}},
+		{"Everything", []string{"*"}, []string{"*"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := http.Header{}
+			if test.existing != nil {
+				h[varyHeader] = test.existing
+			}
+			addVaryAccept(h)
+			assertEqual(t, len(h[varyHeader]), len(test.expected))
+			for i := range test.expected {
+				assertEqual(t, h[varyHeader][i], test.expected[i])
+			}
+		})
+	}
+}
+
+// TestNegotiatingHandler tests serving the representation a request prefers.
+func TestNegotiatingHandler(t *testing.T) {
+	// Set up test case
+	setupData()
+	ctx := context.Background()
+	var rendered vocab.Type
+	html := HTMLRepresentation(func(c context.Context, w http.ResponseWriter, r *http.Request, t vocab.Type) error {
+		rendered = t
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte("<p>note</p>"))
+		return err
+	})
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, clock *MockClock, hf HandlerFunc) {
+		rendered = nil
+		db = NewMockDatabase(ctl)
+		clock = NewMockClock(ctl)
+		hf = NewNegotiatingHandler(db, clock, html)
+		return
+	}
+	// Run tests
+	t.Run("RendersHTML", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(acceptHeader, "application/activity+json;q=0.9, text/html")
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1)).Return(nil)
+		db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(testMyNote, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1)).Return(nil)
+		// Run the test
+		handled, err := hf(ctx, resp, req)
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, rendered, vocab.Type(testMyNote))
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, resp.Header().Get(contentTypeHeader), HTMLMediaType)
+		assertEqual(t, resp.Header().Get(varyHeader), acceptHeader)
+		assertEqual(t, resp.Body.String(), "<p>note</p>")
+	})
+	t.Run("ServesActivityStreams", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, clock, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(acceptHeader, "text/html;q=0.5, application/activity+json")
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1)).Return(nil)
+		db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(testMyNote, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1)).Return(nil)
+		clock.EXPECT().Now().Return(now())
+		// Run the test
+		handled, err := hf(ctx, resp, req)
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, rendered, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, resp.Header().Get(contentTypeHeader), contentTypeHeaderValue)
+		assertEqual(t, resp.Header().Get(varyHeader), acceptHeader)
+	})
+	t.Run("IgnoresUnservedMediaType", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(acceptHeader, "image/png")
+		// Run the test
+		handled, err := hf(ctx, resp, req)
+		// Verify results
+		assertEqual(t, handled, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(resp.Header()), 0)
+	})
+}
@@ -0,0 +1,469 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// ScopeReadInbox allows a client to read the actor's inbox.
+	ScopeReadInbox = "read:inbox"
+	// ScopeWriteOutbox allows a client to post activities to the actor's
+	// outbox.
+	ScopeWriteOutbox = "write:outbox"
+)
+
+var (
+	// ErrOAuthNotFound indicates the client, authorization code, or access
+	// token requested from an OAuthStore does not exist.
+	ErrOAuthNotFound = errors.New("oauth: not found")
+	// ErrOAuthAccessDenied indicates the resource owner denied an
+	// authorization request. Returned by OAuthConfig's Authorize so the
+	// client is told.
+	ErrOAuthAccessDenied = errors.New("oauth: access denied")
+)
+
+const (
+	// defaultOAuthCodeLifetime is how long authorization codes are valid
+	// by default.
+	defaultOAuthCodeLifetime = time.Minute
+	// defaultOAuthTokenLifetime is how long access tokens are valid by
+	// default.
+	defaultOAuthTokenLifetime = 24 * time.Hour
+	// oauthTokenBytes is the number of random bytes in codes and tokens.
+	oauthTokenBytes = 32
+	// pkceS256 is the only supported PKCE code challenge method.
+	pkceS256 = "S256"
+	// The Authorization header.
+	authorizationHeader = "Authorization"
+	// The WWW-Authenticate header.
+	wwwAuthenticateHeader = "WWW-Authenticate"
+	// bearerPrefix prefixes bearer tokens in the Authorization header.
+	bearerPrefix = "Bearer "
+)
+
+// oauthScopes are the scopes an OAuthServer grants.
+var oauthScopes = map[string]bool{
+	ScopeReadInbox:   true,
+	ScopeWriteOutbox: true,
+}
+
+// OAuthAuthorization is an authorization request of a client, to be approved
+// by the resource owner.
+type OAuthAuthorization struct {
+	// Client is the client requesting authorization.
+	Client OAuthClient
+	// Scopes are the scopes requested.
+	Scopes []string
+}
+
+// OAuthConfig configures an OAuthServer.
+type OAuthConfig struct {
+	// Store persists clients, authorization codes, and access tokens.
+	Store OAuthStore
+	// Database is used to determine the actors owning the inboxes and
+	// outboxes requested with access tokens.
+	Database Database
+	// Clock determines when codes and tokens expire.
+	Clock Clock
+	// Authorize authenticates the resource owner of an authorization
+	// request and obtains their consent, such as with login and consent
+	// pages that submit back to the authorization endpoint.
+	//
+	// If the resource owner approves, it returns the IRI of their actor.
+	// If they deny the request, it returns ErrOAuthAccessDenied. If it
+	// wrote a response itself, such as a page asking for consent, it
+	// returns a nil IRI and nil error. Other errors result in a 500
+	// Internal Server Error status.
+	Authorize func(c context.Context, w http.ResponseWriter, r *http.Request, a OAuthAuthorization) (actorIRI *url.URL, err error)
+	// CodeLifetime is how long authorization codes are valid. Defaults to
+	// one minute.
+	CodeLifetime time.Duration
+	// TokenLifetime is how long access tokens are valid. Defaults to one
+	// day.
+	TokenLifetime time.Duration
+}
+
+// OAuthServer is an OAuth 2.0 authorization server letting C2S clients act on
+// behalf of actors, using the authorization code grant with PKCE (RFC 7636).
+//
+// Serve its AuthorizeHandler, TokenHandler, and RevokeHandler at the
+// application's endpoints, and call its AuthenticateGetInbox,
+// AuthenticateGetOutbox, and AuthenticatePostOutbox methods from the methods
+// of the same name of the application's CommonBehavior and SocialProtocol.
+type OAuthServer struct {
+	config OAuthConfig
+}
+
+// NewOAuthServer creates an OAuthServer.
+func NewOAuthServer(cfg OAuthConfig) *OAuthServer {
+	if cfg.CodeLifetime <= 0 {
+		cfg.CodeLifetime = defaultOAuthCodeLifetime
+	}
+	if cfg.TokenLifetime <= 0 {
+		cfg.TokenLifetime = defaultOAuthTokenLifetime
+	}
+	return &OAuthServer{config: cfg}
+}
+
+// oauthTokenKey is the context key of the access token of a request.
+type oauthTokenKey struct{}
+
+// OAuthTokenFromContext returns the access token authenticating the request
+// passed to one of OAuthServer's Authenticate methods.
+func OAuthTokenFromContext(c context.Context) (OAuthToken, bool) {
+	t, ok := c.Value(oauthTokenKey{}).(OAuthToken)
+	return t, ok
+}
+
+// AuthorizeHandler returns the handler of the authorization endpoint.
+//
+// It validates the authorization request, obtains the resource owner's
+// consent with OAuthConfig's Authorize, and redirects back to the client with
+// an authorization code.
+func (s *OAuthServer) AuthorizeHandler() http.Handler {
+	return http.HandlerFunc(s.authorize)
+}
+
+// authorize serves the authorization endpoint.
+func (s *OAuthServer) authorize(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "malformed authorization request", http.StatusBadRequest)
+		return
+	}
+	c := r.Context()
+	client, err := s.config.Store.GetClient(c, r.Form.Get("client_id"))
+	if err == ErrOAuthNotFound {
+		http.Error(w, "unknown client_id", http.StatusBadRequest)
+		return
+	} else if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	// Errors are only reported to the client once its redirect URI is
+	// known to be its own.
+	redirectURI := r.Form.Get("redirect_uri")
+	target := redirectURI
+	if len(target) == 0 && len(client.RedirectURIs) == 1 {
+		target = client.RedirectURIs[0]
+	} else if !containsString(client.RedirectURIs, target) {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	redirect, err := url.Parse(target)
+	if err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	state := r.Form.Get("state")
+	if r.Form.Get("response_type") != "code" {
+		redirectError(w, r, redirect, state, "unsupported_response_type")
+		return
+	} else if len(r.Form.Get("code_challenge")) == 0 || r.Form.Get("code_challenge_method") != pkceS256 {
+		redirectError(w, r, redirect, state, "invalid_request")
+		return
+	}
+	scopes := strings.Fields(r.Form.Get("scope"))
+	if len(scopes) == 0 {
+		redirectError(w, r, redirect, state, "invalid_scope")
+		return
+	}
+	for _, scope := range scopes {
+		if !oauthScopes[scope] {
+			redirectError(w, r, redirect, state, "invalid_scope")
+			return
+		}
+	}
+	actorIRI, err := s.config.Authorize(c, w, r, OAuthAuthorization{
+		Client: client,
+		Scopes: scopes,
+	})
+	if err == ErrOAuthAccessDenied {
+		redirectError(w, r, redirect, state, "access_denied")
+		return
+	} else if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if actorIRI == nil {
+		return
+	}
+	code, err := newOAuthSecret()
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	err = s.config.Store.CreateCode(c, OAuthCode{
+		Code:          code,
+		ClientID:      client.ID,
+		RedirectURI:   redirectURI,
+		Actor:         actorIRI,
+		Scopes:        scopes,
+		CodeChallenge: r.Form.Get("code_challenge"),
+		Expires:       s.config.Clock.Now().Add(s.config.CodeLifetime),
+	})
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	q := redirect.Query()
+	q.Set("code", code)
+	if len(state) > 0 {
+		q.Set("state", state)
+	}
+	redirect.RawQuery = q.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+// redirectError redirects back to the client with an error code.
+func redirectError(w http.ResponseWriter, r *http.Request, redirect *url.URL, state, code string) {
+	u := *redirect
+	q := u.Query()
+	q.Set("error", code)
+	if len(state) > 0 {
+		q.Set("state", state)
+	}
+	u.RawQuery = q.Encode()
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
+
+// oauthTokenResponse is the successful response of the token endpoint.
+type oauthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope"`
+}
+
+// oauthErrorResponse is the error response of the token endpoint.
+type oauthErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// TokenHandler returns the handler of the token endpoint.
+//
+// It exchanges authorization codes for access tokens once the client proves
+// possession of the PKCE code verifier.
+func (s *OAuthServer) TokenHandler() http.Handler {
+	return http.HandlerFunc(s.token)
+}
+
+// token serves the token endpoint.
+func (s *OAuthServer) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	} else if err := r.ParseForm(); err != nil {
+		writeOAuthJSON(w, http.StatusBadRequest, oauthErrorResponse{"invalid_request"})
+		return
+	} else if r.PostForm.Get("grant_type") != "authorization_code" {
+		writeOAuthJSON(w, http.StatusBadRequest, oauthErrorResponse{"unsupported_grant_type"})
+		return
+	}
+	c := r.Context()
+	code, err := s.config.Store.TakeCode(c, r.PostForm.Get("code"))
+	if err == ErrOAuthNotFound {
+		writeOAuthJSON(w, http.StatusBadRequest, oauthErrorResponse{"invalid_grant"})
+		return
+	} else if err != nil {
+		writeOAuthJSON(w, http.StatusInternalServerError, oauthErrorResponse{"server_error"})
+		return
+	}
+	now := s.config.Clock.Now()
+	if !now.Before(code.Expires) ||
+		code.ClientID != r.PostForm.Get("client_id") ||
+		code.RedirectURI != r.PostForm.Get("redirect_uri") ||
+		!verifyPKCE(r.PostForm.Get("code_verifier"), code.CodeChallenge) {
+		writeOAuthJSON(w, http.StatusBadRequest, oauthErrorResponse{"invalid_grant"})
+		return
+	}
+	tok, err := newOAuthSecret()
+	if err != nil {
+		writeOAuthJSON(w, http.StatusInternalServerError, oauthErrorResponse{"server_error"})
+		return
+	}
+	err = s.config.Store.CreateToken(c, OAuthToken{
+		Token:    tok,
+		ClientID: code.ClientID,
+		Actor:    code.Actor,
+		Scopes:   code.Scopes,
+		Expires:  now.Add(s.config.TokenLifetime),
+	})
+	if err != nil {
+		writeOAuthJSON(w, http.StatusInternalServerError, oauthErrorResponse{"server_error"})
+		return
+	}
+	writeOAuthJSON(w, http.StatusOK, oauthTokenResponse{
+		AccessToken: tok,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(s.config.TokenLifetime / time.Second),
+		Scope:       strings.Join(code.Scopes, " "),
+	})
+}
+
+// RevokeHandler returns the handler of the token revocation endpoint (RFC
+// 7009).
+func (s *OAuthServer) RevokeHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		} else if err := r.ParseForm(); err != nil {
+			writeOAuthJSON(w, http.StatusBadRequest, oauthErrorResponse{"invalid_request"})
+			return
+		}
+		// Revoking an unknown token is not an error.
+		err := s.config.Store.DeleteToken(r.Context(), r.PostForm.Get("token"))
+		if err != nil && err != ErrOAuthNotFound {
+			writeOAuthJSON(w, http.StatusServiceUnavailable, oauthErrorResponse{"server_error"})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// writeOAuthJSON writes a response of the token endpoint, which must not be
+// cached.
+func writeOAuthJSON(w http.ResponseWriter, code int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(contentTypeHeader, "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(code)
+	w.Write(b)
+}
+
+// AuthenticateGetInbox requires an access token with the ScopeReadInbox scope
+// for the actor owning the inbox. It has the signature of CommonBehavior's
+// AuthenticateGetInbox.
+func (s *OAuthServer) AuthenticateGetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	return s.authenticate(c, w, r, ScopeReadInbox, s.config.Database.ActorForInbox)
+}
+
+// AuthenticateGetOutbox allows requests without an access token, as outboxes
+// are public, and otherwise requires the access token to be valid. It has the
+// signature of CommonBehavior's AuthenticateGetOutbox.
+//
+// A request with a valid access token for the actor owning the outbox has the
+// token in its context, so CommonBehavior's GetOutbox can include the
+// activities only that actor may see.
+func (s *OAuthServer) AuthenticateGetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	if len(r.Header.Get(authorizationHeader)) == 0 {
+		return c, true, nil
+	}
+	tok, ok, err := s.bearerToken(c, w, r)
+	if err != nil || !ok {
+		return c, ok, err
+	}
+	owner, err := s.boxOwner(c, requestId(r), s.config.Database.ActorForOutbox)
+	if err != nil {
+		return c, false, err
+	} else if owner.String() != tok.Actor.String() {
+		return c, true, nil
+	}
+	return context.WithValue(c, oauthTokenKey{}, tok), true, nil
+}
+
+// AuthenticatePostOutbox requires an access token with the ScopeWriteOutbox
+// scope for the actor owning the outbox. It has the signature of
+// SocialProtocol's AuthenticatePostOutbox.
+func (s *OAuthServer) AuthenticatePostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	return s.authenticate(c, w, r, ScopeWriteOutbox, s.config.Database.ActorForOutbox)
+}
+
+// authenticate requires an access token with the scope for the actor owning
+// the box requested, adding it to the context.
+func (s *OAuthServer) authenticate(c context.Context, w http.ResponseWriter, r *http.Request, scope string, actorFor func(context.Context, *url.URL) (*url.URL, error)) (context.Context, bool, error) {
+	tok, ok, err := s.bearerToken(c, w, r)
+	if err != nil || !ok {
+		return c, ok, err
+	}
+	if !tok.HasScope(scope) {
+		w.Header().Set(wwwAuthenticateHeader, fmt.Sprintf("Bearer error=\"insufficient_scope\", scope=%q", scope))
+		w.WriteHeader(http.StatusForbidden)
+		return c, false, nil
+	}
+	owner, err := s.boxOwner(c, requestId(r), actorFor)
+	if err != nil {
+		return c, false, err
+	} else if owner.String() != tok.Actor.String() {
+		w.WriteHeader(http.StatusForbidden)
+		return c, false, nil
+	}
+	return context.WithValue(c, oauthTokenKey{}, tok), true, nil
+}
+
+// bearerToken obtains the valid access token of the request, responding with
+// a 401 Unauthorized status if there is none.
+func (s *OAuthServer) bearerToken(c context.Context, w http.ResponseWriter, r *http.Request) (OAuthToken, bool, error) {
+	h := r.Header.Get(authorizationHeader)
+	if len(h) < len(bearerPrefix) || !strings.EqualFold(h[:len(bearerPrefix)], bearerPrefix) {
+		w.Header().Set(wwwAuthenticateHeader, "Bearer")
+		w.WriteHeader(http.StatusUnauthorized)
+		return OAuthToken{}, false, nil
+	}
+	tok, err := s.config.Store.GetToken(c, strings.TrimSpace(h[len(bearerPrefix):]))
+	if err == ErrOAuthNotFound || (err == nil && !s.config.Clock.Now().Before(tok.Expires)) {
+		w.Header().Set(wwwAuthenticateHeader, "Bearer error=\"invalid_token\"")
+		w.WriteHeader(http.StatusUnauthorized)
+		return OAuthToken{}, false, nil
+	} else if err != nil {
+		return OAuthToken{}, false, err
+	}
+	return tok, true, nil
+}
+
+// boxOwner fetches the actor owning an inbox or outbox.
+func (s *OAuthServer) boxOwner(c context.Context, boxIRI *url.URL, actorFor func(context.Context, *url.URL) (*url.URL, error)) (*url.URL, error) {
+	if err := s.config.Database.Lock(c, boxIRI); err != nil {
+		return nil, err
+	}
+	defer s.config.Database.Unlock(c, boxIRI)
+	return actorFor(c, boxIRI)
+}
+
+// newOAuthSecret generates an unguessable authorization code or access token.
+func newOAuthSecret() (string, error) {
+	b := make([]byte, oauthTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// verifyPKCE determines whether the code verifier matches the S256 code
+// challenge.
+func verifyPKCE(verifier, challenge string) bool {
+	// RFC 7636 §4.1
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	h := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(h[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+// containsString determines whether the string is in the slice.
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
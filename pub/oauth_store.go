@@ -0,0 +1,89 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// OAuthClient is a C2S client registered with an OAuthServer.
+//
+// Clients are public: they authenticate by the redirect URIs they registered
+// and by proving possession of their PKCE code verifier, not by a secret.
+type OAuthClient struct {
+	// ID is the client identifier.
+	ID string
+	// Name is the human-readable name of the client, for consent pages.
+	Name string
+	// RedirectURIs are the exact URIs the client may be redirected to with
+	// an authorization code.
+	RedirectURIs []string
+}
+
+// OAuthCode is an authorization code issued to a client for an actor, to be
+// exchanged for an OAuthToken.
+type OAuthCode struct {
+	// Code is the authorization code.
+	Code string
+	// ClientID is the identifier of the client it was issued to.
+	ClientID string
+	// RedirectURI is the redirect_uri of the authorization request, which
+	// is empty if the client omitted it. The token request must repeat it
+	// exactly.
+	RedirectURI string
+	// Actor is the IRI of the actor who granted the authorization.
+	Actor *url.URL
+	// Scopes are the scopes granted.
+	Scopes []string
+	// CodeChallenge is the PKCE code challenge of the authorization
+	// request, using the S256 method.
+	CodeChallenge string
+	// Expires is when the code can no longer be exchanged.
+	Expires time.Time
+}
+
+// OAuthToken is an access token allowing a client to act on behalf of an
+// actor.
+type OAuthToken struct {
+	// Token is the bearer access token.
+	Token string
+	// ClientID is the identifier of the client it was issued to.
+	ClientID string
+	// Actor is the IRI of the actor the client acts on behalf of.
+	Actor *url.URL
+	// Scopes are the scopes granted.
+	Scopes []string
+	// Expires is when the token is no longer valid.
+	Expires time.Time
+}
+
+// HasScope determines whether the token has been granted the scope.
+func (t OAuthToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// OAuthStore persists the clients, authorization codes, and access tokens of
+// an OAuthServer.
+//
+// Methods return ErrOAuthNotFound when the requested client, code, or token
+// does not exist.
+type OAuthStore interface {
+	// GetClient fetches a registered client.
+	GetClient(c context.Context, id string) (OAuthClient, error)
+	// CreateCode saves a newly issued authorization code.
+	CreateCode(c context.Context, code OAuthCode) error
+	// TakeCode fetches and deletes an authorization code, so that it can
+	// only be exchanged once, even by concurrent requests.
+	TakeCode(c context.Context, code string) (OAuthCode, error)
+	// CreateToken saves a newly issued access token.
+	CreateToken(c context.Context, token OAuthToken) error
+	// GetToken fetches an access token.
+	GetToken(c context.Context, token string) (OAuthToken, error)
+	// DeleteToken revokes an access token.
+	DeleteToken(c context.Context, token string) error
+}
@@ -0,0 +1,436 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	// testPKCEVerifier is a PKCE code verifier.
+	testPKCEVerifier = "dBjftJeZ4CVP-mJ92K1IXvHMg3cNzT-QlgA-3Df3hjQ"
+	// testPKCEChallenge is the S256 code challenge of testPKCEVerifier.
+	testPKCEChallenge = "6KZH6YRLu-Fr40decdp-Q74C3c_Tvzhnq6csMZcgqzs"
+	// testOAuthRedirect is the redirect URI of the test client.
+	testOAuthRedirect = "https://client.example.com/callback"
+)
+
+// oauthTestStore is an in-memory OAuthStore.
+type oauthTestStore struct {
+	mu      sync.Mutex
+	clients map[string]OAuthClient
+	codes   map[string]OAuthCode
+	tokens  map[string]OAuthToken
+}
+
+func newOAuthTestStore(clients ...OAuthClient) *oauthTestStore {
+	s := &oauthTestStore{
+		clients: make(map[string]OAuthClient),
+		codes:   make(map[string]OAuthCode),
+		tokens:  make(map[string]OAuthToken),
+	}
+	for _, cl := range clients {
+		s.clients[cl.ID] = cl
+	}
+	return s
+}
+
+func (s *oauthTestStore) GetClient(c context.Context, id string) (OAuthClient, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cl, ok := s.clients[id]
+	if !ok {
+		return cl, ErrOAuthNotFound
+	}
+	return cl, nil
+}
+
+func (s *oauthTestStore) CreateCode(c context.Context, code OAuthCode) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.codes[code.Code] = code
+	return nil
+}
+
+func (s *oauthTestStore) TakeCode(c context.Context, code string) (OAuthCode, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	oc, ok := s.codes[code]
+	if !ok {
+		return oc, ErrOAuthNotFound
+	}
+	delete(s.codes, code)
+	return oc, nil
+}
+
+func (s *oauthTestStore) CreateToken(c context.Context, token OAuthToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[token.Token] = token
+	return nil
+}
+
+func (s *oauthTestStore) GetToken(c context.Context, token string) (OAuthToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tokens[token]
+	if !ok {
+		return t, ErrOAuthNotFound
+	}
+	return t, nil
+}
+
+func (s *oauthTestStore) DeleteToken(c context.Context, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tokens[token]; !ok {
+		return ErrOAuthNotFound
+	}
+	delete(s.tokens, token)
+	return nil
+}
+
+// TestVerifyPKCE tests checking PKCE code verifiers.
+func TestVerifyPKCE(t *testing.T) {
+	assertEqual(t, verifyPKCE(testPKCEVerifier, testPKCEChallenge), true)
+	assertEqual(t, verifyPKCE(testPKCEVerifier+"x", testPKCEChallenge), false)
+	assertEqual(t, verifyPKCE("short", testPKCEChallenge), false)
+}
+
+// TestOAuthServer tests the authorization code flow and authenticating
+// requests with the issued access tokens.
+func TestOAuthServer(t *testing.T) {
+	// Set up test case
+	setupData()
+	ctx := context.Background()
+	client := OAuthClient{
+		ID:           "client",
+		Name:         "Test Client",
+		RedirectURIs: []string{testOAuthRedirect},
+	}
+	authorizeQuery := func(scope string) url.Values {
+		return url.Values{
+			"response_type":         []string{"code"},
+			"client_id":             []string{client.ID},
+			"redirect_uri":          []string{testOAuthRedirect},
+			"scope":                 []string{scope},
+			"state":                 []string{"xyz"},
+			"code_challenge":        []string{testPKCEChallenge},
+			"code_challenge_method": []string{pkceS256},
+		}
+	}
+	tokenForm := func(code string) url.Values {
+		return url.Values{
+			"grant_type":    []string{"authorization_code"},
+			"code":          []string{code},
+			"client_id":     []string{client.ID},
+			"redirect_uri":  []string{testOAuthRedirect},
+			"code_verifier": []string{testPKCEVerifier},
+		}
+	}
+	setupFn := func(ctl *gomock.Controller, authorize func(context.Context, http.ResponseWriter, *http.Request, OAuthAuthorization) (*url.URL, error)) (db *MockDatabase, clock *MockClock, store *oauthTestStore, s *OAuthServer) {
+		db = NewMockDatabase(ctl)
+		clock = NewMockClock(ctl)
+		store = newOAuthTestStore(client)
+		s = NewOAuthServer(OAuthConfig{
+			Store:     store,
+			Database:  db,
+			Clock:     clock,
+			Authorize: authorize,
+		})
+		return
+	}
+	approve := func(c context.Context, w http.ResponseWriter, r *http.Request, a OAuthAuthorization) (*url.URL, error) {
+		return mustParse(testPersonIRI), nil
+	}
+	authorize := func(s *OAuthServer, q url.Values) *httptest.ResponseRecorder {
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "https://example.com/oauth/authorize?"+q.Encode(), nil)
+		s.AuthorizeHandler().ServeHTTP(resp, req)
+		return resp
+	}
+	exchange := func(s *OAuthServer, form url.Values) *httptest.ResponseRecorder {
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "https://example.com/oauth/token", strings.NewReader(form.Encode()))
+		req.Header.Set(contentTypeHeader, "application/x-www-form-urlencoded")
+		s.TokenHandler().ServeHTTP(resp, req)
+		return resp
+	}
+	// Run tests
+	t.Run("IssuesToken", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, clock, store, s := setupFn(ctl, approve)
+		clock.EXPECT().Now().Return(now()).Times(2)
+		// Run the test
+		authResp := authorize(s, authorizeQuery(ScopeWriteOutbox))
+		loc, err := url.Parse(authResp.Header().Get("Location"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tokResp := exchange(s, tokenForm(loc.Query().Get("code")))
+		var body oauthTokenResponse
+		err = json.Unmarshal(tokResp.Body.Bytes(), &body)
+		// Verify results
+		assertEqual(t, authResp.Code, http.StatusFound)
+		assertEqual(t, loc.Query().Get("state"), "xyz")
+		assertEqual(t, tokResp.Code, http.StatusOK)
+		assertEqual(t, tokResp.Header().Get("Cache-Control"), "no-store")
+		assertEqual(t, err, nil)
+		assertEqual(t, body.TokenType, "Bearer")
+		assertEqual(t, body.Scope, ScopeWriteOutbox)
+		assertEqual(t, body.ExpiresIn, int64(defaultOAuthTokenLifetime/time.Second))
+		tok, err := store.GetToken(ctx, body.AccessToken)
+		assertEqual(t, err, nil)
+		assertEqual(t, tok.Actor.String(), testPersonIRI)
+		assertEqual(t, tok.Expires.Equal(now().Add(defaultOAuthTokenLifetime)), true)
+		// The code may only be used once.
+		assertEqual(t, exchange(s, tokenForm(loc.Query().Get("code"))).Code, http.StatusBadRequest)
+	})
+	t.Run("IssuesTokenWithoutRedirect", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, clock, _, s := setupFn(ctl, approve)
+		clock.EXPECT().Now().Return(now()).Times(4)
+		q := authorizeQuery(ScopeWriteOutbox)
+		q.Del("redirect_uri")
+		// Run the test
+		authResp := authorize(s, q)
+		loc, err := url.Parse(authResp.Header().Get("Location"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		code := loc.Query().Get("code")
+		// The redirect_uri must be omitted from the token request too.
+		withRedirect := exchange(s, tokenForm(code))
+		authResp = authorize(s, q)
+		loc, err = url.Parse(authResp.Header().Get("Location"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		form := tokenForm(loc.Query().Get("code"))
+		form.Del("redirect_uri")
+		withoutRedirect := exchange(s, form)
+		// Verify results
+		assertEqual(t, authResp.Code, http.StatusFound)
+		assertEqual(t, strings.HasPrefix(loc.String(), testOAuthRedirect), true)
+		assertEqual(t, withRedirect.Code, http.StatusBadRequest)
+		assertEqual(t, withoutRedirect.Code, http.StatusOK)
+	})
+	t.Run("RejectsUnregisteredRedirect", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, s := setupFn(ctl, approve)
+		q := authorizeQuery(ScopeWriteOutbox)
+		q.Set("redirect_uri", "https://evil.example.com/callback")
+		// Run the test
+		resp := authorize(s, q)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+		assertEqual(t, resp.Header().Get("Location"), "")
+	})
+	t.Run("RedirectsErrors", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			modify   func(q url.Values)
+			expected string
+		}{
+			{"Missing Challenge", func(q url.Values) { q.Del("code_challenge") }, "invalid_request"},
+			{"Plain Challenge", func(q url.Values) { q.Set("code_challenge_method", "plain") }, "invalid_request"},
+			{"Unknown Scope", func(q url.Values) { q.Set("scope", "admin") }, "invalid_scope"},
+			{"Token Response Type", func(q url.Values) { q.Set("response_type", "token") }, "unsupported_response_type"},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				// Setup
+				ctl := gomock.NewController(t)
+				defer ctl.Finish()
+				_, _, _, s := setupFn(ctl, approve)
+				q := authorizeQuery(ScopeWriteOutbox)
+				test.modify(q)
+				// Run the test
+				resp := authorize(s, q)
+				loc, err := url.Parse(resp.Header().Get("Location"))
+				// Verify results
+				assertEqual(t, err, nil)
+				assertEqual(t, resp.Code, http.StatusFound)
+				assertEqual(t, loc.Query().Get("error"), test.expected)
+				assertEqual(t, loc.Query().Get("state"), "xyz")
+			})
+		}
+	})
+	t.Run("RedirectsAccessDenied", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, s := setupFn(ctl, func(c context.Context, w http.ResponseWriter, r *http.Request, a OAuthAuthorization) (*url.URL, error) {
+			return nil, ErrOAuthAccessDenied
+		})
+		// Run the test
+		resp := authorize(s, authorizeQuery(ScopeReadInbox))
+		loc, err := url.Parse(resp.Header().Get("Location"))
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, loc.Query().Get("error"), "access_denied")
+	})
+	t.Run("RejectsWrongVerifier", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, clock, store, s := setupFn(ctl, approve)
+		store.CreateCode(ctx, OAuthCode{
+			Code:          "code",
+			ClientID:      client.ID,
+			RedirectURI:   testOAuthRedirect,
+			Actor:         mustParse(testPersonIRI),
+			Scopes:        []string{ScopeWriteOutbox},
+			CodeChallenge: testPKCEChallenge,
+			Expires:       now().Add(time.Minute),
+		})
+		clock.EXPECT().Now().Return(now())
+		form := tokenForm("code")
+		form.Set("code_verifier", strings.Repeat("a", 43))
+		// Run the test
+		resp := exchange(s, form)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+		assertEqual(t, strings.Contains(resp.Body.String(), "invalid_grant"), true)
+		assertEqual(t, len(store.tokens), 0)
+	})
+	t.Run("RejectsExpiredCode", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, clock, store, s := setupFn(ctl, approve)
+		store.CreateCode(ctx, OAuthCode{
+			Code:          "code",
+			ClientID:      client.ID,
+			RedirectURI:   testOAuthRedirect,
+			Actor:         mustParse(testPersonIRI),
+			Scopes:        []string{ScopeWriteOutbox},
+			CodeChallenge: testPKCEChallenge,
+			Expires:       now(),
+		})
+		clock.EXPECT().Now().Return(now())
+		// Run the test
+		resp := exchange(s, tokenForm("code"))
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+	t.Run("AuthenticatesPostOutbox", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			header        string
+			scopes        []string
+			owner         string
+			expected      bool
+			expectedCode  int
+			expectedToken bool
+		}{
+			{"Valid", "Bearer token", []string{ScopeWriteOutbox}, testPersonIRI, true, http.StatusOK, true},
+			{"Missing", "", nil, "", false, http.StatusUnauthorized, false},
+			{"Unknown", "Bearer other", nil, "", false, http.StatusUnauthorized, false},
+			{"Insufficient Scope", "Bearer token", []string{ScopeReadInbox}, "", false, http.StatusForbidden, false},
+			{"Other Actor", "Bearer token", []string{ScopeWriteOutbox}, testFederatedActorIRI, false, http.StatusForbidden, false},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				// Setup
+				ctl := gomock.NewController(t)
+				defer ctl.Finish()
+				db, clock, store, s := setupFn(ctl, approve)
+				store.CreateToken(ctx, OAuthToken{
+					Token:    "token",
+					ClientID: client.ID,
+					Actor:    mustParse(testPersonIRI),
+					Scopes:   test.scopes,
+					Expires:  now().Add(time.Hour),
+				})
+				if test.header == "Bearer token" {
+					clock.EXPECT().Now().Return(now())
+				}
+				if len(test.owner) > 0 {
+					db.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI)).Return(nil)
+					db.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(mustParse(test.owner), nil)
+					db.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI)).Return(nil)
+				}
+				resp := httptest.NewRecorder()
+				req := httptest.NewRequest("POST", testMyOutboxIRI, nil)
+				if len(test.header) > 0 {
+					req.Header.Set(authorizationHeader, test.header)
+				}
+				// Run the test
+				c, authenticated, err := s.AuthenticatePostOutbox(ctx, resp, req)
+				_, hasToken := OAuthTokenFromContext(c)
+				// Verify results
+				assertEqual(t, err, nil)
+				assertEqual(t, authenticated, test.expected)
+				assertEqual(t, resp.Code, test.expectedCode)
+				assertEqual(t, hasToken, test.expectedToken)
+			})
+		}
+	})
+	t.Run("AllowsPublicGetOutbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, s := setupFn(ctl, approve)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testMyOutboxIRI, nil)
+		// Run the test
+		c, authenticated, err := s.AuthenticateGetOutbox(ctx, resp, req)
+		_, hasToken := OAuthTokenFromContext(c)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, true)
+		assertEqual(t, hasToken, false)
+	})
+	t.Run("RejectsExpiredToken", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, clock, store, s := setupFn(ctl, approve)
+		store.CreateToken(ctx, OAuthToken{
+			Token:   "token",
+			Actor:   mustParse(testPersonIRI),
+			Scopes:  []string{ScopeReadInbox},
+			Expires: now(),
+		})
+		clock.EXPECT().Now().Return(now())
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testMyInboxIRI, nil)
+		req.Header.Set(authorizationHeader, "Bearer token")
+		// Run the test
+		_, authenticated, err := s.AuthenticateGetInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, false)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+		assertEqual(t, resp.Header().Get(wwwAuthenticateHeader), "Bearer error=\"invalid_token\"")
+	})
+	t.Run("RevokesToken", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, store, s := setupFn(ctl, approve)
+		store.CreateToken(ctx, OAuthToken{Token: "token"})
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "https://example.com/oauth/revoke", strings.NewReader("token=token"))
+		req.Header.Set(contentTypeHeader, "application/x-www-form-urlencoded")
+		// Run the test
+		s.RevokeHandler().ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, len(store.tokens), 0)
+	})
+}
@@ -0,0 +1,101 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Endpoint identifies the kind of ActivityPub request being handled by an
+// Actor.
+type Endpoint string
+
+const (
+	// EndpointPostInbox is a POST to an actor's inbox.
+	EndpointPostInbox Endpoint = "post_inbox"
+	// EndpointGetInbox is a GET of an actor's inbox.
+	EndpointGetInbox Endpoint = "get_inbox"
+	// EndpointPostOutbox is a POST to an actor's outbox.
+	EndpointPostOutbox Endpoint = "post_outbox"
+	// EndpointGetOutbox is a GET of an actor's outbox.
+	EndpointGetOutbox Endpoint = "get_outbox"
+)
+
+// Observer is notified of federation traffic at well-defined points, so
+// applications can log, collect metrics, or trace the handling of
+// activities.
+//
+// Methods are called synchronously while the request is being handled, and
+// may be called concurrently. Implementations must be cheap and must not
+// block.
+//
+// Methods for events that have a duration return a function that must be
+// called exactly once when the event completes. Implementations are free to
+// time the event between the two calls, and callers will never measure time
+// on their behalf.
+type Observer interface {
+	// RequestReceived is called when an Actor begins handling an
+	// ActivityPub request to an endpoint.
+	//
+	// The returned context is used for the remainder of the request, which
+	// lets implementations attach tracing data that is then passed to the
+	// application's callbacks and Transport. Implementations with nothing
+	// to attach must return the given context.
+	RequestReceived(c context.Context, e Endpoint, r *http.Request) context.Context
+	// Authenticated is called with the result of delegating the
+	// authentication of a request to the application. The context is the
+	// one returned by the application.
+	Authenticated(c context.Context, e Endpoint, authenticated bool, err error)
+	// CallbackDispatched is called when the side effects of an activity of
+	// the given type are about to be applied, including the application's
+	// callbacks.
+	CallbackDispatched(c context.Context, e Endpoint, activityType string) (done func(err error))
+	// DeliveryAttempted is called when a POST of an activity to an inbox
+	// is about to be sent.
+	//
+	// The status code is zero if no response was received.
+	DeliveryAttempted(c context.Context, to *url.URL) (done func(statusCode int, err error))
+	// DereferenceAttempted is called when a GET of an IRI is about to be
+	// sent.
+	//
+	// The status code is zero if no response was received.
+	DereferenceAttempted(c context.Context, iri *url.URL) (done func(statusCode int, err error))
+}
+
+// NoOpObserver is an Observer that ignores all events. It is used when an
+// application does not provide one.
+type NoOpObserver struct{}
+
+// NoOpObserver must satisfy the Observer interface.
+var _ Observer = NoOpObserver{}
+
+// RequestReceived returns the context unchanged.
+func (NoOpObserver) RequestReceived(c context.Context, e Endpoint, r *http.Request) context.Context {
+	return c
+}
+
+// Authenticated does nothing.
+func (NoOpObserver) Authenticated(c context.Context, e Endpoint, authenticated bool, err error) {}
+
+// CallbackDispatched returns a function that does nothing.
+func (NoOpObserver) CallbackDispatched(c context.Context, e Endpoint, activityType string) func(err error) {
+	return func(error) {}
+}
+
+// DeliveryAttempted returns a function that does nothing.
+func (NoOpObserver) DeliveryAttempted(c context.Context, to *url.URL) func(statusCode int, err error) {
+	return func(int, error) {}
+}
+
+// DereferenceAttempted returns a function that does nothing.
+func (NoOpObserver) DereferenceAttempted(c context.Context, iri *url.URL) func(statusCode int, err error) {
+	return func(int, error) {}
+}
+
+// observerOrDefault returns the Observer, or a NoOpObserver if it is nil.
+func observerOrDefault(o Observer) Observer {
+	if o == nil {
+		return NoOpObserver{}
+	}
+	return o
+}
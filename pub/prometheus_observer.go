@@ -0,0 +1,243 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// prometheusContentType is the Content-Type of the Prometheus text
+	// exposition format.
+	prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+	// Values of the 'result' label.
+	resultSuccess         = "success"
+	resultFailure         = "failure"
+	resultAuthenticated   = "authenticated"
+	resultUnauthenticated = "unauthenticated"
+	resultError           = "error"
+)
+
+// PrometheusObserver must satisfy the Observer interface.
+var _ Observer = &PrometheusObserver{}
+
+// PrometheusObserver is an Observer that aggregates federation traffic into
+// metrics, and exports them in the Prometheus text exposition format.
+//
+// It has no dependencies on the Prometheus client libraries. Serve it at a
+// scraping endpoint with ServeHTTP, or write it anywhere with WriteTo.
+//
+// The following metrics are exported:
+//
+//	activitypub_requests_total{endpoint}
+//	activitypub_authentications_total{endpoint,result}
+//	activitypub_callbacks_total{endpoint,type,result}
+//	activitypub_callback_duration_seconds{endpoint,type}
+//	activitypub_deliveries_total{host,result}
+//	activitypub_delivery_duration_seconds{host}
+//	activitypub_dereferences_total{host,result}
+//	activitypub_dereference_duration_seconds{host}
+//
+// Durations are summaries, reported as a sum and a count.
+type PrometheusObserver struct {
+	clock    Clock
+	mu       sync.Mutex
+	families []*promFamily
+	requests *promFamily
+	auths    *promFamily
+	cbs      *promFamily
+	cbDur    *promFamily
+	dels     *promFamily
+	delDur   *promFamily
+	derefs   *promFamily
+	derefDur *promFamily
+}
+
+// NewPrometheusObserver creates a new PrometheusObserver. The clock is used to
+// measure the duration of events.
+func NewPrometheusObserver(clock Clock) *PrometheusObserver {
+	p := &PrometheusObserver{clock: clock}
+	p.requests = p.newFamily("activitypub_requests_total", "ActivityPub requests received.", "counter", "endpoint")
+	p.auths = p.newFamily("activitypub_authentications_total", "Results of authenticating ActivityPub requests.", "counter", "endpoint", "result")
+	p.cbs = p.newFamily("activitypub_callbacks_total", "Activities whose side effects were applied.", "counter", "endpoint", "type", "result")
+	p.cbDur = p.newFamily("activitypub_callback_duration_seconds", "Time spent applying the side effects of activities.", "summary", "endpoint", "type")
+	p.dels = p.newFamily("activitypub_deliveries_total", "Deliveries of activities to peer inboxes.", "counter", "host", "result")
+	p.delDur = p.newFamily("activitypub_delivery_duration_seconds", "Time spent delivering activities to peer inboxes.", "summary", "host")
+	p.derefs = p.newFamily("activitypub_dereferences_total", "Dereferences of peer IRIs.", "counter", "host", "result")
+	p.derefDur = p.newFamily("activitypub_dereference_duration_seconds", "Time spent dereferencing peer IRIs.", "summary", "host")
+	return p
+}
+
+// RequestReceived counts the request.
+func (p *PrometheusObserver) RequestReceived(c context.Context, e Endpoint, r *http.Request) context.Context {
+	p.observe(p.requests, 0, string(e))
+	return c
+}
+
+// Authenticated counts the authentication result.
+func (p *PrometheusObserver) Authenticated(c context.Context, e Endpoint, authenticated bool, err error) {
+	result := resultAuthenticated
+	if err != nil {
+		result = resultError
+	} else if !authenticated {
+		result = resultUnauthenticated
+	}
+	p.observe(p.auths, 0, string(e), result)
+}
+
+// CallbackDispatched counts and times the side effects of the activity.
+func (p *PrometheusObserver) CallbackDispatched(c context.Context, e Endpoint, activityType string) func(err error) {
+	start := p.clock.Now()
+	return func(err error) {
+		d := p.clock.Now().Sub(start).Seconds()
+		p.observe(p.cbs, 0, string(e), activityType, errResult(err))
+		p.observe(p.cbDur, d, string(e), activityType)
+	}
+}
+
+// DeliveryAttempted counts and times the delivery, by host.
+func (p *PrometheusObserver) DeliveryAttempted(c context.Context, to *url.URL) func(statusCode int, err error) {
+	start := p.clock.Now()
+	return func(statusCode int, err error) {
+		d := p.clock.Now().Sub(start).Seconds()
+		p.observe(p.dels, 0, to.Host, errResult(err))
+		p.observe(p.delDur, d, to.Host)
+	}
+}
+
+// DereferenceAttempted counts and times the dereference, by host.
+func (p *PrometheusObserver) DereferenceAttempted(c context.Context, iri *url.URL) func(statusCode int, err error) {
+	start := p.clock.Now()
+	return func(statusCode int, err error) {
+		d := p.clock.Now().Sub(start).Seconds()
+		p.observe(p.derefs, 0, iri.Host, errResult(err))
+		p.observe(p.derefDur, d, iri.Host)
+	}
+}
+
+// WriteTo writes all metrics in the Prometheus text exposition format.
+func (p *PrometheusObserver) WriteTo(w io.Writer) (int64, error) {
+	var b bytes.Buffer
+	p.mu.Lock()
+	for _, f := range p.families {
+		f.writeTo(&b)
+	}
+	p.mu.Unlock()
+	return b.WriteTo(w)
+}
+
+// ServeHTTP responds with all metrics in the Prometheus text exposition
+// format.
+func (p *PrometheusObserver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set(contentTypeHeader, prometheusContentType)
+	w.WriteHeader(http.StatusOK)
+	p.WriteTo(w)
+}
+
+// newFamily registers a new metric family.
+func (p *PrometheusObserver) newFamily(name, help, kind string, labels ...string) *promFamily {
+	f := &promFamily{
+		name:    name,
+		help:    help,
+		kind:    kind,
+		labels:  labels,
+		samples: make(map[string]*promSample),
+	}
+	p.families = append(p.families, f)
+	return f
+}
+
+// observe adds a single observation to a metric family. The value is only
+// used by summaries.
+func (p *PrometheusObserver) observe(f *promFamily, value float64, labelValues ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	key := strings.Join(labelValues, "\xff")
+	s, ok := f.samples[key]
+	if !ok {
+		s = &promSample{labelValues: labelValues}
+		f.samples[key] = s
+	}
+	s.count++
+	s.sum += value
+}
+
+// errResult determines the 'result' label for an error.
+func errResult(err error) string {
+	if err != nil {
+		return resultFailure
+	}
+	return resultSuccess
+}
+
+// promFamily is a metric with all of its labelled samples.
+type promFamily struct {
+	name    string
+	help    string
+	kind    string
+	labels  []string
+	samples map[string]*promSample
+}
+
+// promSample is the aggregate of observations for a single set of label
+// values.
+type promSample struct {
+	labelValues []string
+	count       float64
+	sum         float64
+}
+
+// writeTo writes the family in the text exposition format, with samples in a
+// deterministic order.
+func (f *promFamily) writeTo(b *bytes.Buffer) {
+	fmt.Fprintf(b, "# HELP %s %s\n", f.name, f.help)
+	fmt.Fprintf(b, "# TYPE %s %s\n", f.name, f.kind)
+	keys := make([]string, 0, len(f.samples))
+	for k := range f.samples {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s := f.samples[k]
+		labels := f.formatLabels(s.labelValues)
+		if f.kind == "summary" {
+			fmt.Fprintf(b, "%s_sum%s %s\n", f.name, labels, formatFloat(s.sum))
+			fmt.Fprintf(b, "%s_count%s %s\n", f.name, labels, formatFloat(s.count))
+		} else {
+			fmt.Fprintf(b, "%s%s %s\n", f.name, labels, formatFloat(s.count))
+		}
+	}
+}
+
+// formatLabels formats the label set of a sample.
+func (f *promFamily) formatLabels(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	pairs := make([]string, len(values))
+	for i, v := range values {
+		pairs[i] = fmt.Sprintf("%s=\"%s\"", f.labels[i], escapeLabelValue(v))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// labelValueEscaper escapes label values as required by the text exposition
+// format.
+var labelValueEscaper = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n")
+
+// escapeLabelValue escapes a label value.
+func escapeLabelValue(v string) string {
+	return labelValueEscaper.Replace(v)
+}
+
+// formatFloat formats a sample value.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,93 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestPrometheusObserver tests exporting observed federation traffic.
+func TestPrometheusObserver(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2000, 2, 3, 4, 5, 6, 0, time.UTC)
+	t.Run("ExportsTextFormat", func(t *testing.T) {
+		// Setup
+		setupData()
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		gomock.InOrder(
+			cl.EXPECT().Now().Return(start),
+			cl.EXPECT().Now().Return(start.Add(500*time.Millisecond)),
+			cl.EXPECT().Now().Return(start),
+			cl.EXPECT().Now().Return(start.Add(2*time.Second)),
+			cl.EXPECT().Now().Return(start),
+			cl.EXPECT().Now().Return(start.Add(time.Second)),
+		)
+		p := NewPrometheusObserver(cl)
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		// Run
+		p.RequestReceived(ctx, EndpointPostInbox, req)
+		p.Authenticated(ctx, EndpointPostInbox, true, nil)
+		p.Authenticated(ctx, EndpointPostInbox, false, nil)
+		p.CallbackDispatched(ctx, EndpointPostInbox, "Create")(nil)
+		p.DeliveryAttempted(ctx, mustParse(testFederatedActorIRI))(500, errors.New("nope"))
+		p.DereferenceAttempted(ctx, mustParse(testFederatedActorIRI))(200, nil)
+		var b bytes.Buffer
+		_, err := p.WriteTo(&b)
+		// Verify
+		assertEqual(t, err, nil)
+		expected := `# HELP activitypub_requests_total ActivityPub requests received.
+# TYPE activitypub_requests_total counter
+activitypub_requests_total{endpoint="post_inbox"} 1
+# HELP activitypub_authentications_total Results of authenticating ActivityPub requests.
+# TYPE activitypub_authentications_total counter
+activitypub_authentications_total{endpoint="post_inbox",result="authenticated"} 1
+activitypub_authentications_total{endpoint="post_inbox",result="unauthenticated"} 1
+# HELP activitypub_callbacks_total Activities whose side effects were applied.
+# TYPE activitypub_callbacks_total counter
+activitypub_callbacks_total{endpoint="post_inbox",type="Create",result="success"} 1
+# HELP activitypub_callback_duration_seconds Time spent applying the side effects of activities.
+# TYPE activitypub_callback_duration_seconds summary
+activitypub_callback_duration_seconds_sum{endpoint="post_inbox",type="Create"} 0.5
+activitypub_callback_duration_seconds_count{endpoint="post_inbox",type="Create"} 1
+# HELP activitypub_deliveries_total Deliveries of activities to peer inboxes.
+# TYPE activitypub_deliveries_total counter
+activitypub_deliveries_total{host="other.example.com",result="failure"} 1
+# HELP activitypub_delivery_duration_seconds Time spent delivering activities to peer inboxes.
+# TYPE activitypub_delivery_duration_seconds summary
+activitypub_delivery_duration_seconds_sum{host="other.example.com"} 2
+activitypub_delivery_duration_seconds_count{host="other.example.com"} 1
+# HELP activitypub_dereferences_total Dereferences of peer IRIs.
+# TYPE activitypub_dereferences_total counter
+activitypub_dereferences_total{host="other.example.com",result="success"} 1
+# HELP activitypub_dereference_duration_seconds Time spent dereferencing peer IRIs.
+# TYPE activitypub_dereference_duration_seconds summary
+activitypub_dereference_duration_seconds_sum{host="other.example.com"} 1
+activitypub_dereference_duration_seconds_count{host="other.example.com"} 1
+`
+		assertEqual(t, b.String(), expected)
+	})
+	t.Run("EscapesLabelValues", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(start).Times(2)
+		p := NewPrometheusObserver(cl)
+		// Run
+		p.CallbackDispatched(ctx, EndpointPostOutbox, "Odd\"Type\\\n")(nil)
+		resp := httptest.NewRecorder()
+		p.ServeHTTP(resp, httptest.NewRequest("GET", "/metrics", nil))
+		// Verify
+		assertEqual(t, resp.Header().Get(contentTypeHeader), prometheusContentType)
+		if !strings.Contains(resp.Body.String(), `type="Odd\"Type\\\n"`) {
+			t.Fatalf("label value not escaped:\n%s", resp.Body.String())
+		}
+	})
+}
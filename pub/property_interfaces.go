@@ -23,6 +23,7 @@ type targeter interface {
 // tagger is an ActivityStreams type with a 'tag' property
 type tagger interface {
 	GetActivityStreamsTag() vocab.ActivityStreamsTagProperty
+	SetActivityStreamsTag(i vocab.ActivityStreamsTagProperty)
 }
 
 // hrefer is an ActivityStreams type with a 'href' property
@@ -115,3 +116,40 @@ type actorer interface {
 type appendIRIer interface {
 	AppendIRI(v *url.URL)
 }
+
+// mediaTyper is an ActivityStreams type with a settable 'mediaType' property
+type mediaTyper interface {
+	SetActivityStreamsMediaType(vocab.ActivityStreamsMediaTypeProperty)
+}
+
+// urler is an ActivityStreams type with a settable 'url' property
+type urler interface {
+	SetActivityStreamsUrl(vocab.ActivityStreamsUrlProperty)
+}
+
+// dimensioner is an ActivityStreams type with settable 'width' and 'height'
+// properties
+type dimensioner interface {
+	SetActivityStreamsWidth(vocab.ActivityStreamsWidthProperty)
+	SetActivityStreamsHeight(vocab.ActivityStreamsHeightProperty)
+}
+
+// durationer is an ActivityStreams type with a settable 'duration' property
+type durationer interface {
+	SetActivityStreamsDuration(vocab.ActivityStreamsDurationProperty)
+}
+
+// contenter is an ActivityStreams type with a 'content' property
+type contenter interface {
+	GetActivityStreamsContent() vocab.ActivityStreamsContentProperty
+}
+
+// summaryer is an ActivityStreams type with a 'summary' property
+type summaryer interface {
+	GetActivityStreamsSummary() vocab.ActivityStreamsSummaryProperty
+}
+
+// namer is an ActivityStreams type with a 'name' property
+type namer interface {
+	GetActivityStreamsName() vocab.ActivityStreamsNameProperty
+}
@@ -0,0 +1,301 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+const (
+	// defaultMaxProxySize is the default limit of the size of proxied
+	// documents.
+	defaultMaxProxySize = 1 << 20
+	// proxyIdField is the form field of the IRI to proxy.
+	proxyIdField = "id"
+)
+
+// lookupIPAddr resolves the host of a proxied IRI.
+var lookupIPAddr = net.DefaultResolver.LookupIPAddr
+
+// nonPublicNetworks are the private networks that proxied IRIs must not
+// resolve to, in addition to loopback, link-local, and unspecified addresses.
+var nonPublicNetworks = mustParseCIDRs(
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"100.64.0.0/10",
+	"fc00::/7",
+)
+
+// mustParseCIDRs parses networks in CIDR notation, panicking on failure.
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets[i] = n
+	}
+	return nets
+}
+
+// isPublicIP determines whether the address is reachable on the Internet, as
+// opposed to the server itself or its private networks.
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, n := range nonPublicNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// resolvesPublicly determines whether all the addresses of the host are
+// public.
+func resolvesPublicly(c context.Context, host string) (bool, error) {
+	addrs, err := lookupIPAddr(c, host)
+	if err != nil {
+		return false, err
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return false, nil
+		}
+	}
+	return len(addrs) > 0, nil
+}
+
+// isPublicAddress determines whether a dialed "host:port" address is public.
+var isPublicAddress = func(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && isPublicIP(ip)
+}
+
+// publicOnlyTransport is the RoundTripper of requests that must only connect
+// to public addresses. The addresses are checked as they are dialed, after the
+// host is resolved, so that neither redirects nor DNS rebinding reach the
+// server's own networks.
+var publicOnlyTransport http.RoundTripper = &http.Transport{
+	DialContext: (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			if !isPublicAddress(address) {
+				return fmt.Errorf("%s is not a public address", address)
+			}
+			return nil
+		},
+	}).DialContext,
+	MaxIdleConns:          100,
+	IdleConnTimeout:       90 * time.Second,
+	TLSHandshakeTimeout:   10 * time.Second,
+	ExpectContinueTimeout: 1 * time.Second,
+}
+
+// checkPublicRedirect refuses redirects to IRIs whose host does not resolve to
+// public addresses, as well as long chains of redirects.
+func checkPublicRedirect(r *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		return fmt.Errorf("stopped after %d redirects", len(via))
+	} else if r.URL.Scheme != "https" && r.URL.Scheme != "http" {
+		return fmt.Errorf("redirected to unsupported scheme %q", r.URL.Scheme)
+	}
+	if public, err := resolvesPublicly(r.Context(), r.URL.Hostname()); err != nil {
+		return err
+	} else if !public {
+		return fmt.Errorf("redirected to %s, which is not public", r.URL.Host)
+	}
+	return nil
+}
+
+// publicOnlyClient returns an HTTP client only connecting to public addresses,
+// keeping the timeout and cookies of the given client if it is an http.Client.
+func publicOnlyClient(client HttpClient) *http.Client {
+	c := &http.Client{
+		Transport:     publicOnlyTransport,
+		CheckRedirect: checkPublicRedirect,
+	}
+	if hc, ok := client.(*http.Client); ok {
+		c.Timeout = hc.Timeout
+		c.Jar = hc.Jar
+	}
+	return c
+}
+
+// ProxyURLConfig configures the handler of a proxyUrl endpoint.
+type ProxyURLConfig struct {
+	// Authenticate authenticates and authorizes the local user. It has the
+	// semantics of CommonBehavior's AuthenticateGetInbox, which is
+	// typically used.
+	Authenticate func(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error)
+	// Box returns the IRI of the user's inbox or outbox, given the request
+	// to their proxyUrl endpoint. The Transport obtained for it from the
+	// CommonBehavior signs the fetch.
+	Box func(c context.Context, r *http.Request) (*url.URL, error)
+	// Common provides the user's Transport.
+	Common CommonBehavior
+	// Blocked determines whether the requested IRI, or the actors and
+	// authors of the fetched value, are blocked. It has the semantics of
+	// FederatingProtocol's Blocked, which is typically used. If nil, nothing
+	// is blocked.
+	Blocked func(c context.Context, actorIRIs []*url.URL) (bool, error)
+	// Clock determines the Date header of responses.
+	Clock Clock
+	// MaxSize is the largest size of a proxied document in bytes. Defaults
+	// to 1 MiB. HttpSigTransport stops reading the document once it is
+	// exceeded.
+	MaxSize int
+}
+
+// NewProxyURLHandler creates a HandlerFunc serving an actor's proxyUrl
+// endpoint, with which C2S clients such as web browsers fetch remote values
+// that require signed fetches.
+//
+// It handles POST requests whose form has the IRI to fetch as its "id" field.
+// Once the user is authenticated, the IRI is dereferenced with the user's
+// Transport, and the ActivityStreams document is returned to the client.
+//
+// Requests for blocked values, and for IRIs whose host resolves to a loopback,
+// link-local, or private address, are responded to with a 403 Forbidden
+// status. Remote failures, documents larger than the size limit, and documents
+// that are not ActivityStreams are responded to with a 502 Bad Gateway status.
+//
+// When the Transport is an HttpSigTransport, it only connects to public
+// addresses while fetching, including for each redirect, using its own
+// connections rather than the RoundTripper of its HTTP client. Other
+// Transports are responsible for this themselves.
+func NewProxyURLHandler(cfg ProxyURLConfig) HandlerFunc {
+	if cfg.MaxSize <= 0 {
+		cfg.MaxSize = defaultMaxProxySize
+	}
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		// Do nothing if it is not a form POST request.
+		if r.Method != "POST" {
+			return
+		} else if mediaType, _, err := mime.ParseMediaType(r.Header.Get(contentTypeHeader)); err != nil || mediaType != "application/x-www-form-urlencoded" {
+			return false, nil
+		}
+		isASRequest = true
+		c, authenticated, err := cfg.Authenticate(c, w, r)
+		if err != nil || !authenticated {
+			return
+		}
+		if err = r.ParseForm(); err != nil {
+			http.Error(w, "malformed form", http.StatusBadRequest)
+			return true, nil
+		}
+		iri, err := url.Parse(r.PostForm.Get(proxyIdField))
+		if err != nil || (iri.Scheme != "https" && iri.Scheme != "http") || len(iri.Host) == 0 {
+			http.Error(w, "invalid id", http.StatusBadRequest)
+			return true, nil
+		}
+		if blocked, err := cfg.blocked(c, []*url.URL{iri}); err != nil {
+			return true, err
+		} else if blocked {
+			w.WriteHeader(http.StatusForbidden)
+			return true, nil
+		}
+		// Do not let users reach the server's own network.
+		if public, err := resolvesPublicly(c, iri.Hostname()); err != nil {
+			http.Error(w, fmt.Sprintf("resolving %s failed", iri.Host), http.StatusBadGateway)
+			return true, nil
+		} else if !public {
+			w.WriteHeader(http.StatusForbidden)
+			return true, nil
+		}
+		box, err := cfg.Box(c, r)
+		if err != nil {
+			return
+		}
+		tp, err := cfg.Common.NewTransport(c, box, goFedUserAgent())
+		if err != nil {
+			return
+		}
+		b, err := tp.Dereference(withPublicOnly(withMaxResponseSize(c, int64(cfg.MaxSize))), iri)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("fetching %s failed", iri), http.StatusBadGateway)
+			return true, nil
+		} else if len(b) > cfg.MaxSize {
+			http.Error(w, fmt.Sprintf("%s is too large", iri), http.StatusBadGateway)
+			return true, nil
+		}
+		var m map[string]interface{}
+		if err = json.Unmarshal(b, &m); err != nil {
+			http.Error(w, fmt.Sprintf("%s is not JSON", iri), http.StatusBadGateway)
+			return true, nil
+		}
+		NormalizeJSON(m)
+		t, err := streams.ToType(c, m)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("%s is not ActivityStreams", iri), http.StatusBadGateway)
+			return true, nil
+		}
+		if blocked, err := cfg.blocked(c, involvedActors(t)); err != nil {
+			return true, err
+		} else if blocked {
+			w.WriteHeader(http.StatusForbidden)
+			return true, nil
+		}
+		addResponseHeaders(w.Header(), cfg.Clock, b)
+		// The response depends on the user's credentials.
+		w.Header().Set("Cache-Control", "private")
+		w.WriteHeader(http.StatusOK)
+		n, err := w.Write(b)
+		if err != nil {
+			return
+		} else if n != len(b) {
+			err = fmt.Errorf("only wrote %d of %d bytes", n, len(b))
+			return
+		}
+		return
+	}
+}
+
+// blocked applies the block policy, if any.
+func (cfg ProxyURLConfig) blocked(c context.Context, iris []*url.URL) (bool, error) {
+	if cfg.Blocked == nil || len(iris) == 0 {
+		return false, nil
+	}
+	return cfg.Blocked(c, iris)
+}
+
+// involvedActors obtains the 'id' of the value, and the IRIs of its actors and
+// authors.
+func involvedActors(t vocab.Type) []*url.URL {
+	var iris []*url.URL
+	if id, err := GetId(t); err == nil {
+		iris = append(iris, id)
+	}
+	if v, ok := t.(actorer); ok && v.GetActivityStreamsActor() != nil {
+		for iter := v.GetActivityStreamsActor().Begin(); iter != v.GetActivityStreamsActor().End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil {
+				iris = append(iris, id)
+			}
+		}
+	}
+	if v, ok := t.(attributedToer); ok && v.GetActivityStreamsAttributedTo() != nil {
+		for iter := v.GetActivityStreamsAttributedTo().Begin(); iter != v.GetActivityStreamsAttributedTo().End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil {
+				iris = append(iris, id)
+			}
+		}
+	}
+	return iris
+}
@@ -0,0 +1,391 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// testProxiedNote is a remote Note fetched through the proxyUrl endpoint.
+const testProxiedNote = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://other.example.com/note/1",
+  "type": "Note",
+  "attributedTo": "https://other.example.com/dakota",
+  "content": "hello"
+}`
+
+// TestProxyURLHandler tests fetching remote values for C2S clients.
+func TestProxyURLHandler(t *testing.T) {
+	// Set up test case
+	setupData()
+	c := context.Background()
+	noteIRI := "https://other.example.com/note/1"
+	defer func(l func(context.Context, string) ([]net.IPAddr, error)) { lookupIPAddr = l }(lookupIPAddr)
+	lookupIPAddr = func(c context.Context, host string) ([]net.IPAddr, error) {
+		switch host {
+		case "other.example.com":
+			return []net.IPAddr{{IP: net.ParseIP("192.0.2.1")}}, nil
+		case "internal.example.com":
+			return []net.IPAddr{{IP: net.ParseIP("192.0.2.1")}, {IP: net.ParseIP("10.0.0.1")}}, nil
+		}
+		if ip := net.ParseIP(host); ip != nil {
+			return []net.IPAddr{{IP: ip}}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+	setupFn := func(ctl *gomock.Controller, authenticated bool, blocked string) (cb *MockCommonBehavior, tp *MockTransport, clock *MockClock, hf HandlerFunc) {
+		cb = NewMockCommonBehavior(ctl)
+		tp = NewMockTransport(ctl)
+		clock = NewMockClock(ctl)
+		hf = NewProxyURLHandler(ProxyURLConfig{
+			Authenticate: func(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+				if !authenticated {
+					w.WriteHeader(http.StatusUnauthorized)
+				}
+				return c, authenticated, nil
+			},
+			Box: func(c context.Context, r *http.Request) (*url.URL, error) {
+				return mustParse(testMyInboxIRI), nil
+			},
+			Common: cb,
+			Blocked: func(c context.Context, actorIRIs []*url.URL) (bool, error) {
+				for _, iri := range actorIRIs {
+					if iri.String() == blocked {
+						return true, nil
+					}
+				}
+				return false, nil
+			},
+			Clock:   clock,
+			MaxSize: len(testProxiedNote),
+		})
+		return
+	}
+	proxyRequest := func(id string) *http.Request {
+		r := httptest.NewRequest("POST", "https://example.com/addison/proxyUrl", strings.NewReader(url.Values{"id": []string{id}}.Encode()))
+		r.Header.Set(contentTypeHeader, "application/x-www-form-urlencoded")
+		return r
+	}
+	// Run tests
+	t.Run("ReturnsDocument", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cb, tp, clock, hf := setupFn(ctl, true, "")
+		resp := httptest.NewRecorder()
+		cb.EXPECT().NewTransport(c, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Dereference(gomock.Any(), mustParse(noteIRI)).Return([]byte(testProxiedNote), nil)
+		clock.EXPECT().Now().Return(now())
+		// Run the test
+		handled, err := hf(c, resp, proxyRequest(noteIRI))
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, resp.Body.String(), testProxiedNote)
+		assertEqual(t, resp.Header().Get(contentTypeHeader), contentTypeHeaderValue)
+		assertEqual(t, resp.Header().Get("Cache-Control"), "private")
+	})
+	t.Run("BlocksRequestedIRI", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, hf := setupFn(ctl, true, noteIRI)
+		resp := httptest.NewRecorder()
+		// Run the test
+		handled, err := hf(c, resp, proxyRequest(noteIRI))
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("BlocksAuthor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cb, tp, _, hf := setupFn(ctl, true, testFederatedActorIRI)
+		resp := httptest.NewRecorder()
+		cb.EXPECT().NewTransport(c, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Dereference(gomock.Any(), mustParse(noteIRI)).Return([]byte(testProxiedNote), nil)
+		// Run the test
+		handled, err := hf(c, resp, proxyRequest(noteIRI))
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("RejectsLargeDocument", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cb, tp, _, hf := setupFn(ctl, true, "")
+		resp := httptest.NewRecorder()
+		cb.EXPECT().NewTransport(c, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Dereference(gomock.Any(), mustParse(noteIRI)).Return([]byte(testProxiedNote+" "), nil)
+		// Run the test
+		handled, err := hf(c, resp, proxyRequest(noteIRI))
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusBadGateway)
+	})
+	t.Run("RejectsPrivateAddresses", func(t *testing.T) {
+		for _, iri := range []string{
+			"http://127.0.0.1/note",
+			"http://[::1]/note",
+			"http://169.254.169.254/latest/meta-data",
+			"https://internal.example.com/note",
+		} {
+			t.Run(iri, func(t *testing.T) {
+				// Setup
+				ctl := gomock.NewController(t)
+				defer ctl.Finish()
+				_, _, _, hf := setupFn(ctl, true, "")
+				resp := httptest.NewRecorder()
+				// Run the test
+				handled, err := hf(c, resp, proxyRequest(iri))
+				// Verify results
+				assertEqual(t, handled, true)
+				assertEqual(t, err, nil)
+				assertEqual(t, resp.Code, http.StatusForbidden)
+			})
+		}
+	})
+	t.Run("UnresolvableHostIsBadGateway", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, hf := setupFn(ctl, true, "")
+		resp := httptest.NewRecorder()
+		// Run the test
+		handled, err := hf(c, resp, proxyRequest("https://unknown.example.com/note"))
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusBadGateway)
+	})
+	t.Run("RemoteFailureIsBadGateway", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cb, tp, _, hf := setupFn(ctl, true, "")
+		resp := httptest.NewRecorder()
+		cb.EXPECT().NewTransport(c, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Dereference(gomock.Any(), mustParse(noteIRI)).Return(nil, errors.New("expected"))
+		// Run the test
+		handled, err := hf(c, resp, proxyRequest(noteIRI))
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusBadGateway)
+	})
+	t.Run("RequiresAuthentication", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, hf := setupFn(ctl, false, "")
+		resp := httptest.NewRecorder()
+		// Run the test
+		handled, err := hf(c, resp, proxyRequest(noteIRI))
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+	t.Run("RejectsInvalidId", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, hf := setupFn(ctl, true, "")
+		resp := httptest.NewRecorder()
+		// Run the test
+		handled, err := hf(c, resp, proxyRequest("file:///etc/passwd"))
+		// Verify results
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+	t.Run("IgnoresOtherRequests", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, hf := setupFn(ctl, true, "")
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", "https://example.com/addison/proxyUrl", nil))
+		// Run the test
+		handled, err := hf(c, resp, req)
+		// Verify results
+		assertEqual(t, handled, false)
+		assertEqual(t, err, nil)
+	})
+}
+
+// TestIsPublicIP tests distinguishing public addresses from the server's own
+// networks.
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip       string
+		expected bool
+	}{
+		{"192.0.2.1", true},
+		{"2001:db8::1", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"0.0.0.0", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"100.64.0.1", false},
+		{"fd00::1", false},
+	}
+	for _, test := range tests {
+		t.Run(test.ip, func(t *testing.T) {
+			assertEqual(t, isPublicIP(net.ParseIP(test.ip)), test.expected)
+		})
+	}
+}
+
+// TestHttpSigTransportDereferenceLimit tests that dereferencing stops reading
+// responses larger than the limit in the context.
+func TestHttpSigTransportDereferenceLimit(t *testing.T) {
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, httpsig.DigestSha256, []string{"(request-target)", "date"}, httpsig.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock := NewMockClock(ctl)
+	clock.EXPECT().Now().Return(now()).Times(2)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testProxiedNote))
+	}))
+	defer s.Close()
+	tp := NewHttpSigTransport(s.Client(), "test", clock, signer, signer, testMyInboxIRI+"#key", key)
+	c := context.Background()
+	// Run the test
+	within, withinErr := tp.Dereference(withMaxResponseSize(c, int64(len(testProxiedNote))), mustParse(s.URL))
+	_, beyondErr := tp.Dereference(withMaxResponseSize(c, int64(len(testProxiedNote)-1)), mustParse(s.URL))
+	// Verify results
+	assertEqual(t, withinErr, nil)
+	assertEqual(t, string(within), testProxiedNote)
+	assertEqual(t, beyondErr != nil, true)
+}
+
+// TestHttpSigTransportDereferencePublicOnly tests that dereferencing with a
+// public-only context refuses to connect to non-public addresses, including
+// ones reached through redirects.
+func TestHttpSigTransportDereferencePublicOnly(t *testing.T) {
+	// Setup
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, httpsig.DigestSha256, []string{"(request-target)", "date"}, httpsig.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	internalHit := false
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		internalHit = true
+		w.Write([]byte(testProxiedNote))
+	}))
+	defer internal.Close()
+	internalURL := mustParse(internal.URL)
+	var redirectTo string
+	remote := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(redirectTo) > 0 {
+			http.Redirect(w, r, redirectTo, http.StatusFound)
+			return
+		}
+		w.Write([]byte(testProxiedNote))
+	}))
+	defer remote.Close()
+	remoteURL := mustParse(remote.URL)
+	// Only the remote server counts as public, and localhost resolves to a
+	// public address until it is dialed.
+	defer func(p func(string) bool) { isPublicAddress = p }(isPublicAddress)
+	isPublicAddress = func(address string) bool {
+		return address == remoteURL.Host
+	}
+	defer func(l func(context.Context, string) ([]net.IPAddr, error)) { lookupIPAddr = l }(lookupIPAddr)
+	lookupIPAddr = func(c context.Context, host string) ([]net.IPAddr, error) {
+		if host == "localhost" {
+			return []net.IPAddr{{IP: net.ParseIP("192.0.2.1")}}, nil
+		} else if ip := net.ParseIP(host); ip != nil {
+			return []net.IPAddr{{IP: ip}}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+	setupFn := func(ctl *gomock.Controller) Transport {
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(now())
+		internalHit = false
+		return NewHttpSigTransport(http.DefaultClient, "test", clock, signer, signer, testMyInboxIRI+"#key", key)
+	}
+	c := withPublicOnly(context.Background())
+	// Run tests
+	t.Run("FetchesPublicAddress", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := setupFn(ctl)
+		redirectTo = ""
+		// Run the test
+		b, err := tp.Dereference(c, remoteURL)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), testProxiedNote)
+	})
+	t.Run("RefusesNonPublicAddress", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := setupFn(ctl)
+		// Run the test
+		_, err := tp.Dereference(c, internalURL)
+		// Verify results
+		assertEqual(t, err != nil, true)
+		assertEqual(t, internalHit, false)
+	})
+	t.Run("RefusesRedirectToLoopback", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := setupFn(ctl)
+		redirectTo = internal.URL
+		// Run the test
+		_, err := tp.Dereference(c, remoteURL)
+		// Verify results
+		assertEqual(t, err != nil, true)
+		assertEqual(t, internalHit, false)
+	})
+	t.Run("RefusesRedirectReboundToLoopback", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := setupFn(ctl)
+		redirectTo = "http://localhost:" + internalURL.Port()
+		// Run the test
+		_, err := tp.Dereference(c, remoteURL)
+		// Verify results
+		assertEqual(t, err != nil, true)
+		assertEqual(t, internalHit, false)
+	})
+}
@@ -0,0 +1,163 @@
+package pubtest
+
+import (
+	"context"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// Actor is a user of an Instance, with helpers to act on their behalf and
+// inspect their state in scenarios.
+type Actor struct {
+	// Instance is the server of the actor.
+	Instance *Instance
+	// IRI is the id of the actor.
+	IRI *url.URL
+	// Inbox is the IRI of the actor's inbox.
+	Inbox *url.URL
+	// Outbox is the IRI of the actor's outbox.
+	Outbox *url.URL
+	// Followers is the IRI of the actor's followers collection.
+	Followers *url.URL
+	// Following is the IRI of the actor's following collection.
+	Following *url.URL
+	// Liked is the IRI of the actor's liked collection.
+	Liked *url.URL
+}
+
+// NewActor creates a Person with a new key on the Instance. Its IRI is
+// "/users/" followed by the name.
+func (i *Instance) NewActor(name string) *Actor {
+	a := &Actor{
+		Instance:  i,
+		IRI:       i.iri("/users/" + name),
+		Inbox:     i.iri("/users/" + name + "/inbox"),
+		Outbox:    i.iri("/users/" + name + "/outbox"),
+		Followers: i.iri("/users/" + name + "/followers"),
+		Following: i.iri("/users/" + name + "/following"),
+		Liked:     i.iri("/users/" + name + "/liked"),
+	}
+	k, err := newKeyPair(a.IRI)
+	if err != nil {
+		i.network.t.Fatal(err)
+	}
+	p := streams.NewActivityStreamsPerson()
+	p.SetJSONLDId(idProperty(a.IRI))
+	username := streams.NewActivityStreamsPreferredUsernameProperty()
+	username.SetXMLSchemaString(name)
+	p.SetActivityStreamsPreferredUsername(username)
+	inbox := streams.NewActivityStreamsInboxProperty()
+	inbox.SetIRI(a.Inbox)
+	p.SetActivityStreamsInbox(inbox)
+	outbox := streams.NewActivityStreamsOutboxProperty()
+	outbox.SetIRI(a.Outbox)
+	p.SetActivityStreamsOutbox(outbox)
+	followers := streams.NewActivityStreamsFollowersProperty()
+	followers.SetIRI(a.Followers)
+	p.SetActivityStreamsFollowers(followers)
+	following := streams.NewActivityStreamsFollowingProperty()
+	following.SetIRI(a.Following)
+	p.SetActivityStreamsFollowing(following)
+	liked := streams.NewActivityStreamsLikedProperty()
+	liked.SetIRI(a.Liked)
+	p.SetActivityStreamsLiked(liked)
+	p.SetW3IDSecurityV1PublicKey(k.publicKeyProperty(a.IRI))
+	c := context.Background()
+	for _, id := range []*url.URL{a.Followers, a.Following, a.Liked} {
+		col := streams.NewActivityStreamsCollection()
+		col.SetJSONLDId(idProperty(id))
+		col.SetActivityStreamsItems(streams.NewActivityStreamsItemsProperty())
+		if err = i.DB.Create(c, col); err != nil {
+			i.network.t.Fatal(err)
+		}
+	}
+	if err = i.createActor(p, k); err != nil {
+		i.network.t.Fatal(err)
+	}
+	return a
+}
+
+// Send posts the value to the actor's outbox, and delivers it to its
+// recipients. Values that are not activities are wrapped in a Create.
+func (a *Actor) Send(c context.Context, t vocab.Type) (pub.Activity, error) {
+	return a.Instance.Actor.Send(c, a.Outbox, t)
+}
+
+// Follow sends a Follow of the other actor.
+func (a *Actor) Follow(c context.Context, other *Actor) (pub.Activity, error) {
+	f := streams.NewActivityStreamsFollow()
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(a.IRI)
+	f.SetActivityStreamsActor(actor)
+	obj := streams.NewActivityStreamsObjectProperty()
+	obj.AppendIRI(other.IRI)
+	f.SetActivityStreamsObject(obj)
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(other.IRI)
+	f.SetActivityStreamsTo(to)
+	return a.Send(c, f)
+}
+
+// Post sends a Create of a Note with the content, addressed to the
+// recipients.
+func (a *Actor) Post(c context.Context, content string, to ...*url.URL) (pub.Activity, error) {
+	n := streams.NewActivityStreamsNote()
+	attributedTo := streams.NewActivityStreamsAttributedToProperty()
+	attributedTo.AppendIRI(a.IRI)
+	n.SetActivityStreamsAttributedTo(attributedTo)
+	contentProp := streams.NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString(content)
+	n.SetActivityStreamsContent(contentProp)
+	toProp := streams.NewActivityStreamsToProperty()
+	for _, iri := range to {
+		toProp.AppendIRI(iri)
+	}
+	n.SetActivityStreamsTo(toProp)
+	return a.Send(c, n)
+}
+
+// InboxContains determines whether the actor's inbox contains the id. It
+// fails the test if the inbox cannot be read.
+func (a *Actor) InboxContains(c context.Context, id *url.URL) bool {
+	contains, err := a.Instance.DB.InboxContains(c, a.Inbox, id)
+	if err != nil {
+		a.Instance.network.t.Fatal(err)
+	}
+	return contains
+}
+
+// FollowerIRIs returns the members of the actor's followers collection. It
+// fails the test if the collection cannot be read.
+func (a *Actor) FollowerIRIs(c context.Context) []*url.URL {
+	col, err := a.Instance.DB.Followers(c, a.IRI)
+	if err != nil {
+		a.Instance.network.t.Fatal(err)
+	}
+	return a.items(col)
+}
+
+// FollowingIRIs returns the members of the actor's following collection. It
+// fails the test if the collection cannot be read.
+func (a *Actor) FollowingIRIs(c context.Context) []*url.URL {
+	col, err := a.Instance.DB.Following(c, a.IRI)
+	if err != nil {
+		a.Instance.network.t.Fatal(err)
+	}
+	return a.items(col)
+}
+
+// items returns the ids of the items of a collection.
+func (a *Actor) items(col vocab.ActivityStreamsCollection) []*url.URL {
+	var ids []*url.URL
+	items := col.GetActivityStreamsItems()
+	for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+		id, err := pub.ToId(iter)
+		if err != nil {
+			a.Instance.network.t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,42 @@
+package pubtest
+
+import (
+	"github.com/go-fed/activity/pub"
+	"sync"
+	"time"
+)
+
+// FakeClock must satisfy the pub.Clock interface.
+var _ pub.Clock = &FakeClock{}
+
+// FakeClock is a pub.Clock that only changes when told to.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock creates a FakeClock set to the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the current fake time.
+func (f *FakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Set changes the current fake time.
+func (f *FakeClock) Set(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = now
+}
+
+// Advance moves the current fake time forward.
+func (f *FakeClock) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
@@ -0,0 +1,363 @@
+package pubtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// MemoryDatabase must satisfy the pub.Database interface.
+var _ pub.Database = &MemoryDatabase{}
+
+// MemoryDatabase is an in-memory pub.Database for a single host.
+//
+// Values are stored serialized, so callers never share memory with the
+// database, just like with a real one. Storing an actor records which inbox
+// and outbox belong to it.
+type MemoryDatabase struct {
+	scheme string
+	host   string
+	// mu guards the fields below.
+	mu            sync.Mutex
+	locks         map[string]*sync.Mutex
+	values        map[string][]byte
+	actorByInbox  map[string]*url.URL
+	actorByOutbox map[string]*url.URL
+	outboxByInbox map[string]*url.URL
+	nextId        int
+}
+
+// NewMemoryDatabase creates an empty MemoryDatabase owning the IRIs at the
+// scheme and host of the given base IRI, such as "https://example.com".
+func NewMemoryDatabase(base *url.URL) *MemoryDatabase {
+	return &MemoryDatabase{
+		scheme:        base.Scheme,
+		host:          base.Host,
+		locks:         make(map[string]*sync.Mutex),
+		values:        make(map[string][]byte),
+		actorByInbox:  make(map[string]*url.URL),
+		actorByOutbox: make(map[string]*url.URL),
+		outboxByInbox: make(map[string]*url.URL),
+	}
+}
+
+// Lock takes the lock for the IRI.
+func (m *MemoryDatabase) Lock(c context.Context, id *url.URL) error {
+	m.mu.Lock()
+	l, ok := m.locks[id.String()]
+	if !ok {
+		l = &sync.Mutex{}
+		m.locks[id.String()] = l
+	}
+	m.mu.Unlock()
+	l.Lock()
+	return nil
+}
+
+// Unlock releases the lock for the IRI.
+func (m *MemoryDatabase) Unlock(c context.Context, id *url.URL) error {
+	m.mu.Lock()
+	l, ok := m.locks[id.String()]
+	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("unlock of %s which was never locked", id)
+	}
+	l.Unlock()
+	return nil
+}
+
+// InboxContains determines whether the inbox contains the id.
+func (m *MemoryDatabase) InboxContains(c context.Context, inbox, id *url.URL) (bool, error) {
+	items, err := m.orderedItems(c, inbox)
+	if err != nil {
+		return false, err
+	}
+	for _, item := range items {
+		if item.String() == id.String() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetInbox returns a page with all items in the inbox.
+func (m *MemoryDatabase) GetInbox(c context.Context, inboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	return m.page(c, inboxIRI)
+}
+
+// SetInbox replaces the items of the inbox with those on the page.
+func (m *MemoryDatabase) SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	return m.setPage(c, inbox)
+}
+
+// Owns determines whether the IRI is on this database's host.
+func (m *MemoryDatabase) Owns(c context.Context, id *url.URL) (bool, error) {
+	return id.Scheme == m.scheme && id.Host == m.host, nil
+}
+
+// ActorForOutbox returns the actor owning the outbox.
+func (m *MemoryDatabase) ActorForOutbox(c context.Context, outboxIRI *url.URL) (*url.URL, error) {
+	return m.lookup(m.actorByOutbox, outboxIRI)
+}
+
+// ActorForInbox returns the actor owning the inbox.
+func (m *MemoryDatabase) ActorForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	return m.lookup(m.actorByInbox, inboxIRI)
+}
+
+// OutboxForInbox returns the outbox of the actor owning the inbox.
+func (m *MemoryDatabase) OutboxForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	return m.lookup(m.outboxByInbox, inboxIRI)
+}
+
+// Exists determines whether a value with the id is stored.
+func (m *MemoryDatabase) Exists(c context.Context, id *url.URL) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.values[id.String()]
+	return ok, nil
+}
+
+// Get returns a copy of the value with the id.
+func (m *MemoryDatabase) Get(c context.Context, id *url.URL) (vocab.Type, error) {
+	m.mu.Lock()
+	b, ok := m.values[id.String()]
+	m.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("no value with id %s", id)
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+	return streams.ToType(c, raw)
+}
+
+// Create stores a new value.
+func (m *MemoryDatabase) Create(c context.Context, asType vocab.Type) error {
+	return m.store(asType)
+}
+
+// Update replaces an existing value.
+func (m *MemoryDatabase) Update(c context.Context, asType vocab.Type) error {
+	return m.store(asType)
+}
+
+// Delete removes a value.
+func (m *MemoryDatabase) Delete(c context.Context, id *url.URL) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.values, id.String())
+	return nil
+}
+
+// GetOutbox returns a page with all items in the outbox.
+func (m *MemoryDatabase) GetOutbox(c context.Context, outboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	return m.page(c, outboxIRI)
+}
+
+// SetOutbox replaces the items of the outbox with those on the page.
+func (m *MemoryDatabase) SetOutbox(c context.Context, outbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	return m.setPage(c, outbox)
+}
+
+// NewId mints a new IRI on this database's host for the value.
+func (m *MemoryDatabase) NewId(c context.Context, t vocab.Type) (*url.URL, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextId++
+	return url.Parse(fmt.Sprintf("%s://%s/%ss/%d", m.scheme, m.host, strings.ToLower(t.GetTypeName()), m.nextId))
+}
+
+// Followers returns the followers collection of the actor.
+func (m *MemoryDatabase) Followers(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	return m.collection(c, actorIRI, func(actor vocab.Type) *url.URL {
+		if f, ok := actor.(followerser); ok && f.GetActivityStreamsFollowers() != nil {
+			return f.GetActivityStreamsFollowers().GetIRI()
+		}
+		return nil
+	})
+}
+
+// Following returns the following collection of the actor.
+func (m *MemoryDatabase) Following(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	return m.collection(c, actorIRI, func(actor vocab.Type) *url.URL {
+		if f, ok := actor.(followinger); ok && f.GetActivityStreamsFollowing() != nil {
+			return f.GetActivityStreamsFollowing().GetIRI()
+		}
+		return nil
+	})
+}
+
+// Liked returns the liked collection of the actor.
+func (m *MemoryDatabase) Liked(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	return m.collection(c, actorIRI, func(actor vocab.Type) *url.URL {
+		if l, ok := actor.(likeder); ok && l.GetActivityStreamsLiked() != nil {
+			return l.GetActivityStreamsLiked().GetIRI()
+		}
+		return nil
+	})
+}
+
+// store serializes and saves a value, recording the boxes of actors.
+func (m *MemoryDatabase) store(t vocab.Type) error {
+	id, err := pub.GetId(t)
+	if err != nil {
+		return err
+	}
+	raw, err := streams.Serialize(t)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values[id.String()] = b
+	if a, ok := t.(boxer); ok && a.GetActivityStreamsInbox() != nil && a.GetActivityStreamsOutbox() != nil {
+		inbox := a.GetActivityStreamsInbox().GetIRI()
+		outbox := a.GetActivityStreamsOutbox().GetIRI()
+		if inbox != nil && outbox != nil {
+			m.actorByInbox[inbox.String()] = id
+			m.actorByOutbox[outbox.String()] = id
+			m.outboxByInbox[inbox.String()] = outbox
+		}
+	}
+	return nil
+}
+
+// lookup finds the IRI associated with another.
+func (m *MemoryDatabase) lookup(in map[string]*url.URL, key *url.URL) (*url.URL, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := in[key.String()]
+	if !ok {
+		return nil, fmt.Errorf("no actor with box %s", key)
+	}
+	return v, nil
+}
+
+// orderedItems returns the IRIs in an ordered collection.
+func (m *MemoryDatabase) orderedItems(c context.Context, id *url.URL) ([]*url.URL, error) {
+	t, err := m.Get(c, id)
+	if err != nil {
+		return nil, err
+	}
+	oc, ok := t.(vocab.ActivityStreamsOrderedCollection)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an OrderedCollection: %T", id, t)
+	}
+	var items []*url.URL
+	if oi := oc.GetActivityStreamsOrderedItems(); oi != nil {
+		for iter := oi.Begin(); iter != oi.End(); iter = iter.Next() {
+			itemId, err := pub.ToId(iter)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, itemId)
+		}
+	}
+	return items, nil
+}
+
+// page returns a single page containing every item of an ordered
+// collection. The page has the id of the collection, so it can be saved with
+// setPage.
+func (m *MemoryDatabase) page(c context.Context, id *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	items, err := m.orderedItems(c, id)
+	if err != nil {
+		return nil, err
+	}
+	p := streams.NewActivityStreamsOrderedCollectionPage()
+	p.SetJSONLDId(idProperty(id))
+	oi := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, item := range items {
+		oi.AppendIRI(item)
+	}
+	p.SetActivityStreamsOrderedItems(oi)
+	return p, nil
+}
+
+// setPage replaces the items of the ordered collection with the id of the
+// page.
+func (m *MemoryDatabase) setPage(c context.Context, p vocab.ActivityStreamsOrderedCollectionPage) error {
+	id, err := pub.GetId(p)
+	if err != nil {
+		return err
+	}
+	oc := streams.NewActivityStreamsOrderedCollection()
+	oc.SetJSONLDId(idProperty(id))
+	oi := streams.NewActivityStreamsOrderedItemsProperty()
+	if items := p.GetActivityStreamsOrderedItems(); items != nil {
+		for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+			itemId, err := pub.ToId(iter)
+			if err != nil {
+				return err
+			}
+			oi.AppendIRI(itemId)
+		}
+	}
+	oc.SetActivityStreamsOrderedItems(oi)
+	return m.store(oc)
+}
+
+// collection returns one of an actor's collections, found with the getter.
+// The collection always has an 'items' property, even if it is empty.
+func (m *MemoryDatabase) collection(c context.Context, actorIRI *url.URL, getter func(actor vocab.Type) *url.URL) (vocab.ActivityStreamsCollection, error) {
+	actor, err := m.Get(c, actorIRI)
+	if err != nil {
+		return nil, err
+	}
+	id := getter(actor)
+	if id == nil {
+		return nil, fmt.Errorf("actor %s does not have the collection", actorIRI)
+	}
+	t, err := m.Get(c, id)
+	if err != nil {
+		return nil, err
+	}
+	col, ok := t.(vocab.ActivityStreamsCollection)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a Collection: %T", id, t)
+	}
+	if col.GetActivityStreamsItems() == nil {
+		col.SetActivityStreamsItems(streams.NewActivityStreamsItemsProperty())
+	}
+	return col, nil
+}
+
+// idProperty creates an 'id' property.
+func idProperty(id *url.URL) vocab.JSONLDIdProperty {
+	p := streams.NewJSONLDIdProperty()
+	p.Set(id)
+	return p
+}
+
+// boxer is an actor with an inbox and outbox.
+type boxer interface {
+	GetActivityStreamsInbox() vocab.ActivityStreamsInboxProperty
+	GetActivityStreamsOutbox() vocab.ActivityStreamsOutboxProperty
+}
+
+// followerser has a 'followers' property.
+type followerser interface {
+	GetActivityStreamsFollowers() vocab.ActivityStreamsFollowersProperty
+}
+
+// followinger has a 'following' property.
+type followinger interface {
+	GetActivityStreamsFollowing() vocab.ActivityStreamsFollowingProperty
+}
+
+// likeder has a 'liked' property.
+type likeder interface {
+	GetActivityStreamsLiked() vocab.ActivityStreamsLikedProperty
+}
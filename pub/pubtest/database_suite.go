@@ -0,0 +1,463 @@
+package pubtest
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// lockTimeout bounds how long TestDatabase waits on a lock that should be
+// free.
+const lockTimeout = 5 * time.Second
+
+// TestDatabase checks that a pub.Database behaves the way the rest of the
+// library assumes it does. Run it from a test of the implementation:
+//
+//	func TestMyDatabase(t *testing.T) {
+//		base, _ := url.Parse("https://example.com")
+//		pubtest.TestDatabase(t, base, func() pub.Database {
+//			return newMyDatabase(base)
+//		})
+//	}
+//
+// The factory must return a new, empty database for every call, owning the
+// IRIs at the scheme and host of base.
+//
+// The suite populates databases only through the pub.Database interface. An
+// actor is stored with Create, as are its inbox and outbox as
+// OrderedCollections, and its followers, following, and liked collections as
+// Collections. The database is then expected to know which boxes belong to
+// the actor.
+func TestDatabase(t *testing.T, base *url.URL, factory func() pub.Database) {
+	s := &databaseSuite{base: base, factory: factory}
+	t.Run("LockUnknownId", s.testLockUnknownId)
+	t.Run("LockExcludes", s.testLockExcludes)
+	t.Run("CreateGetExists", s.testCreateGetExists)
+	t.Run("UpdateReplaces", s.testUpdateReplaces)
+	t.Run("DeleteRemoves", s.testDeleteRemoves)
+	t.Run("UpdateToTombstone", s.testUpdateToTombstone)
+	t.Run("Owns", s.testOwns)
+	t.Run("ActorBoxes", s.testActorBoxes)
+	t.Run("InboxPrepend", s.testInboxPrepend)
+	t.Run("OutboxPrepend", s.testOutboxPrepend)
+	t.Run("Collections", s.testCollections)
+	t.Run("NewIdUniqueUnderConcurrency", s.testNewIdUnique)
+}
+
+// databaseSuite contains the configuration of TestDatabase.
+type databaseSuite struct {
+	base    *url.URL
+	factory func() pub.Database
+}
+
+// suiteActor is the IRIs of an actor stored by the suite.
+type suiteActor struct {
+	id, inbox, outbox, followers, following, liked *url.URL
+}
+
+// iri creates an IRI owned by the database.
+func (s *databaseSuite) iri(path string) *url.URL {
+	u := *s.base
+	u.Path = path
+	return &u
+}
+
+// newNote creates a Note with the id and content.
+func newNote(id *url.URL, content string) vocab.ActivityStreamsNote {
+	n := streams.NewActivityStreamsNote()
+	n.SetJSONLDId(idProperty(id))
+	c := streams.NewActivityStreamsContentProperty()
+	c.AppendXMLSchemaString(content)
+	n.SetActivityStreamsContent(c)
+	return n
+}
+
+// createActor stores an actor and its boxes and collections.
+func (s *databaseSuite) createActor(t *testing.T, db pub.Database) suiteActor {
+	t.Helper()
+	c := context.Background()
+	a := suiteActor{
+		id:        s.iri("/users/alice"),
+		inbox:     s.iri("/users/alice/inbox"),
+		outbox:    s.iri("/users/alice/outbox"),
+		followers: s.iri("/users/alice/followers"),
+		following: s.iri("/users/alice/following"),
+		liked:     s.iri("/users/alice/liked"),
+	}
+	for _, box := range []*url.URL{a.inbox, a.outbox} {
+		oc := streams.NewActivityStreamsOrderedCollection()
+		oc.SetJSONLDId(idProperty(box))
+		oc.SetActivityStreamsOrderedItems(streams.NewActivityStreamsOrderedItemsProperty())
+		mustDo(t, db.Create(c, oc))
+	}
+	for _, id := range []*url.URL{a.followers, a.following, a.liked} {
+		col := streams.NewActivityStreamsCollection()
+		col.SetJSONLDId(idProperty(id))
+		col.SetActivityStreamsItems(streams.NewActivityStreamsItemsProperty())
+		mustDo(t, db.Create(c, col))
+	}
+	p := streams.NewActivityStreamsPerson()
+	p.SetJSONLDId(idProperty(a.id))
+	inbox := streams.NewActivityStreamsInboxProperty()
+	inbox.SetIRI(a.inbox)
+	p.SetActivityStreamsInbox(inbox)
+	outbox := streams.NewActivityStreamsOutboxProperty()
+	outbox.SetIRI(a.outbox)
+	p.SetActivityStreamsOutbox(outbox)
+	followers := streams.NewActivityStreamsFollowersProperty()
+	followers.SetIRI(a.followers)
+	p.SetActivityStreamsFollowers(followers)
+	following := streams.NewActivityStreamsFollowingProperty()
+	following.SetIRI(a.following)
+	p.SetActivityStreamsFollowing(following)
+	liked := streams.NewActivityStreamsLikedProperty()
+	liked.SetIRI(a.liked)
+	p.SetActivityStreamsLiked(liked)
+	mustDo(t, db.Create(c, p))
+	return a
+}
+
+// testLockUnknownId checks that ids may be locked before anything is stored
+// at them, as is done before creating new values.
+func (s *databaseSuite) testLockUnknownId(t *testing.T) {
+	db := s.factory()
+	c := context.Background()
+	id := s.iri("/notes/unknown")
+	mustDo(t, db.Lock(c, id))
+	mustDo(t, db.Unlock(c, id))
+	// The lock is usable again.
+	mustDo(t, db.Lock(c, id))
+	mustDo(t, db.Unlock(c, id))
+}
+
+// testLockExcludes checks that a lock is held by one caller at a time.
+func (s *databaseSuite) testLockExcludes(t *testing.T) {
+	db := s.factory()
+	c := context.Background()
+	id := s.iri("/notes/1")
+	mustDo(t, db.Lock(c, id))
+	acquired := make(chan error, 1)
+	go func() {
+		acquired <- db.Lock(c, id)
+	}()
+	select {
+	case <-acquired:
+		t.Fatalf("Lock of %s succeeded while it was held", id)
+	case <-time.After(50 * time.Millisecond):
+	}
+	mustDo(t, db.Unlock(c, id))
+	select {
+	case err := <-acquired:
+		mustDo(t, err)
+	case <-time.After(lockTimeout):
+		t.Fatalf("Lock of %s not acquired after Unlock", id)
+	}
+	mustDo(t, db.Unlock(c, id))
+}
+
+// testCreateGetExists checks that created values can be retrieved intact.
+func (s *databaseSuite) testCreateGetExists(t *testing.T) {
+	db := s.factory()
+	c := context.Background()
+	id := s.iri("/notes/1")
+	exists, err := db.Exists(c, id)
+	mustDo(t, err)
+	if exists {
+		t.Fatalf("Exists(%s) before Create", id)
+	}
+	note := newNote(id, "hello")
+	mustDo(t, db.Create(c, note))
+	exists, err = db.Exists(c, id)
+	mustDo(t, err)
+	if !exists {
+		t.Fatalf("!Exists(%s) after Create", id)
+	}
+	got, err := db.Get(c, id)
+	mustDo(t, err)
+	assertSameJSON(t, got, note)
+}
+
+// testUpdateReplaces checks that updates replace values entirely.
+func (s *databaseSuite) testUpdateReplaces(t *testing.T) {
+	db := s.factory()
+	c := context.Background()
+	id := s.iri("/notes/1")
+	mustDo(t, db.Create(c, newNote(id, "hello")))
+	updated := newNote(id, "goodbye")
+	mustDo(t, db.Update(c, updated))
+	got, err := db.Get(c, id)
+	mustDo(t, err)
+	assertSameJSON(t, got, updated)
+}
+
+// testDeleteRemoves checks that deleted values no longer exist, as is
+// expected when a peer deletes its own content.
+func (s *databaseSuite) testDeleteRemoves(t *testing.T) {
+	db := s.factory()
+	c := context.Background()
+	id := s.iri("/notes/1")
+	mustDo(t, db.Create(c, newNote(id, "hello")))
+	mustDo(t, db.Delete(c, id))
+	exists, err := db.Exists(c, id)
+	mustDo(t, err)
+	if exists {
+		t.Fatalf("Exists(%s) after Delete", id)
+	}
+	if _, err = db.Get(c, id); err == nil {
+		t.Fatalf("Get(%s) succeeded after Delete", id)
+	}
+}
+
+// testUpdateToTombstone checks that values replaced by a Tombstone, as is
+// done when a local actor deletes their content, still exist.
+func (s *databaseSuite) testUpdateToTombstone(t *testing.T) {
+	db := s.factory()
+	c := context.Background()
+	id := s.iri("/notes/1")
+	mustDo(t, db.Create(c, newNote(id, "hello")))
+	tomb := streams.NewActivityStreamsTombstone()
+	tomb.SetJSONLDId(idProperty(id))
+	mustDo(t, db.Update(c, tomb))
+	exists, err := db.Exists(c, id)
+	mustDo(t, err)
+	if !exists {
+		t.Fatalf("!Exists(%s) after replacing with a Tombstone", id)
+	}
+	got, err := db.Get(c, id)
+	mustDo(t, err)
+	if !streams.IsOrExtendsActivityStreamsTombstone(got) {
+		t.Fatalf("Get(%s) = %s, want a Tombstone", id, got.GetTypeName())
+	}
+}
+
+// testOwns checks ownership of local and peer IRIs.
+func (s *databaseSuite) testOwns(t *testing.T) {
+	db := s.factory()
+	c := context.Background()
+	owns, err := db.Owns(c, s.iri("/notes/1"))
+	mustDo(t, err)
+	if !owns {
+		t.Fatalf("!Owns(%s)", s.iri("/notes/1"))
+	}
+	peer, err := url.Parse("https://peer.example.invalid/notes/1")
+	mustDo(t, err)
+	owns, err = db.Owns(c, peer)
+	mustDo(t, err)
+	if owns {
+		t.Fatalf("Owns(%s)", peer)
+	}
+}
+
+// testActorBoxes checks the mapping between actors and their boxes.
+func (s *databaseSuite) testActorBoxes(t *testing.T) {
+	db := s.factory()
+	c := context.Background()
+	a := s.createActor(t, db)
+	got, err := db.ActorForInbox(c, a.inbox)
+	mustDo(t, err)
+	assertSameIRI(t, "ActorForInbox", got, a.id)
+	got, err = db.ActorForOutbox(c, a.outbox)
+	mustDo(t, err)
+	assertSameIRI(t, "ActorForOutbox", got, a.id)
+	got, err = db.OutboxForInbox(c, a.inbox)
+	mustDo(t, err)
+	assertSameIRI(t, "OutboxForInbox", got, a.outbox)
+}
+
+// testInboxPrepend checks that items prepended to an inbox page are saved in
+// order, and are then contained in the inbox.
+func (s *databaseSuite) testInboxPrepend(t *testing.T) {
+	db := s.factory()
+	c := context.Background()
+	a := s.createActor(t, db)
+	first := s.iri("/activities/1")
+	second := s.iri("/activities/2")
+	for _, id := range []*url.URL{first, second} {
+		contains, err := db.InboxContains(c, a.inbox, id)
+		mustDo(t, err)
+		if contains {
+			t.Fatalf("InboxContains(%s) before SetInbox", id)
+		}
+		page, err := db.GetInbox(c, a.inbox)
+		mustDo(t, err)
+		page.SetActivityStreamsOrderedItems(prepended(page.GetActivityStreamsOrderedItems(), id))
+		mustDo(t, db.SetInbox(c, page))
+		contains, err = db.InboxContains(c, a.inbox, id)
+		mustDo(t, err)
+		if !contains {
+			t.Fatalf("!InboxContains(%s) after SetInbox", id)
+		}
+	}
+	page, err := db.GetInbox(c, a.inbox)
+	mustDo(t, err)
+	assertOrderedItems(t, page, second, first)
+}
+
+// testOutboxPrepend checks that items prepended to an outbox page are saved
+// in order.
+func (s *databaseSuite) testOutboxPrepend(t *testing.T) {
+	db := s.factory()
+	c := context.Background()
+	a := s.createActor(t, db)
+	first := s.iri("/activities/1")
+	second := s.iri("/activities/2")
+	for _, id := range []*url.URL{first, second} {
+		page, err := db.GetOutbox(c, a.outbox)
+		mustDo(t, err)
+		page.SetActivityStreamsOrderedItems(prepended(page.GetActivityStreamsOrderedItems(), id))
+		mustDo(t, db.SetOutbox(c, page))
+	}
+	page, err := db.GetOutbox(c, a.outbox)
+	mustDo(t, err)
+	assertOrderedItems(t, page, second, first)
+}
+
+// testCollections checks that an actor's collections can be modified, which
+// requires them to always have an 'items' property.
+func (s *databaseSuite) testCollections(t *testing.T) {
+	db := s.factory()
+	c := context.Background()
+	a := s.createActor(t, db)
+	peer, err := url.Parse("https://peer.example.invalid/users/bob")
+	mustDo(t, err)
+	getters := map[string]func(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error){
+		"Followers": db.Followers,
+		"Following": db.Following,
+		"Liked":     db.Liked,
+	}
+	for name, get := range getters {
+		col, err := get(c, a.id)
+		mustDo(t, err)
+		items := col.GetActivityStreamsItems()
+		if items == nil {
+			t.Fatalf("%s has no 'items' property", name)
+		}
+		items.PrependIRI(peer)
+		mustDo(t, db.Update(c, col))
+		col, err = get(c, a.id)
+		mustDo(t, err)
+		items = col.GetActivityStreamsItems()
+		if items == nil || items.Len() != 1 {
+			t.Fatalf("%s does not contain the updated item", name)
+		}
+		id, err := pub.ToId(items.At(0))
+		mustDo(t, err)
+		assertSameIRI(t, name, id, peer)
+	}
+}
+
+// testNewIdUnique checks that ids minted concurrently are owned and unique.
+func (s *databaseSuite) testNewIdUnique(t *testing.T) {
+	const n = 50
+	db := s.factory()
+	c := context.Background()
+	ids := make(chan *url.URL, n)
+	errs := make(chan error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := db.NewId(c, streams.NewActivityStreamsNote())
+			if err != nil {
+				errs <- err
+				return
+			}
+			ids <- id
+		}()
+	}
+	wg.Wait()
+	close(ids)
+	close(errs)
+	for err := range errs {
+		mustDo(t, err)
+	}
+	seen := make(map[string]bool)
+	for id := range ids {
+		if seen[id.String()] {
+			t.Fatalf("NewId returned %s more than once", id)
+		}
+		seen[id.String()] = true
+		owns, err := db.Owns(c, id)
+		mustDo(t, err)
+		if !owns {
+			t.Fatalf("NewId returned %s, which is not owned", id)
+		}
+	}
+}
+
+// prepended returns the ordered items with the id added at the front, just as
+// the library adds activities to inboxes and outboxes.
+func prepended(oi vocab.ActivityStreamsOrderedItemsProperty, id *url.URL) vocab.ActivityStreamsOrderedItemsProperty {
+	if oi == nil {
+		oi = streams.NewActivityStreamsOrderedItemsProperty()
+	}
+	oi.PrependIRI(id)
+	return oi
+}
+
+// mustDo fails the test if there is an error.
+func mustDo(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// assertSameIRI fails the test if the IRIs differ.
+func assertSameIRI(t *testing.T, what string, got, want *url.URL) {
+	t.Helper()
+	if got == nil || got.String() != want.String() {
+		t.Fatalf("%s = %v, want %s", what, got, want)
+	}
+}
+
+// assertSameJSON fails the test if the values serialize differently.
+func assertSameJSON(t *testing.T, got, want vocab.Type) {
+	t.Helper()
+	g, err := toJSON(got)
+	mustDo(t, err)
+	w, err := toJSON(want)
+	mustDo(t, err)
+	if g != w {
+		t.Fatalf("got %s, want %s", g, w)
+	}
+}
+
+// assertOrderedItems fails the test if the page does not have exactly the
+// ids, in order.
+func assertOrderedItems(t *testing.T, page vocab.ActivityStreamsOrderedCollectionPage, want ...*url.URL) {
+	t.Helper()
+	var got []string
+	if oi := page.GetActivityStreamsOrderedItems(); oi != nil {
+		for iter := oi.Begin(); iter != oi.End(); iter = iter.Next() {
+			id, err := pub.ToId(iter)
+			mustDo(t, err)
+			got = append(got, id.String())
+		}
+	}
+	var wantStrs []string
+	for _, id := range want {
+		wantStrs = append(wantStrs, id.String())
+	}
+	if strings.Join(got, " ") != strings.Join(wantStrs, " ") {
+		t.Fatalf("orderedItems = %v, want %v", got, wantStrs)
+	}
+}
+
+// toJSON serializes a value.
+func toJSON(t vocab.Type) (string, error) {
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(m)
+	return string(b), err
+}
@@ -0,0 +1,18 @@
+package pubtest
+
+import (
+	"github.com/go-fed/activity/pub"
+	"net/url"
+	"testing"
+)
+
+// TestMemoryDatabase runs the conformance suite against MemoryDatabase.
+func TestMemoryDatabase(t *testing.T) {
+	base, err := url.Parse("https://example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	TestDatabase(t, base, func() pub.Database {
+		return NewMemoryDatabase(base)
+	})
+}
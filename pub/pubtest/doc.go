@@ -0,0 +1,29 @@
+// Package pubtest provides a simulated federation of in-process ActivityPub
+// servers for integration tests.
+//
+// Each Instance runs on its own httptest TLS server with its own actors,
+// database, keys, and Clock. Activities are delivered between instances as
+// real HTTP requests signed with HTTP Signatures, and are authenticated by the
+// receiving instance by fetching the sender's public key. This exercises the
+// same code paths as federation between real servers, including the
+// application's callbacks:
+//
+//	n := pubtest.NewNetwork(t)
+//	defer n.Close()
+//	alice := n.NewInstance(pubtest.InstanceConfig{}).NewActor("alice")
+//	bob := n.NewInstance(pubtest.InstanceConfig{}).NewActor("bob")
+//	alice.Follow(ctx, bob)
+//	create, _ := bob.Post(ctx, "hello", bob.Followers)
+//	if !alice.InboxContains(ctx, create.GetJSONLDId().Get()) { ... }
+//
+// Delivery is synchronous: once an actor has sent an activity, all of its
+// side effects on peer instances, and any responses they sent in turn, have
+// been applied.
+//
+// Applications with their own pub.Database can check that it behaves the way
+// the library expects with TestDatabase.
+//
+// Traffic recorded in production with a pub.TrafficRecorder is re-driven into
+// an Actor with Replay, while a ReplayClient answers the Actor's outbound
+// requests the way peers did at the time.
+package pubtest
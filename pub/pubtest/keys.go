@@ -0,0 +1,189 @@
+package pubtest
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/go-fed/httpsig"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// keyBits is the size of generated RSA keys.
+	keyBits = 2048
+	// digestHeader is the HTTP header containing the digest of a body.
+	digestHeader = "Digest"
+)
+
+var (
+	// getHeaders are signed on GET requests.
+	getHeaders = []string{httpsig.RequestTarget, "date"}
+	// postHeaders are signed on POST requests.
+	postHeaders = []string{httpsig.RequestTarget, "date", "digest"}
+)
+
+// keyPair is the credentials of an actor.
+type keyPair struct {
+	id      *url.URL
+	private *rsa.PrivateKey
+	pem     string
+}
+
+// newKeyPair generates credentials for an actor, with a key id that is the
+// actor's id with a fragment.
+func newKeyPair(actorIRI *url.URL) (*keyPair, error) {
+	private, err := rsa.GenerateKey(rand.Reader, keyBits)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKIXPublicKey(&private.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	id := *actorIRI
+	id.Fragment = "main-key"
+	return &keyPair{
+		id:      &id,
+		private: private,
+		pem:     string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	}, nil
+}
+
+// publicKeyProperty creates the 'publicKey' property of the actor owning the
+// key.
+func (k *keyPair) publicKeyProperty(owner *url.URL) vocab.W3IDSecurityV1PublicKeyProperty {
+	key := streams.NewW3IDSecurityV1PublicKey()
+	key.SetJSONLDId(idProperty(k.id))
+	ownerProp := streams.NewW3IDSecurityV1OwnerProperty()
+	ownerProp.Set(owner)
+	key.SetW3IDSecurityV1Owner(ownerProp)
+	pemProp := streams.NewW3IDSecurityV1PublicKeyPemProperty()
+	pemProp.Set(k.pem)
+	key.SetW3IDSecurityV1PublicKeyPem(pemProp)
+	p := streams.NewW3IDSecurityV1PublicKeyProperty()
+	p.AppendW3IDSecurityV1PublicKey(key)
+	return p
+}
+
+// newTransport creates a Transport signing requests with the key.
+func (k *keyPair) newTransport(client pub.HttpClient, clock pub.Clock) (pub.Transport, error) {
+	getSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, httpsig.DigestSha256, getHeaders, httpsig.Signature)
+	if err != nil {
+		return nil, err
+	}
+	postSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, httpsig.DigestSha256, postHeaders, httpsig.Signature)
+	if err != nil {
+		return nil, err
+	}
+	return pub.NewHttpSigTransport(client, "pubtest", clock, getSigner, postSigner, k.id.String(), k.private), nil
+}
+
+// verifyRequest checks the HTTP Signature and Digest of a request, fetching
+// the public key with the transport. The body of the request is left
+// readable.
+func verifyRequest(c context.Context, t pub.Transport, r *http.Request) error {
+	v, err := httpsig.NewVerifier(r)
+	if err != nil {
+		return err
+	}
+	keyId, err := url.Parse(v.KeyId())
+	if err != nil {
+		return err
+	}
+	key, err := fetchPublicKey(c, t, keyId)
+	if err != nil {
+		return err
+	}
+	if err = v.Verify(key, httpsig.RSA_SHA256); err != nil {
+		return err
+	}
+	if r.Method != http.MethodPost {
+		return nil
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	digest := r.Header.Get(digestHeader)
+	for _, expected := range digests(body) {
+		if digest == expected {
+			return nil
+		}
+	}
+	return fmt.Errorf("digest mismatch: %q", digest)
+}
+
+// digests returns the acceptable SHA-256 Digest header values of a body.
+//
+// The version of httpsig used by go-fed does not hash the body, but instead
+// appends the hash of nothing to it. Both that and the correct value are
+// accepted, so the harness works with either version.
+func digests(body []byte) []string {
+	sum := sha256.Sum256(body)
+	legacy := sha256.New().Sum(body)
+	return []string{
+		"SHA-256=" + base64.StdEncoding.EncodeToString(sum[:]),
+		"SHA-256=" + base64.StdEncoding.EncodeToString(legacy),
+	}
+}
+
+// fetchPublicKey dereferences the actor owning the key, and returns the key
+// from its 'publicKey' property.
+func fetchPublicKey(c context.Context, t pub.Transport, keyId *url.URL) (crypto.PublicKey, error) {
+	ownerIRI := *keyId
+	ownerIRI.Fragment = ""
+	b, err := t.Dereference(c, &ownerIRI)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	owner, err := streams.ToType(c, m)
+	if err != nil {
+		return nil, err
+	}
+	k, ok := owner.(publicKeyer)
+	if !ok || k.GetW3IDSecurityV1PublicKey() == nil {
+		return nil, fmt.Errorf("%s has no public keys", &ownerIRI)
+	}
+	keys := k.GetW3IDSecurityV1PublicKey()
+	for iter := keys.Begin(); iter != keys.End(); iter = iter.Next() {
+		if !iter.IsW3IDSecurityV1PublicKey() {
+			continue
+		}
+		key := iter.Get()
+		if id, err := pub.GetId(key); err != nil || id.String() != keyId.String() {
+			continue
+		}
+		if key.GetW3IDSecurityV1PublicKeyPem() == nil {
+			continue
+		}
+		block, _ := pem.Decode([]byte(key.GetW3IDSecurityV1PublicKeyPem().Get()))
+		if block == nil {
+			return nil, fmt.Errorf("key %s is not PEM encoded", keyId)
+		}
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	return nil, fmt.Errorf("%s has no key %s", &ownerIRI, keyId)
+}
+
+// publicKeyer has a 'publicKey' property.
+type publicKeyer interface {
+	GetW3IDSecurityV1PublicKey() vocab.W3IDSecurityV1PublicKeyProperty
+}
@@ -0,0 +1,229 @@
+package pubtest
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Network is a set of Instances federating with each other.
+type Network struct {
+	t      testing.TB
+	client *http.Client
+	start  time.Time
+	// mu guards instances.
+	mu        sync.Mutex
+	instances []*Instance
+}
+
+// NewNetwork creates an empty Network. Problems with the network itself fail
+// the test, while errors handling federated requests are only logged.
+//
+// Close must be called when the test is done.
+func NewNetwork(t testing.TB) *Network {
+	return &Network{
+		t: t,
+		client: &http.Client{
+			Transport: &http.Transport{
+				// Every Instance serves a throwaway certificate.
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		},
+		start: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// Close shuts down all Instances.
+func (n *Network) Close() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, i := range n.instances {
+		i.Server.Close()
+	}
+	n.instances = nil
+}
+
+// Advance moves the Clock of every Instance forward.
+func (n *Network) Advance(d time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, i := range n.instances {
+		i.Clock.Advance(d)
+	}
+}
+
+// InstanceConfig customizes the behavior of an Instance. The zero value
+// automatically accepts follow requests and has no other custom behavior.
+type InstanceConfig struct {
+	// FederatingCallbacks are the callbacks of the Instance's
+	// FederatingProtocol, which are usually the ones under test.
+	FederatingCallbacks func(c context.Context) (pub.FederatingWrappedCallbacks, []interface{}, error)
+	// SocialCallbacks are the callbacks of the Instance's SocialProtocol.
+	SocialCallbacks func(c context.Context) (pub.SocialWrappedCallbacks, []interface{}, error)
+	// ActorOptions are passed when creating the pub.FederatingActor.
+	ActorOptions []pub.ActorOption
+	// WrapClient, if set, wraps the client the Instance uses to send
+	// requests to its peers, such as with a pub.RecordingClient.
+	WrapClient func(client pub.HttpClient) pub.HttpClient
+	// Addr, if set, is the address the Instance listens on, such as the
+	// Host of a closed Instance whose recorded traffic is replayed.
+	// Otherwise, a free port on the loopback interface is used.
+	Addr string
+}
+
+// Instance is a single ActivityPub server in a Network.
+type Instance struct {
+	// Host is the host and port of the server, which differs between
+	// Instances of the same Network.
+	Host string
+	// Server is the running server.
+	Server *httptest.Server
+	// Clock is the time seen by the server.
+	Clock *FakeClock
+	// DB contains the state of the server.
+	DB *MemoryDatabase
+	// Actor handles the ActivityPub requests to every actor on the server.
+	Actor pub.FederatingActor
+
+	network     *Network
+	config      InstanceConfig
+	client      pub.HttpClient
+	base        *url.URL
+	handler     pub.HandlerFunc
+	instanceKey *keyPair
+	// mu guards keys.
+	mu   sync.Mutex
+	keys map[string]*keyPair
+}
+
+// NewInstance starts a new Instance in the Network, with an instance actor
+// and no users.
+func (n *Network) NewInstance(cfg InstanceConfig) *Instance {
+	i := &Instance{
+		Clock:   NewFakeClock(n.start),
+		network: n,
+		config:  cfg,
+		client:  n.client,
+		keys:    make(map[string]*keyPair),
+	}
+	if cfg.WrapClient != nil {
+		i.client = cfg.WrapClient(i.client)
+	}
+	i.Server = httptest.NewUnstartedServer(http.HandlerFunc(i.serveHTTP))
+	var err error
+	if cfg.Addr != "" {
+		i.Server.Listener.Close()
+		if i.Server.Listener, err = net.Listen("tcp", cfg.Addr); err != nil {
+			n.t.Fatal(err)
+		}
+	}
+	i.Server.StartTLS()
+	if i.base, err = url.Parse(i.Server.URL); err != nil {
+		n.t.Fatal(err)
+	}
+	i.Host = i.base.Host
+	i.DB = NewMemoryDatabase(i.base)
+	i.handler = pub.NewActivityStreamsHandler(i.DB, i.Clock)
+	i.Actor = pub.NewActor(commonBehavior{i}, socialProtocol{i}, federatingProtocol{i}, i.DB, i.Clock, cfg.ActorOptions...)
+	n.mu.Lock()
+	n.instances = append(n.instances, i)
+	n.mu.Unlock()
+	// The instance actor fetches the keys of peers.
+	actorIRI := i.iri("/actor")
+	if i.instanceKey, err = newKeyPair(actorIRI); err != nil {
+		n.t.Fatal(err)
+	}
+	actor := pub.NewInstanceActor(actorIRI, i.iri("/actor/inbox"), i.iri("/actor/outbox"), i.Host, i.instanceKey.id, i.instanceKey.pem)
+	if err = i.createActor(actor, i.instanceKey); err != nil {
+		n.t.Fatal(err)
+	}
+	return i
+}
+
+// serveHTTP routes requests to the Actor, or serves the stored values.
+func (i *Instance) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	c := r.Context()
+	var handled bool
+	var err error
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/inbox") && r.Method == http.MethodPost:
+		handled, err = i.Actor.PostInbox(c, w, r)
+	case strings.HasSuffix(r.URL.Path, "/inbox"):
+		handled, err = i.Actor.GetInbox(c, w, r)
+	case strings.HasSuffix(r.URL.Path, "/outbox") && r.Method == http.MethodPost:
+		handled, err = i.Actor.PostOutbox(c, w, r)
+	case strings.HasSuffix(r.URL.Path, "/outbox"):
+		handled, err = i.Actor.GetOutbox(c, w, r)
+	default:
+		var exists bool
+		if exists, err = i.DB.Exists(c, i.requestIRI(r)); err == nil && exists {
+			handled, err = i.handler(c, w, r)
+		}
+	}
+	if err != nil {
+		i.network.t.Logf("%s: %s %s: %s", i.Host, r.Method, r.URL.Path, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	} else if !handled {
+		http.NotFound(w, r)
+	}
+}
+
+// iri creates an IRI on this Instance.
+func (i *Instance) iri(path string) *url.URL {
+	u := *i.base
+	u.Path = path
+	return &u
+}
+
+// requestIRI determines the IRI being requested.
+func (i *Instance) requestIRI(r *http.Request) *url.URL {
+	return i.iri(r.URL.Path)
+}
+
+// key obtains the credentials of an actor.
+func (i *Instance) key(actorIRI *url.URL) (*keyPair, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	k, ok := i.keys[actorIRI.String()]
+	return k, ok
+}
+
+// createActor stores an actor along with its empty collections, and
+// remembers its credentials.
+func (i *Instance) createActor(actor boxer, k *keyPair) error {
+	t, ok := actor.(vocab.Type)
+	if !ok {
+		return fmt.Errorf("actor %T is not a vocab.Type", actor)
+	}
+	id, err := pub.GetId(t)
+	if err != nil {
+		return err
+	}
+	c := context.Background()
+	for _, box := range []*url.URL{actor.GetActivityStreamsInbox().GetIRI(), actor.GetActivityStreamsOutbox().GetIRI()} {
+		oc := streams.NewActivityStreamsOrderedCollection()
+		oc.SetJSONLDId(idProperty(box))
+		oc.SetActivityStreamsOrderedItems(streams.NewActivityStreamsOrderedItemsProperty())
+		if err = i.DB.Create(c, oc); err != nil {
+			return err
+		}
+	}
+	if err = i.DB.Create(c, t); err != nil {
+		return err
+	}
+	i.mu.Lock()
+	i.keys[id.String()] = k
+	i.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,66 @@
+package pubtest
+
+import (
+	"context"
+	"testing"
+)
+
+// TestFollowAndPost tests federating between two instances: alice on one
+// follows bob on another, then bob posts to his followers.
+func TestFollowAndPost(t *testing.T) {
+	ctx := context.Background()
+	n := NewNetwork(t)
+	defer n.Close()
+	alice := n.NewInstance(InstanceConfig{}).NewActor("alice")
+	bob := n.NewInstance(InstanceConfig{}).NewActor("bob")
+	if alice.Instance.Host == bob.Instance.Host {
+		t.Fatalf("instances share host %s", alice.Instance.Host)
+	}
+	// Follow
+	follow, err := alice.Follow(ctx, bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bob.InboxContains(ctx, follow.GetJSONLDId().Get()) {
+		t.Fatalf("bob did not receive the Follow")
+	}
+	if f := bob.FollowerIRIs(ctx); len(f) != 1 || f[0].String() != alice.IRI.String() {
+		t.Fatalf("bob's followers: got %v, want [%s]", f, alice.IRI)
+	}
+	if f := alice.FollowingIRIs(ctx); len(f) != 1 || f[0].String() != bob.IRI.String() {
+		t.Fatalf("alice's following: got %v, want [%s]", f, bob.IRI)
+	}
+	// Post
+	create, err := bob.Post(ctx, "hello", bob.Followers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !alice.InboxContains(ctx, create.GetJSONLDId().Get()) {
+		t.Fatalf("alice did not receive the Create")
+	}
+}
+
+// TestRejectsForgedSignature tests that an instance does not accept
+// activities signed with a key other than the actor's.
+func TestRejectsForgedSignature(t *testing.T) {
+	ctx := context.Background()
+	n := NewNetwork(t)
+	defer n.Close()
+	a := n.NewInstance(InstanceConfig{})
+	alice := a.NewActor("alice")
+	bob := n.NewInstance(InstanceConfig{}).NewActor("bob")
+	// Swap alice's key for a new one that peers do not know about.
+	forged, err := newKeyPair(alice.IRI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.mu.Lock()
+	a.keys[alice.IRI.String()] = forged
+	a.mu.Unlock()
+	if _, err = alice.Follow(ctx, bob); err == nil {
+		t.Fatalf("expected delivery to fail")
+	}
+	if f := bob.FollowerIRIs(ctx); len(f) != 0 {
+		t.Fatalf("bob's followers: got %v, want none", f)
+	}
+}
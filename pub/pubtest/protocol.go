@@ -0,0 +1,157 @@
+package pubtest
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/http"
+	"net/url"
+)
+
+// maxRecursionDepth bounds inbox forwarding and the resolution of
+// collections when delivering.
+const maxRecursionDepth = 4
+
+// commonBehavior is the pub.CommonBehavior of an Instance.
+type commonBehavior struct {
+	i *Instance
+}
+
+// commonBehavior must satisfy the pub.CommonBehavior interface.
+var _ pub.CommonBehavior = commonBehavior{}
+
+// AuthenticateGetInbox permits everyone.
+func (b commonBehavior) AuthenticateGetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	return c, true, nil
+}
+
+// AuthenticateGetOutbox permits everyone.
+func (b commonBehavior) AuthenticateGetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	return c, true, nil
+}
+
+// GetOutbox returns the whole outbox.
+func (b commonBehavior) GetOutbox(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	return b.i.DB.GetOutbox(c, b.i.requestIRI(r))
+}
+
+// NewTransport creates a Transport signing with the key of the actor owning
+// the box.
+func (b commonBehavior) NewTransport(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (pub.Transport, error) {
+	actorIRI, err := b.i.DB.ActorForOutbox(c, actorBoxIRI)
+	if err != nil {
+		actorIRI, err = b.i.DB.ActorForInbox(c, actorBoxIRI)
+	}
+	if err != nil {
+		return nil, err
+	}
+	k, ok := b.i.key(actorIRI)
+	if !ok {
+		return nil, fmt.Errorf("no key for actor %s", actorIRI)
+	}
+	return k.newTransport(b.i.client, b.i.Clock)
+}
+
+// NewInstanceTransport creates a Transport signing with the key of the
+// instance actor.
+func (b commonBehavior) NewInstanceTransport(c context.Context, gofedAgent string) (pub.Transport, error) {
+	return b.i.instanceKey.newTransport(b.i.client, b.i.Clock)
+}
+
+// federatingProtocol is the pub.FederatingProtocol of an Instance.
+type federatingProtocol struct {
+	i *Instance
+}
+
+// federatingProtocol must satisfy the pub.FederatingProtocol interface.
+var _ pub.FederatingProtocol = federatingProtocol{}
+
+// PostInboxRequestBodyHook does nothing.
+func (f federatingProtocol) PostInboxRequestBodyHook(c context.Context, r *http.Request, activity pub.Activity) (context.Context, error) {
+	return c, nil
+}
+
+// AuthenticatePostInbox verifies the HTTP Signature of the peer.
+func (f federatingProtocol) AuthenticatePostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	t, err := commonBehavior{f.i}.NewInstanceTransport(c, "")
+	if err != nil {
+		return c, false, err
+	}
+	if err = verifyRequest(c, t, r); err != nil {
+		f.i.network.t.Logf("%s: rejected unauthenticated delivery to %s: %s", f.i.Host, r.URL.Path, err)
+		w.WriteHeader(http.StatusUnauthorized)
+		return c, false, nil
+	}
+	return c, true, nil
+}
+
+// Blocked blocks nobody.
+func (f federatingProtocol) Blocked(c context.Context, actorIRIs []*url.URL) (bool, error) {
+	return false, nil
+}
+
+// Callbacks returns the callbacks of the InstanceConfig.
+func (f federatingProtocol) Callbacks(c context.Context) (pub.FederatingWrappedCallbacks, []interface{}, error) {
+	if f.i.config.FederatingCallbacks != nil {
+		return f.i.config.FederatingCallbacks(c)
+	}
+	return pub.FederatingWrappedCallbacks{OnFollow: pub.OnFollowAutomaticallyAccept}, nil, nil
+}
+
+// DefaultCallback ignores activities.
+func (f federatingProtocol) DefaultCallback(c context.Context, activity pub.Activity) error {
+	return nil
+}
+
+// MaxInboxForwardingRecursionDepth returns maxRecursionDepth.
+func (f federatingProtocol) MaxInboxForwardingRecursionDepth(c context.Context) int {
+	return maxRecursionDepth
+}
+
+// MaxDeliveryRecursionDepth returns maxRecursionDepth.
+func (f federatingProtocol) MaxDeliveryRecursionDepth(c context.Context) int {
+	return maxRecursionDepth
+}
+
+// FilterForwarding forwards to everyone.
+func (f federatingProtocol) FilterForwarding(c context.Context, potentialRecipients []*url.URL, a pub.Activity) ([]*url.URL, error) {
+	return potentialRecipients, nil
+}
+
+// GetInbox returns the whole inbox.
+func (f federatingProtocol) GetInbox(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	return f.i.DB.GetInbox(c, f.i.requestIRI(r))
+}
+
+// socialProtocol is the pub.SocialProtocol of an Instance.
+type socialProtocol struct {
+	i *Instance
+}
+
+// socialProtocol must satisfy the pub.SocialProtocol interface.
+var _ pub.SocialProtocol = socialProtocol{}
+
+// PostOutboxRequestBodyHook does nothing.
+func (s socialProtocol) PostOutboxRequestBodyHook(c context.Context, r *http.Request, data vocab.Type) (context.Context, error) {
+	return c, nil
+}
+
+// AuthenticatePostOutbox permits everyone. Tests act on behalf of actors with
+// their Send method instead.
+func (s socialProtocol) AuthenticatePostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	return c, true, nil
+}
+
+// Callbacks returns the callbacks of the InstanceConfig.
+func (s socialProtocol) Callbacks(c context.Context) (pub.SocialWrappedCallbacks, []interface{}, error) {
+	if s.i.config.SocialCallbacks != nil {
+		return s.i.config.SocialCallbacks(c)
+	}
+	return pub.SocialWrappedCallbacks{}, nil, nil
+}
+
+// DefaultCallback ignores activities.
+func (s socialProtocol) DefaultCallback(c context.Context, activity pub.Activity) error {
+	return nil
+}
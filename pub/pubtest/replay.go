@@ -0,0 +1,146 @@
+package pubtest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// ReplayResult is the outcome of replaying a single recorded request.
+type ReplayResult struct {
+	// Record is the request that was replayed.
+	Record pub.TrafficRecord
+	// Handled is whether the Actor handled the request.
+	Handled bool
+	// StatusCode is the status code the Actor responded with.
+	StatusCode int
+	// Err is the error returned by the Actor.
+	Err error
+}
+
+// Changed determines whether the Actor responded differently than when the
+// request was recorded.
+func (r ReplayResult) Changed() bool {
+	var errString string
+	if r.Err != nil {
+		errString = r.Err.Error()
+	}
+	return r.StatusCode != r.Record.StatusCode || errString != r.Record.Error
+}
+
+// Replay re-drives the inbound requests of a traffic log into an Actor, in
+// the order they were recorded. Outbound records are skipped. Before each
+// request, the clock is set to the time it was originally received. Bodies
+// that were truncated when recorded are replayed truncated.
+//
+// The Actor should be built with the same clock, and with a CommonBehavior
+// whose Transports send requests with a ReplayClient of the same log, so that
+// peers appear to respond as they did when the traffic was recorded:
+//
+//	records, err := pub.ReadTrafficLog(f)
+//	clock := pubtest.NewFakeClock(time.Time{})
+//	client := pubtest.NewReplayClient(records)
+//	actor := pub.NewFederatingActor(myCommon(client), myProtocol, myDB, clock)
+//	for _, res := range pubtest.Replay(ctx, actor, clock, records) {
+//		if res.Changed() { ... }
+//	}
+func Replay(c context.Context, a pub.Actor, clock *FakeClock, records []pub.TrafficRecord) []ReplayResult {
+	var results []ReplayResult
+	for _, rec := range records {
+		if rec.Direction != pub.TrafficInbound {
+			continue
+		}
+		clock.Set(rec.Time)
+		r := httptest.NewRequest(rec.Method, rec.URL, strings.NewReader(rec.Body)).WithContext(c)
+		for k, v := range rec.Header {
+			r.Header[k] = append([]string(nil), v...)
+		}
+		w := httptest.NewRecorder()
+		res := ReplayResult{Record: rec}
+		res.Handled, res.Err = a.PostInbox(c, w, r)
+		if res.Handled {
+			res.StatusCode = w.Code
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+// ReplayClient must satisfy the pub.HttpClient interface.
+var _ pub.HttpClient = &ReplayClient{}
+
+// ReplayClient is a pub.HttpClient answering requests with the responses in
+// the outbound records of a traffic log, without contacting any peer.
+//
+// Requests are matched by method and URL. Repeated requests are answered
+// with the recorded responses in order, and with the last one once they run
+// out. Requests that were never recorded fail with an error. Response bodies
+// that were truncated when recorded are answered truncated.
+type ReplayClient struct {
+	// mu guards responses.
+	mu        sync.Mutex
+	responses map[string][]pub.TrafficRecord
+}
+
+// NewReplayClient creates a ReplayClient for the records of a traffic log.
+func NewReplayClient(records []pub.TrafficRecord) *ReplayClient {
+	r := &ReplayClient{
+		responses: make(map[string][]pub.TrafficRecord),
+	}
+	for _, rec := range records {
+		if rec.Direction != pub.TrafficOutbound {
+			continue
+		}
+		k := replayKey(rec.Method, rec.URL)
+		r.responses[k] = append(r.responses[k], rec)
+	}
+	return r
+}
+
+// Do answers the request with a recorded response.
+func (r *ReplayClient) Do(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	k := replayKey(req.Method, req.URL.String())
+	r.mu.Lock()
+	recs := r.responses[k]
+	if len(recs) == 0 {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("pubtest: no recorded response for %s", k)
+	}
+	rec := recs[0]
+	if len(recs) > 1 {
+		r.responses[k] = recs[1:]
+	}
+	r.mu.Unlock()
+	if rec.Error != "" && rec.StatusCode == 0 {
+		return nil, fmt.Errorf("pubtest: recorded error for %s: %s", k, rec.Error)
+	}
+	header := make(http.Header, len(rec.ResponseHeader))
+	for k, v := range rec.ResponseHeader {
+		header[k] = append([]string(nil), v...)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rec.StatusCode, http.StatusText(rec.StatusCode)),
+		StatusCode:    rec.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader([]byte(rec.ResponseBody))),
+		ContentLength: int64(len(rec.ResponseBody)),
+		Request:       req,
+	}, nil
+}
+
+// replayKey identifies a request.
+func replayKey(method, url string) string {
+	return method + " " + url
+}
@@ -0,0 +1,70 @@
+package pubtest
+
+import (
+	"bytes"
+	"context"
+	"github.com/go-fed/activity/pub"
+	"net/http"
+	"testing"
+)
+
+// TestRecordAndReplay tests replaying the traffic recorded by an instance
+// into a fresh one: alice follows bob while bob's instance records its
+// traffic, then bob's instance is replaced and the Follow is replayed.
+func TestRecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+	// Record
+	n := NewNetwork(t)
+	defer n.Close()
+	var log bytes.Buffer
+	recorder := pub.NewTrafficRecorder(&log, NewFakeClock(n.start))
+	alice := n.NewInstance(InstanceConfig{}).NewActor("alice")
+	bobInstance := n.NewInstance(InstanceConfig{
+		ActorOptions: []pub.ActorOption{pub.WithTrafficRecorder(recorder)},
+		WrapClient: func(client pub.HttpClient) pub.HttpClient {
+			return pub.NewRecordingClient(client, recorder)
+		},
+	})
+	bob := bobInstance.NewActor("bob")
+	if _, err := alice.Follow(ctx, bob); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Err(); err != nil {
+		t.Fatal(err)
+	}
+	records, err := pub.ReadTrafficLog(&log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var inbound, outbound int
+	for _, rec := range records {
+		if rec.Direction == pub.TrafficInbound {
+			inbound++
+		} else {
+			outbound++
+		}
+	}
+	if inbound != 1 || outbound == 0 {
+		t.Fatalf("recorded %d inbound and %d outbound requests, want 1 and some", inbound, outbound)
+	}
+	// Replay
+	addr := bobInstance.Host
+	bobInstance.Server.Close()
+	replayed := n.NewInstance(InstanceConfig{
+		Addr: addr,
+		WrapClient: func(pub.HttpClient) pub.HttpClient {
+			return NewReplayClient(records)
+		},
+	})
+	replayedBob := replayed.NewActor("bob")
+	results := Replay(ctx, replayed.Actor, replayed.Clock, records)
+	if len(results) != 1 {
+		t.Fatalf("replayed %d requests, want 1", len(results))
+	}
+	if res := results[0]; res.Changed() || res.StatusCode != http.StatusOK {
+		t.Fatalf("replay responded %d with error %v, recorded %d with error %q", res.StatusCode, res.Err, res.Record.StatusCode, res.Record.Error)
+	}
+	if f := replayedBob.FollowerIRIs(ctx); len(f) != 1 || f[0].String() != alice.IRI.String() {
+		t.Fatalf("replayed bob's followers: got %v, want [%s]", f, alice.IRI)
+	}
+}
@@ -0,0 +1,278 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimits configures a RateLimitedClient. Zero values disable the
+// corresponding limit.
+type RateLimits struct {
+	// MaxConcurrent is the maximum number of requests in flight to all
+	// hosts combined.
+	MaxConcurrent int
+	// MaxConcurrentPerHost is the maximum number of requests in flight to
+	// any single host.
+	MaxConcurrentPerHost int
+	// RequestsPerSecondPerHost is the rate at which requests to any single
+	// host are permitted on average.
+	RequestsPerSecondPerHost float64
+	// BurstPerHost is the number of requests to a single host permitted
+	// above the average rate after a period of inactivity. Values less
+	// than one are treated as one.
+	BurstPerHost int
+	// MaxRetryAfter caps how long requests to a host are delayed when the
+	// host responds with a 429 Too Many Requests status and a Retry-After
+	// header. If zero, the Retry-After header is honored as given.
+	MaxRetryAfter time.Duration
+}
+
+// burstPerHost returns the size of the hosts' token buckets.
+func (l RateLimits) burstPerHost() float64 {
+	if l.BurstPerHost < 1 {
+		return 1
+	}
+	return float64(l.BurstPerHost)
+}
+
+// RateLimitedClient must satisfy the HttpClient interface.
+var _ HttpClient = &RateLimitedClient{}
+
+// RateLimitedClient is an HttpClient that bounds the outbound traffic to peer
+// servers, so that a large fan-out neither overwhelms a single host nor
+// exhausts this server's resources.
+//
+// Requests wait for their turn, in order of: the host's rate limit, including
+// any time the host asked to be left alone with a 429 Too Many Requests
+// response, then a free slot for the host, then a free slot overall. Waiting
+// ends early with an error if the request's context is done.
+//
+// A 429 response is still returned to the caller, which usually treats it as
+// a failure. Only subsequent requests to the same host are delayed.
+//
+// To apply to all traffic initiated by the library, a single RateLimitedClient
+// must be shared by every Transport returned by CommonBehavior's NewTransport
+// and NewInstanceTransport.
+type RateLimitedClient struct {
+	client HttpClient
+	clock  Clock
+	limits RateLimits
+	global chan struct{}
+	mu     sync.Mutex
+	hosts  map[string]*hostLimiter
+}
+
+// NewRateLimitedClient wraps an HttpClient, such as the standard library's
+// http.Client, with the given limits.
+func NewRateLimitedClient(client HttpClient, clock Clock, limits RateLimits) *RateLimitedClient {
+	r := &RateLimitedClient{
+		client: client,
+		clock:  clock,
+		limits: limits,
+		hosts:  make(map[string]*hostLimiter),
+	}
+	if limits.MaxConcurrent > 0 {
+		r.global = make(chan struct{}, limits.MaxConcurrent)
+	}
+	return r
+}
+
+// Do sends the request once it is permitted by the limits.
+func (r *RateLimitedClient) Do(req *http.Request) (*http.Response, error) {
+	c := req.Context()
+	h := r.host(req.URL.Host)
+	defer r.done(h)
+	if err := sleep(c, h.reserve(r.clock.Now(), r.limits)); err != nil {
+		return nil, err
+	}
+	if err := acquire(c, h.sem); err != nil {
+		return nil, err
+	}
+	defer release(h.sem)
+	if err := acquire(c, r.global); err != nil {
+		return nil, err
+	}
+	defer release(r.global)
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		now := r.clock.Now()
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), now); ok {
+			if r.limits.MaxRetryAfter > 0 && d > r.limits.MaxRetryAfter {
+				d = r.limits.MaxRetryAfter
+			}
+			h.backOff(now.Add(d))
+		}
+	}
+	return resp, err
+}
+
+// host obtains the limiter for a host, creating it if needed. The limiter is
+// in use by the request until done is called.
+func (r *RateLimitedClient) host(host string) *hostLimiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	h, ok := r.hosts[host]
+	if !ok {
+		if len(r.hosts) >= maxTrackedRateLimitKeys {
+			r.forgetIdle(r.clock.Now())
+		}
+		h = &hostLimiter{}
+		if r.limits.MaxConcurrentPerHost > 0 {
+			h.sem = make(chan struct{}, r.limits.MaxConcurrentPerHost)
+		}
+		r.hosts[host] = h
+	}
+	h.users++
+	return h
+}
+
+// done marks the limiter obtained with host as no longer in use by the
+// request.
+func (r *RateLimitedClient) done(h *hostLimiter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	h.users--
+}
+
+// forgetIdle removes the limiters of hosts that no request is using and whose
+// limits have lapsed, as they are indistinguishable from new ones.
+func (r *RateLimitedClient) forgetIdle(now time.Time) {
+	for host, h := range r.hosts {
+		if h.users == 0 && h.idle(now, r.limits) {
+			delete(r.hosts, host)
+		}
+	}
+}
+
+// hostLimiter contains the limits of a single host.
+type hostLimiter struct {
+	// sem bounds concurrency. It is nil if unbounded.
+	sem chan struct{}
+	// users is the number of requests using the limiter. It is guarded by
+	// the RateLimitedClient's mutex.
+	users int
+	mu    sync.Mutex
+	// tokens and last form a token bucket.
+	tokens float64
+	last   time.Time
+	// blockedUntil is set by Retry-After headers.
+	blockedUntil time.Time
+}
+
+// reserve takes a token from the host's bucket, and returns how long the
+// caller must wait before using it.
+//
+// The bucket is allowed to go into debt, so concurrent callers are queued
+// fairly behind each other.
+func (h *hostLimiter) reserve(now time.Time, l RateLimits) time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var wait time.Duration
+	if l.RequestsPerSecondPerHost > 0 {
+		burst := l.burstPerHost()
+		if h.last.IsZero() {
+			h.tokens = burst
+		} else if elapsed := now.Sub(h.last); elapsed > 0 {
+			h.tokens += elapsed.Seconds() * l.RequestsPerSecondPerHost
+		}
+		if h.tokens > burst {
+			h.tokens = burst
+		}
+		h.last = now
+		h.tokens--
+		if h.tokens < 0 {
+			wait = time.Duration(-h.tokens / l.RequestsPerSecondPerHost * float64(time.Second))
+		}
+	}
+	if blocked := h.blockedUntil.Sub(now); blocked > wait {
+		wait = blocked
+	}
+	return wait
+}
+
+// idle determines whether the host's bucket has refilled completely and it is
+// no longer blocked by a Retry-After header.
+func (h *hostLimiter) idle(now time.Time, l RateLimits) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if now.Before(h.blockedUntil) {
+		return false
+	} else if l.RequestsPerSecondPerHost <= 0 || h.last.IsZero() {
+		return true
+	}
+	return h.tokens+now.Sub(h.last).Seconds()*l.RequestsPerSecondPerHost >= l.burstPerHost()
+}
+
+// backOff delays requests to the host until the given time.
+func (h *hostLimiter) backOff(until time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if until.After(h.blockedUntil) {
+		h.blockedUntil = until
+	}
+}
+
+// parseRetryAfter parses a Retry-After header value, which is either a number
+// of seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if d := t.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
+
+// sleep waits for the duration, or until the context is done.
+func sleep(c context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-c.Done():
+		return c.Err()
+	}
+}
+
+// acquire takes a slot in the semaphore, or returns an error if the context
+// is done first. A nil semaphore is unbounded.
+func acquire(c context.Context, sem chan struct{}) error {
+	if sem == nil {
+		return nil
+	}
+	select {
+	case sem <- struct{}{}:
+		return nil
+	case <-c.Done():
+		return c.Err()
+	}
+}
+
+// release frees a slot taken with acquire.
+func release(sem chan struct{}) {
+	if sem == nil {
+		return
+	}
+	<-sem
+}
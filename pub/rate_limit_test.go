@@ -0,0 +1,193 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock whose time is set by the test.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// Now returns the fake time.
+func (f *fakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// countingClient is an HttpClient that records the peak number of concurrent
+// requests, and responds with a fixed response.
+type countingClient struct {
+	mu       sync.Mutex
+	inFlight int
+	peak     int
+	delay    time.Duration
+	respond  func() *http.Response
+}
+
+// Do records the request and responds.
+func (c *countingClient) Do(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	c.inFlight++
+	if c.inFlight > c.peak {
+		c.peak = c.inFlight
+	}
+	c.mu.Unlock()
+	time.Sleep(c.delay)
+	c.mu.Lock()
+	c.inFlight--
+	c.mu.Unlock()
+	return c.respond(), nil
+}
+
+// TestHostLimiterReserve tests the per-host token bucket.
+func TestHostLimiterReserve(t *testing.T) {
+	start := time.Date(2000, 2, 3, 4, 5, 6, 0, time.UTC)
+	limits := RateLimits{
+		RequestsPerSecondPerHost: 2,
+		BurstPerHost:             2,
+	}
+	h := &hostLimiter{}
+	assertEqual(t, h.reserve(start, limits), time.Duration(0))
+	assertEqual(t, h.reserve(start, limits), time.Duration(0))
+	assertEqual(t, h.reserve(start, limits), 500*time.Millisecond)
+	assertEqual(t, h.reserve(start, limits), time.Second)
+	// Refilled over time, but never above the burst.
+	later := start.Add(time.Hour)
+	assertEqual(t, h.reserve(later, limits), time.Duration(0))
+	assertEqual(t, h.reserve(later, limits), time.Duration(0))
+	assertEqual(t, h.reserve(later, limits), 500*time.Millisecond)
+	// Retry-After dominates when longer.
+	h.backOff(later.Add(time.Minute))
+	assertEqual(t, h.reserve(later, limits), time.Minute)
+}
+
+// TestRateLimitedClientForgetIdle tests forgetting the limiters of hosts that
+// are no longer limited.
+func TestRateLimitedClientForgetIdle(t *testing.T) {
+	now := time.Date(2000, 2, 3, 4, 5, 6, 0, time.UTC)
+	r := NewRateLimitedClient(&countingClient{}, &fakeClock{now: now}, RateLimits{
+		RequestsPerSecondPerHost: 1,
+	})
+	refilled := r.host("refilled.example.com")
+	refilled.reserve(now.Add(-time.Minute), r.limits)
+	r.done(refilled)
+	draining := r.host("draining.example.com")
+	draining.reserve(now, r.limits)
+	r.done(draining)
+	blocked := r.host("blocked.example.com")
+	blocked.backOff(now.Add(time.Minute))
+	r.done(blocked)
+	r.host("busy.example.com")
+	r.forgetIdle(now)
+	_, refilledKept := r.hosts["refilled.example.com"]
+	_, drainingKept := r.hosts["draining.example.com"]
+	_, blockedKept := r.hosts["blocked.example.com"]
+	_, busyKept := r.hosts["busy.example.com"]
+	assertEqual(t, refilledKept, false)
+	assertEqual(t, drainingKept, true)
+	assertEqual(t, blockedKept, true)
+	assertEqual(t, busyKept, true)
+}
+
+// TestRateLimitedClientBoundsHosts tests that the number of remembered hosts
+// stays bounded.
+func TestRateLimitedClientBoundsHosts(t *testing.T) {
+	inner := &countingClient{
+		respond: func() *http.Response {
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+		},
+	}
+	r := NewRateLimitedClient(inner, &fakeClock{}, RateLimits{})
+	for i := 0; i <= maxTrackedRateLimitKeys; i++ {
+		_, err := r.Do(httptest.NewRequest("GET", "https://"+strconv.Itoa(i)+".example.com/", nil))
+		assertEqual(t, err, nil)
+	}
+	assertEqual(t, len(r.hosts) <= maxTrackedRateLimitKeys, true)
+}
+
+// TestParseRetryAfter tests parsing both forms of the Retry-After header.
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2000, 2, 3, 4, 5, 6, 0, time.UTC)
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"Empty", "", 0, false},
+		{"Seconds", "120", 2 * time.Minute, true},
+		{"Negative", "-1", 0, false},
+		{"Date", "Thu, 03 Feb 2000 04:06:06 GMT", time.Minute, true},
+		{"Past Date", "Thu, 03 Feb 2000 04:00:00 GMT", 0, true},
+		{"Garbage", "soon", 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d, ok := parseRetryAfter(test.input, now)
+			assertEqual(t, d, test.expected)
+			assertEqual(t, ok, test.ok)
+		})
+	}
+}
+
+// TestRateLimitedClient tests bounding requests with a RateLimitedClient.
+func TestRateLimitedClient(t *testing.T) {
+	t.Run("BoundsConcurrencyPerHost", func(t *testing.T) {
+		// Setup
+		inner := &countingClient{
+			delay: 10 * time.Millisecond,
+			respond: func() *http.Response {
+				return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+			},
+		}
+		r := NewRateLimitedClient(inner, &fakeClock{}, RateLimits{
+			MaxConcurrent:        4,
+			MaxConcurrentPerHost: 2,
+		})
+		// Run
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				r.Do(httptest.NewRequest("GET", testFederatedActorIRI, nil))
+			}()
+		}
+		wg.Wait()
+		// Verify
+		assertEqual(t, inner.peak, 2)
+	})
+	t.Run("HonorsRetryAfter", func(t *testing.T) {
+		// Setup
+		now := time.Date(2000, 2, 3, 4, 5, 6, 0, time.UTC)
+		inner := &countingClient{
+			respond: func() *http.Response {
+				h := http.Header{}
+				h.Set("Retry-After", "3600")
+				return &http.Response{StatusCode: http.StatusTooManyRequests, Header: h}
+			},
+		}
+		r := NewRateLimitedClient(inner, &fakeClock{now: now}, RateLimits{
+			MaxRetryAfter: time.Minute,
+		})
+		resp, err := r.Do(httptest.NewRequest("GET", testFederatedActorIRI, nil))
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.StatusCode, http.StatusTooManyRequests)
+		// Run
+		c, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = r.Do(httptest.NewRequest("GET", testFederatedActorIRI, nil).WithContext(c))
+		// Verify
+		assertEqual(t, err, context.Canceled)
+		assertEqual(t, r.host("other.example.com").reserve(now, r.limits), time.Minute)
+	})
+}
@@ -0,0 +1,279 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// NewRelayFollow creates a Follow activity subscribing the given actor to an
+// ActivityPub relay.
+//
+// Relays in the wild expect the 'object' of the subscription to be the Public
+// collection, and the relay actor to be its sole recipient. The returned
+// Follow has no 'id', so it is meant to be passed to FederatingActor's Send
+// method using the outbox of the subscribing actor, which is usually the
+// instance actor created with NewInstanceActor.
+//
+// Once the relay replies with an Accept, the relay's actor is added to the
+// subscriber's 'following' collection by the default Accept behavior.
+func NewRelayFollow(actorIRI, relayIRI *url.URL) vocab.ActivityStreamsFollow {
+	follow := streams.NewActivityStreamsFollow()
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(actorIRI)
+	follow.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(mustParsePublic())
+	follow.SetActivityStreamsObject(op)
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(relayIRI)
+	follow.SetActivityStreamsTo(to)
+	return follow
+}
+
+// mustParsePublic returns the IRI of the Public collection.
+func mustParsePublic() *url.URL {
+	u, err := url.Parse(PublicActivityPubIRI)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// isAddressedToPublic returns true if the activity has the Public collection
+// in its 'to' or 'cc' properties.
+func isAddressedToPublic(activity Activity) bool {
+	if to := activity.GetActivityStreamsTo(); to != nil {
+		for iter := to.Begin(); iter != to.End(); iter = iter.Next() {
+			if iter.IsIRI() && IsPublic(iter.GetIRI().String()) {
+				return true
+			}
+		}
+	}
+	if cc := activity.GetActivityStreamsCc(); cc != nil {
+		for iter := cc.Begin(); iter != cc.End(); iter = iter.Next() {
+			if iter.IsIRI() && IsPublic(iter.GetIRI().String()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isRelayable returns true if the activity is a type that relays rebroadcast
+// to their subscribers.
+func isRelayable(activity Activity) bool {
+	return streams.IsOrExtendsActivityStreamsCreate(activity) ||
+		streams.IsOrExtendsActivityStreamsUpdate(activity) ||
+		streams.IsOrExtendsActivityStreamsDelete(activity) ||
+		streams.IsOrExtendsActivityStreamsAnnounce(activity)
+}
+
+// isFromSubscribedRelay returns true if any of the actors of the Announce is a
+// relay the application subscribes to.
+func (w FederatingWrappedCallbacks) isFromSubscribedRelay(c context.Context, a vocab.ActivityStreamsAnnounce) (bool, error) {
+	actors := a.GetActivityStreamsActor()
+	if actors == nil {
+		return false, nil
+	}
+	for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
+		id, err := ToId(iter)
+		if err != nil {
+			return false, err
+		}
+		if isRelay, err := w.IsSubscribedRelay(c, id); err != nil {
+			return false, err
+		} else if isRelay {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ingestRelayed verifies every activity announced by a relay and handles it
+// as if it had been delivered to the inbox directly.
+//
+// The announced values are never trusted: each one is dereferenced from its
+// origin by its 'id' as the instance actor, and its actors must share the
+// origin's host. Values that fail to be dereferenced or verified are skipped,
+// as are those from blocked origins or actors.
+func (w FederatingWrappedCallbacks) ingestRelayed(c context.Context, op vocab.ActivityStreamsObjectProperty) error {
+	if op == nil {
+		return nil
+	}
+	tport, err := w.newInstanceTransport(c, goFedUserAgent())
+	if err != nil {
+		return err
+	}
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		id, err := ToId(iter)
+		if err != nil {
+			w.skipRelayed(c, nil, err)
+			continue
+		}
+		// Do not fetch anything from blocked origins.
+		origin := &url.URL{Scheme: id.Scheme, Host: id.Host}
+		if blocked, err := w.blocked(c, []*url.URL{origin}); err != nil {
+			return err
+		} else if blocked {
+			continue
+		}
+		activity, err := dereferenceRelayed(c, tport, id)
+		if err != nil {
+			w.skipRelayed(c, id, err)
+			continue
+		} else if activity == nil {
+			// Relays may also announce plain objects, which are
+			// not activities needing any side effects.
+			continue
+		}
+		actors, err := mustHaveRelayedOriginMatch(id, activity)
+		if err != nil {
+			w.skipRelayed(c, id, err)
+			continue
+		}
+		if blocked, err := w.blocked(c, actors); err != nil {
+			return err
+		} else if blocked {
+			continue
+		}
+		if err := w.ingest(c, w.inboxIRI, activity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// skipRelayed reports an announced value that is not handled.
+func (w FederatingWrappedCallbacks) skipRelayed(c context.Context, iri *url.URL, err error) {
+	if w.RelayedActivitySkipped != nil {
+		w.RelayedActivitySkipped(c, iri, err)
+	}
+}
+
+// dereferenceRelayed obtains an announced value from its origin. It returns a
+// nil Activity if the value is not an activity.
+func dereferenceRelayed(c context.Context, tport Transport, id *url.URL) (Activity, error) {
+	b, err := tport.Dereference(c, id)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	NormalizeJSON(m)
+	t, err := streams.ToType(c, m)
+	if err != nil {
+		return nil, err
+	}
+	activity, _ := t.(Activity)
+	return activity, nil
+}
+
+// mustHaveRelayedOriginMatch ensures a dereferenced relayed activity is the one
+// that was requested, and that its actors live on the same host as it does. It
+// returns the actors.
+func mustHaveRelayedOriginMatch(requested *url.URL, activity Activity) (actors []*url.URL, err error) {
+	id, err := GetId(activity)
+	if err != nil {
+		return nil, err
+	}
+	if id.String() != requested.String() {
+		return nil, fmt.Errorf("relayed activity %q was dereferenced as %q", requested, id)
+	}
+	actorProp := activity.GetActivityStreamsActor()
+	if actorProp == nil || actorProp.Len() == 0 {
+		return nil, fmt.Errorf("relayed activity %q has no actor", id)
+	}
+	for iter := actorProp.Begin(); iter != actorProp.End(); iter = iter.Next() {
+		actorIRI, err := ToId(iter)
+		if err != nil {
+			return nil, err
+		}
+		if actorIRI.Host != id.Host {
+			return nil, fmt.Errorf("relayed activity %q: actor %q not in activity origin", id, actorIRI)
+		}
+		actors = append(actors, actorIRI)
+	}
+	return actors, nil
+}
+
+// rebroadcast announces a public activity received in a relay's inbox to all
+// of the relay's followers.
+//
+// Activities that are not public, not of a relayable type, or were sent by the
+// relay itself are ignored.
+func (a *sideEffectActor) rebroadcast(c context.Context, inboxIRI *url.URL, activity Activity) error {
+	if !isRelayable(activity) || !isAddressedToPublic(activity) {
+		return nil
+	}
+	activityId, err := GetId(activity)
+	if err != nil {
+		return err
+	}
+	err = a.db.Lock(c, inboxIRI)
+	if err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	actorIRI, err := a.db.ActorForInbox(c, inboxIRI)
+	if err != nil {
+		a.db.Unlock(c, inboxIRI)
+		return err
+	}
+	outboxIRI, err := a.db.OutboxForInbox(c, inboxIRI)
+	if err != nil {
+		a.db.Unlock(c, inboxIRI)
+		return err
+	}
+	a.db.Unlock(c, inboxIRI)
+	// Unlock must be called by now and every branch above.
+	if actors := activity.GetActivityStreamsActor(); actors != nil {
+		for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
+			id, err := ToId(iter)
+			if err != nil {
+				return err
+			}
+			if id.String() == actorIRI.String() {
+				return nil
+			}
+		}
+	}
+	err = a.db.Lock(c, actorIRI)
+	if err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	followers, err := a.db.Followers(c, actorIRI)
+	if err != nil {
+		a.db.Unlock(c, actorIRI)
+		return err
+	}
+	a.db.Unlock(c, actorIRI)
+	// Unlock must be called by now and every branch above.
+	followersIRI, err := GetId(followers)
+	if err != nil {
+		return err
+	}
+	announce := streams.NewActivityStreamsAnnounce()
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(actorIRI)
+	announce.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(activityId)
+	announce.SetActivityStreamsObject(op)
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(followersIRI)
+	announce.SetActivityStreamsTo(to)
+	cc := streams.NewActivityStreamsCcProperty()
+	cc.AppendIRI(mustParsePublic())
+	announce.SetActivityStreamsCc(cc)
+	if err := a.AddNewIds(c, announce); err != nil {
+		return err
+	}
+	return a.Deliver(c, outboxIRI, announce)
+}
@@ -0,0 +1,341 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+const (
+	testRelayIRI = "https://relay.example.com/actor"
+)
+
+// TestNewRelayFollow tests building a relay subscription.
+func TestNewRelayFollow(t *testing.T) {
+	f := NewRelayFollow(mustParse(testMyInboxIRI), mustParse(testRelayIRI))
+	assertEqual(t, f.GetActivityStreamsActor().At(0).GetIRI().String(), testMyInboxIRI)
+	assertEqual(t, f.GetActivityStreamsObject().At(0).GetIRI().String(), PublicActivityPubIRI)
+	assertEqual(t, f.GetActivityStreamsTo().At(0).GetIRI().String(), testRelayIRI)
+	assertEqual(t, f.GetJSONLDId(), nil)
+}
+
+// TestFederatedAnnounceFromRelay tests ingesting activities announced by a
+// subscribed relay.
+func TestFederatedAnnounceFromRelay(t *testing.T) {
+	ctx := context.Background()
+	newAnnounce := func() vocab.ActivityStreamsAnnounce {
+		a := streams.NewActivityStreamsAnnounce()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse("https://relay.example.com/announce/1"))
+		a.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testRelayIRI))
+		a.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testFederatedActivityIRI))
+		a.SetActivityStreamsObject(op)
+		return a
+	}
+	setupFn := func(ctl *gomock.Controller, isRelay bool) (db *MockDatabase, tp *MockTransport, ingested *[]Activity, skipped *[]error, w FederatingWrappedCallbacks) {
+		setupData()
+		db = NewMockDatabase(ctl)
+		tp = NewMockTransport(ctl)
+		ingested = &[]Activity{}
+		skipped = &[]error{}
+		w = FederatingWrappedCallbacks{
+			IsSubscribedRelay: func(c context.Context, actorIRI *url.URL) (bool, error) {
+				return isRelay && actorIRI.String() == testRelayIRI, nil
+			},
+			RelayedActivitySkipped: func(c context.Context, iri *url.URL, err error) {
+				*skipped = append(*skipped, err)
+			},
+			db:       db,
+			inboxIRI: mustParse(testMyInboxIRI),
+			newInstanceTransport: func(c context.Context, gofedAgent string) (Transport, error) {
+				return tp, nil
+			},
+			ingest: func(c context.Context, inboxIRI *url.URL, activity Activity) error {
+				*ingested = append(*ingested, activity)
+				return nil
+			},
+			blocked: func(c context.Context, actorIRIs []*url.URL) (bool, error) {
+				return false, nil
+			},
+		}
+		return
+	}
+	t.Run("IngestsVerifiedActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, tp, ingested, _, w := setupFn(ctl, true)
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActivityIRI)).Return(mustSerializeToBytes(testCreate), nil)
+		db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI))
+		db.EXPECT().Owns(ctx, mustParse(testFederatedActivityIRI)).Return(false, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testFederatedActivityIRI))
+		// Run
+		err := w.announce(ctx, newAnnounce())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*ingested), 1)
+		assertEqual(t, (*ingested)[0].GetJSONLDId().Get().String(), testFederatedActivityIRI)
+	})
+	t.Run("IgnoresUnsubscribedRelay", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, ingested, _, w := setupFn(ctl, false)
+		db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI))
+		db.EXPECT().Owns(ctx, mustParse(testFederatedActivityIRI)).Return(false, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testFederatedActivityIRI))
+		// Run
+		err := w.announce(ctx, newAnnounce())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*ingested), 0)
+	})
+	// expectAnnounceSideEffects expects the default Announce behavior for
+	// the announced IRIs, which are not owned.
+	expectAnnounceSideEffects := func(db *MockDatabase, iris ...string) {
+		for _, iri := range iris {
+			db.EXPECT().Lock(ctx, mustParse(iri))
+			db.EXPECT().Owns(ctx, mustParse(iri)).Return(false, nil)
+			db.EXPECT().Unlock(ctx, mustParse(iri))
+		}
+	}
+	t.Run("SkipsMismatchedActorOrigin", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, tp, ingested, skipped, w := setupFn(ctl, true)
+		forged := streams.NewActivityStreamsCreate()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		forged.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testPersonIRI))
+		forged.SetActivityStreamsActor(actor)
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActivityIRI)).Return(mustSerializeToBytes(forged), nil)
+		expectAnnounceSideEffects(db, testFederatedActivityIRI)
+		// Run
+		err := w.announce(ctx, newAnnounce())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*ingested), 0)
+		assertEqual(t, len(*skipped), 1)
+	})
+	t.Run("SkipsFailedItemAndIngestsTheRest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, tp, ingested, skipped, w := setupFn(ctl, true)
+		a := newAnnounce()
+		a.GetActivityStreamsObject().PrependIRI(mustParse(testFederatedActivityIRI2))
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActivityIRI2)).Return(nil, errors.New("gone"))
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActivityIRI)).Return(mustSerializeToBytes(testCreate), nil)
+		expectAnnounceSideEffects(db, testFederatedActivityIRI2, testFederatedActivityIRI)
+		// Run
+		err := w.announce(ctx, a)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*ingested), 1)
+		assertEqual(t, (*ingested)[0].GetJSONLDId().Get().String(), testFederatedActivityIRI)
+		assertEqual(t, len(*skipped), 1)
+	})
+	t.Run("SkipsBlockedOrigin", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, ingested, skipped, w := setupFn(ctl, true)
+		var checked []string
+		w.blocked = func(c context.Context, actorIRIs []*url.URL) (bool, error) {
+			for _, iri := range actorIRIs {
+				checked = append(checked, iri.String())
+			}
+			return true, nil
+		}
+		expectAnnounceSideEffects(db, testFederatedActivityIRI)
+		// Run
+		err := w.announce(ctx, newAnnounce())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*ingested), 0)
+		assertEqual(t, len(*skipped), 0)
+		assertEqual(t, len(checked), 1)
+		assertEqual(t, checked[0], "https://other.example.com")
+	})
+	t.Run("SkipsBlockedActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, tp, ingested, skipped, w := setupFn(ctl, true)
+		w.blocked = func(c context.Context, actorIRIs []*url.URL) (bool, error) {
+			for _, iri := range actorIRIs {
+				if iri.String() == testFederatedActorIRI {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActivityIRI)).Return(mustSerializeToBytes(testCreate), nil)
+		expectAnnounceSideEffects(db, testFederatedActivityIRI)
+		// Run
+		err := w.announce(ctx, newAnnounce())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*ingested), 0)
+		assertEqual(t, len(*skipped), 0)
+	})
+	t.Run("ReturnsBlockedError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, ingested, _, w := setupFn(ctl, true)
+		w.blocked = func(c context.Context, actorIRIs []*url.URL) (bool, error) {
+			return false, errors.New("unavailable")
+		}
+		// Run
+		err := w.announce(ctx, newAnnounce())
+		// Verify
+		assertNotEqual(t, err, nil)
+		assertEqual(t, len(*ingested), 0)
+	})
+}
+
+// TestIsAddressedToPublic tests detecting public activities for relaying.
+func TestIsAddressedToPublic(t *testing.T) {
+	setupData()
+	assertEqual(t, isAddressedToPublic(testCreate), false)
+	a := streams.NewActivityStreamsCreate()
+	cc := streams.NewActivityStreamsCcProperty()
+	cc.AppendIRI(mustParse(PublicActivityPubIRI))
+	a.SetActivityStreamsCc(cc)
+	assertEqual(t, isAddressedToPublic(a), true)
+}
+
+// TestRebroadcast tests a relay announcing the public activities it receives
+// to its followers.
+func TestRebroadcast(t *testing.T) {
+	ctx := context.Background()
+	inboxIRI := mustParse(testMyInboxIRI)
+	followersIRI := mustParse("https://relay.example.com/followers")
+	newPerson := func(id, inbox string) vocab.ActivityStreamsPerson {
+		p := streams.NewActivityStreamsPerson()
+		idProp := streams.NewJSONLDIdProperty()
+		idProp.Set(mustParse(id))
+		p.SetJSONLDId(idProp)
+		inboxProp := streams.NewActivityStreamsInboxProperty()
+		inboxProp.SetIRI(mustParse(inbox))
+		p.SetActivityStreamsInbox(inboxProp)
+		return p
+	}
+	newPublic := func(a Activity) Activity {
+		cc := streams.NewActivityStreamsCcProperty()
+		cc.AppendIRI(mustParsePublic())
+		a.(interface {
+			SetActivityStreamsCc(vocab.ActivityStreamsCcProperty)
+		}).SetActivityStreamsCc(cc)
+		return a
+	}
+	setupFn := func(ctl *gomock.Controller) (c *MockCommonBehavior, fp *MockFederatingProtocol, db *MockDatabase, a *sideEffectActor) {
+		setupData()
+		c = NewMockCommonBehavior(ctl)
+		fp = NewMockFederatingProtocol(ctl)
+		db = NewMockDatabase(ctl)
+		a = &sideEffectActor{
+			common: c,
+			s2s:    fp,
+			db:     db,
+			clock:  NewMockClock(ctl),
+		}
+		return
+	}
+	t.Run("AnnouncesPublicCreateToFollowers", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, fp, db, a := setupFn(ctl)
+		tp := NewMockTransport(ctl)
+		followers := streams.NewActivityStreamsCollection()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(followersIRI)
+		followers.SetJSONLDId(id)
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(mustParse(testFederatedActorIRI3))
+		followers.SetActivityStreamsItems(items)
+		var delivered map[string]interface{}
+		var recipients []*url.URL
+		db.EXPECT().Lock(ctx, gomock.Any()).Return(nil).AnyTimes()
+		db.EXPECT().Unlock(ctx, gomock.Any()).Return(nil).AnyTimes()
+		db.EXPECT().ActorForInbox(ctx, inboxIRI).Return(mustParse(testRelayIRI), nil)
+		db.EXPECT().OutboxForInbox(ctx, inboxIRI).Return(mustParse(testMyOutboxIRI), nil)
+		db.EXPECT().Followers(ctx, mustParse(testRelayIRI)).Return(followers, nil)
+		db.EXPECT().NewId(ctx, gomock.Any()).Return(mustParse(testNewActivityIRI), nil)
+		db.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(mustParse(testRelayIRI), nil)
+		db.EXPECT().Get(ctx, mustParse(testRelayIRI)).Return(newPerson(testRelayIRI, testMyInboxIRI), nil)
+		fp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(0)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil).Times(2)
+		tp.EXPECT().Dereference(ctx, followersIRI).Return(mustSerializeToBytes(followers), nil)
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI3)).Return(
+			mustSerializeToBytes(newPerson(testFederatedActorIRI3, testFederatedActorIRI3+"/inbox")), nil)
+		tp.EXPECT().BatchDeliver(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(c context.Context, b []byte, r []*url.URL) error {
+			recipients = r
+			return json.Unmarshal(b, &delivered)
+		})
+		// Run
+		err := a.rebroadcast(ctx, inboxIRI, newPublic(testCreate))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(recipients), 1)
+		assertEqual(t, recipients[0].String(), testFederatedActorIRI3+"/inbox")
+		assertEqual(t, delivered["type"], "Announce")
+		assertEqual(t, delivered["id"], testNewActivityIRI)
+		assertEqual(t, delivered["actor"], testRelayIRI)
+		assertEqual(t, delivered["object"], testFederatedActivityIRI)
+		assertEqual(t, delivered["to"], followersIRI.String())
+		assertEqual(t, delivered["cc"], PublicActivityPubIRI)
+	})
+	t.Run("IgnoresNonPublicActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, a := setupFn(ctl)
+		// Run
+		err := a.rebroadcast(ctx, inboxIRI, testCreate)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("IgnoresNonRelayableType", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, a := setupFn(ctl)
+		like := streams.NewActivityStreamsLike()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		like.SetJSONLDId(id)
+		// Run
+		err := a.rebroadcast(ctx, inboxIRI, newPublic(like))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("DoesNotEchoOwnActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, db, a := setupFn(ctl)
+		db.EXPECT().Lock(ctx, inboxIRI).Return(nil)
+		db.EXPECT().ActorForInbox(ctx, inboxIRI).Return(mustParse(testFederatedActorIRI), nil)
+		db.EXPECT().OutboxForInbox(ctx, inboxIRI).Return(mustParse(testMyOutboxIRI), nil)
+		db.EXPECT().Unlock(ctx, inboxIRI).Return(nil)
+		// Run
+		err := a.rebroadcast(ctx, inboxIRI, newPublic(testCreate))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+}
@@ -0,0 +1,300 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Router must satisfy the http.Handler interface.
+var _ http.Handler = &Router{}
+
+// RouterConfig maps the paths of IRIs served by an application to the
+// ActivityPub endpoints serving them.
+//
+// Patterns are paths whose segments are either literal, or a parameter name
+// in braces matching any single non-empty segment, such as
+// "/users/{name}/inbox". The values of the parameters are available to the
+// Actor and Database with RouteParams.
+type RouterConfig struct {
+	// Inbox is the pattern of the paths of actors' inboxes.
+	Inbox string
+	// Outbox is the pattern of the paths of actors' outboxes.
+	Outbox string
+	// Objects are the patterns of the paths of the other ActivityStreams
+	// values served from the Database, such as actors, their followers
+	// collections, and notes. If empty, GET and HEAD requests to every other
+	// path are served from the Database, and other requests to them are
+	// passed to the Fallback.
+	Objects []string
+	// Representations are the formats other than ActivityStreams in which
+	// objects are served, such as one created by HTMLRepresentation, chosen
+	// by the Accept header of requests.
+	Representations []Representation
+	// Fallback handles the requests that are not ActivityPub requests and
+	// prefer no Representation, such as a web browser requesting an HTML
+	// page when there is no HTML Representation, and the requests to paths
+	// matching no pattern. If nil, they are responded to with a 404 Not
+	// Found status.
+	Fallback http.Handler
+	// ErrorHandler responds to requests whose handling failed with an
+	// error. If nil, a 503 Service Unavailable status is used when the
+	// request's context is done, and a 500 Internal Server Error status
+	// otherwise, without revealing the error to the peer.
+	ErrorHandler func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// Router is an http.Handler serving ActivityPub requests with an Actor and
+// the ActivityStreams values in a Database, according to the scheme of the
+// application's IRIs.
+//
+// POST and GET requests to inboxes and outboxes are passed to the Actor,
+// while GET and HEAD requests to objects are served from the Database like the
+// HandlerFunc returned by NewNegotiatingHandler. A GET request for an object
+// that does not exist in the representation it prefers is responded to with a
+// 404 Not Found status. Responses to GET and HEAD requests vary by their
+// Accept header.
+//
+// Other methods are responded to with a 405 Method Not Allowed status, except
+// for paths matched only because RouterConfig has no Objects patterns, whose
+// requests are passed to the Fallback.
+type Router struct {
+	actor   Actor
+	db      Database
+	handler HandlerFunc
+	inbox   routePattern
+	outbox  routePattern
+	objects []routePattern
+	config  RouterConfig
+}
+
+// NewRouter creates a Router. It returns an error if a pattern is invalid.
+func NewRouter(a Actor, db Database, clock Clock, cfg RouterConfig) (*Router, error) {
+	r := &Router{
+		actor:   a,
+		db:      db,
+		handler: NewNegotiatingHandler(db, clock, cfg.Representations...),
+		config:  cfg,
+	}
+	var err error
+	if r.inbox, err = parseRoutePattern(cfg.Inbox); err != nil {
+		return nil, err
+	}
+	if r.outbox, err = parseRoutePattern(cfg.Outbox); err != nil {
+		return nil, err
+	}
+	for _, o := range cfg.Objects {
+		p, err := parseRoutePattern(o)
+		if err != nil {
+			return nil, err
+		}
+		r.objects = append(r.objects, p)
+	}
+	return r, nil
+}
+
+// ServeHTTP dispatches the request to the matching endpoint.
+func (rt *Router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var handled bool
+	var err error
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		addVaryAccept(w.Header())
+	}
+	if params, ok := rt.inbox.match(r.URL.Path); ok {
+		r = withRouteParams(r, params)
+		switch r.Method {
+		case http.MethodPost:
+			handled, err = rt.actor.PostInbox(r.Context(), w, r)
+		case http.MethodGet:
+			handled, err = rt.actor.GetInbox(r.Context(), w, r)
+		default:
+			rt.methodNotAllowed(w, http.MethodGet, http.MethodPost)
+			return
+		}
+	} else if params, ok := rt.outbox.match(r.URL.Path); ok {
+		r = withRouteParams(r, params)
+		switch r.Method {
+		case http.MethodPost:
+			handled, err = rt.actor.PostOutbox(r.Context(), w, r)
+		case http.MethodGet:
+			handled, err = rt.actor.GetOutbox(r.Context(), w, r)
+		default:
+			rt.methodNotAllowed(w, http.MethodGet, http.MethodPost)
+			return
+		}
+	} else if params, ok := rt.matchObject(r.URL.Path); ok {
+		r = withRouteParams(r, params)
+		switch r.Method {
+		case http.MethodGet:
+			handled, err = rt.serveObject(r.Context(), w, r)
+		case http.MethodHead:
+			handled, err = rt.serveObject(r.Context(), w, headAsGet(r))
+		default:
+			// Without object patterns, the path may well be one of the
+			// application's own, such as a login form.
+			if len(rt.objects) > 0 {
+				rt.methodNotAllowed(w, http.MethodGet, http.MethodHead)
+				return
+			}
+		}
+	}
+	if err != nil {
+		rt.handleError(w, r, err)
+	} else if !handled {
+		rt.fallback(w, r)
+	}
+}
+
+// matchObject matches the path against the object patterns. Any path matches
+// if there are none.
+func (rt *Router) matchObject(path string) (map[string]string, bool) {
+	if len(rt.objects) == 0 {
+		return nil, true
+	}
+	for _, p := range rt.objects {
+		if params, ok := p.match(path); ok {
+			return params, true
+		}
+	}
+	return nil, false
+}
+
+// serveObject serves a GET request for a value in the Database in the
+// representation it prefers.
+func (rt *Router) serveObject(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	if isAS, rep := negotiateGet(r, rt.config.Representations); !isAS && rep == nil {
+		return false, nil
+	}
+	exists, err := rt.db.Exists(c, requestId(r))
+	if err != nil {
+		return true, err
+	} else if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return true, nil
+	}
+	return rt.handler(c, w, r)
+}
+
+// headAsGet returns the GET request serving a HEAD request, whose response body
+// is discarded by the server.
+func headAsGet(r *http.Request) *http.Request {
+	get := *r
+	get.Method = http.MethodGet
+	return &get
+}
+
+// fallback passes the request to the fallback handler.
+func (rt *Router) fallback(w http.ResponseWriter, r *http.Request) {
+	if rt.config.Fallback != nil {
+		rt.config.Fallback.ServeHTTP(w, r)
+		return
+	}
+	http.NotFound(w, r)
+}
+
+// handleError responds to a failed request.
+func (rt *Router) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	if rt.config.ErrorHandler != nil {
+		rt.config.ErrorHandler(w, r, err)
+		return
+	}
+	code := http.StatusInternalServerError
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		code = http.StatusServiceUnavailable
+	}
+	http.Error(w, http.StatusText(code), code)
+}
+
+// methodNotAllowed responds with a 405 Method Not Allowed status.
+func (rt *Router) methodNotAllowed(w http.ResponseWriter, allowed ...string) {
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	w.WriteHeader(http.StatusMethodNotAllowed)
+}
+
+// routeParamsKey is the context key of the parameters of a route.
+type routeParamsKey struct{}
+
+// RouteParams returns the values of the parameters in the pattern matched by a
+// Router, such as "name" in "/users/{name}/inbox". It returns nil if there are
+// none.
+func RouteParams(c context.Context) map[string]string {
+	params, _ := c.Value(routeParamsKey{}).(map[string]string)
+	return params
+}
+
+// withRouteParams adds the parameters of a route to the request's context.
+func withRouteParams(r *http.Request, params map[string]string) *http.Request {
+	if len(params) == 0 {
+		return r
+	}
+	return r.WithContext(context.WithValue(r.Context(), routeParamsKey{}, params))
+}
+
+// routePattern is a parsed pattern of a RouterConfig. A nil routePattern
+// matches nothing.
+type routePattern []routeSegment
+
+// routeSegment is a segment of a routePattern.
+type routeSegment struct {
+	// literal is the text the segment must equal, if param is empty.
+	literal string
+	// param is the name of the parameter the segment matches.
+	param string
+}
+
+// parseRoutePattern parses a pattern. An empty pattern matches nothing.
+func parseRoutePattern(s string) (routePattern, error) {
+	if len(s) == 0 {
+		return nil, nil
+	} else if !strings.HasPrefix(s, "/") {
+		return nil, fmt.Errorf("route pattern %q must begin with '/'", s)
+	}
+	var p routePattern
+	seen := make(map[string]bool)
+	for _, seg := range strings.Split(s[1:], "/") {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			name := seg[1 : len(seg)-1]
+			if len(name) == 0 || strings.ContainsAny(name, "{}") {
+				return nil, fmt.Errorf("route pattern %q has an invalid parameter %q", s, seg)
+			} else if seen[name] {
+				return nil, fmt.Errorf("route pattern %q repeats the parameter %q", s, name)
+			}
+			seen[name] = true
+			p = append(p, routeSegment{param: name})
+		} else if strings.ContainsAny(seg, "{}") {
+			return nil, fmt.Errorf("route pattern %q has an invalid segment %q", s, seg)
+		} else {
+			p = append(p, routeSegment{literal: seg})
+		}
+	}
+	return p, nil
+}
+
+// match determines whether the path matches the pattern, returning the values
+// of its parameters.
+func (p routePattern) match(path string) (map[string]string, bool) {
+	if p == nil || !strings.HasPrefix(path, "/") {
+		return nil, false
+	}
+	segs := strings.Split(path[1:], "/")
+	if len(segs) != len(p) {
+		return nil, false
+	}
+	var params map[string]string
+	for i, seg := range p {
+		if len(seg.param) == 0 {
+			if segs[i] != seg.literal {
+				return nil, false
+			}
+			continue
+		} else if len(segs[i]) == 0 {
+			return nil, false
+		}
+		if params == nil {
+			params = make(map[string]string, len(p))
+		}
+		params[seg.param] = segs[i]
+	}
+	return params, true
+}
@@ -0,0 +1,348 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// routerTestActor is an Actor that records which of its methods was called.
+type routerTestActor struct {
+	handled bool
+	err     error
+	called  string
+	params  map[string]string
+}
+
+// record remembers the call and returns the configured results.
+func (a *routerTestActor) record(method string, c context.Context, w http.ResponseWriter) (bool, error) {
+	a.called = method
+	a.params = RouteParams(c)
+	if a.handled && a.err == nil {
+		w.WriteHeader(http.StatusOK)
+	}
+	return a.handled, a.err
+}
+
+// PostInbox records the call.
+func (a *routerTestActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	return a.record("PostInbox", c, w)
+}
+
+// GetInbox records the call.
+func (a *routerTestActor) GetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	return a.record("GetInbox", c, w)
+}
+
+// PostOutbox records the call.
+func (a *routerTestActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	return a.record("PostOutbox", c, w)
+}
+
+// GetOutbox records the call.
+func (a *routerTestActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	return a.record("GetOutbox", c, w)
+}
+
+// TestParseRoutePattern tests validating the patterns of a RouterConfig.
+func TestParseRoutePattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		valid   bool
+	}{
+		{"Empty", "", true},
+		{"Literal", "/inbox", true},
+		{"Parameter", "/users/{name}/inbox", true},
+		{"Multiple Parameters", "/users/{name}/notes/{id}", true},
+		{"Relative", "users/{name}", false},
+		{"Empty Parameter", "/users/{}", false},
+		{"Repeated Parameter", "/users/{name}/{name}", false},
+		{"Partial Parameter", "/users/@{name}", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseRoutePattern(test.pattern)
+			assertEqual(t, err == nil, test.valid)
+		})
+	}
+}
+
+// TestRoutePatternMatch tests matching paths against patterns.
+func TestRoutePatternMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		path     string
+		expected map[string]string
+		matches  bool
+	}{
+		{"Literal", "/inbox", "/inbox", nil, true},
+		{"Literal Mismatch", "/inbox", "/outbox", nil, false},
+		{"Parameter", "/users/{name}/inbox", "/users/alice/inbox", map[string]string{"name": "alice"}, true},
+		{"Empty Segment", "/users/{name}/inbox", "/users//inbox", nil, false},
+		{"Too Long", "/users/{name}", "/users/alice/inbox", nil, false},
+		{"Too Short", "/users/{name}/inbox", "/users/alice", nil, false},
+		{"Trailing Slash", "/users/{name}", "/users/alice/", nil, false},
+		{"Empty Pattern", "", "/", nil, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := parseRoutePattern(test.pattern)
+			assertEqual(t, err, nil)
+			params, ok := p.match(test.path)
+			assertEqual(t, ok, test.matches)
+			assertEqual(t, reflect.DeepEqual(params, test.expected), true)
+		})
+	}
+}
+
+// TestRouter tests dispatching requests to the Actor, the Database, and the
+// fallback handler.
+func TestRouter(t *testing.T) {
+	// Set up test case
+	setupData()
+	cfg := RouterConfig{
+		Inbox:   "/users/{name}/inbox",
+		Outbox:  "/users/{name}/outbox",
+		Objects: []string{"/users/{name}", "/note/{id}"},
+		Fallback: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		}),
+	}
+	setupFn := func(ctl *gomock.Controller) (a *routerTestActor, db *MockDatabase, clock *MockClock, rt *Router) {
+		a = &routerTestActor{handled: true}
+		db = NewMockDatabase(ctl)
+		clock = NewMockClock(ctl)
+		var err error
+		rt, err = NewRouter(a, db, clock, cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	// Run tests
+	t.Run("DispatchesToActor", func(t *testing.T) {
+		tests := []struct {
+			method   string
+			path     string
+			expected string
+		}{
+			{"POST", "/users/alice/inbox", "PostInbox"},
+			{"GET", "/users/alice/inbox", "GetInbox"},
+			{"POST", "/users/alice/outbox", "PostOutbox"},
+			{"GET", "/users/alice/outbox", "GetOutbox"},
+		}
+		for _, test := range tests {
+			// Setup
+			ctl := gomock.NewController(t)
+			a, _, _, rt := setupFn(ctl)
+			resp := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, "https://example.com"+test.path, nil)
+			// Run the test
+			rt.ServeHTTP(resp, req)
+			// Verify results
+			assertEqual(t, resp.Code, http.StatusOK)
+			assertEqual(t, a.called, test.expected)
+			assertEqual(t, a.params["name"], "alice")
+			ctl.Finish()
+		}
+	})
+	t.Run("RejectsOtherMethods", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a, _, _, rt := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("DELETE", "https://example.com/users/alice/inbox", nil)
+		// Run the test
+		rt.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusMethodNotAllowed)
+		assertEqual(t, resp.Header().Get("Allow"), "GET, POST")
+		assertEqual(t, a.called, "")
+	})
+	t.Run("RejectsOtherMethodsForObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, rt := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", testNoteId1, nil)
+		// Run the test
+		rt.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusMethodNotAllowed)
+		assertEqual(t, resp.Header().Get("Allow"), "GET, HEAD")
+	})
+	t.Run("FallsBackForOtherMethodsWithoutObjects", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		anyCfg := cfg
+		anyCfg.Objects = nil
+		rt, err := NewRouter(&routerTestActor{}, NewMockDatabase(ctl), NewMockClock(ctl), anyCfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "https://example.com/login", nil)
+		// Run the test
+		rt.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusTeapot)
+	})
+	t.Run("FallsBackWhenNotHandled", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a, _, _, rt := setupFn(ctl)
+		a.handled = false
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "https://example.com/users/alice/inbox", nil)
+		// Run the test
+		rt.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusTeapot)
+	})
+	t.Run("FallsBackForUnmatchedPath", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, rt := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", "https://example.com/about", nil))
+		// Run the test
+		rt.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusTeapot)
+	})
+	t.Run("FallsBackForNonActivityPubObjectRequest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, rt := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		// Run the test
+		rt.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusTeapot)
+		assertEqual(t, resp.Header().Get(varyHeader), acceptHeader)
+	})
+	t.Run("ServesObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, db, clock, rt := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testNoteId1, nil))
+		ctx := gomock.Any()
+		db.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(true, nil)
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1)).Return(nil)
+		db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(testMyNote, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1)).Return(nil)
+		clock.EXPECT().Now().Return(now())
+		// Run the test
+		rt.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, resp.Header().Get(contentTypeHeader), contentTypeHeaderValue)
+	})
+	t.Run("ServesObjectForHead", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, db, clock, rt := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("HEAD", testNoteId1, nil)
+		req.Header.Set(acceptHeader, activityStreamsMediaTypes[0])
+		ctx := gomock.Any()
+		db.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(true, nil)
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1)).Return(nil)
+		db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(testMyNote, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1)).Return(nil)
+		clock.EXPECT().Now().Return(now())
+		// Run the test
+		rt.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, resp.Header().Get(contentTypeHeader), contentTypeHeaderValue)
+		assertEqual(t, resp.Header().Get(varyHeader), acceptHeader)
+	})
+	t.Run("RendersRepresentation", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		htmlCfg := cfg
+		htmlCfg.Representations = []Representation{
+			HTMLRepresentation(func(c context.Context, w http.ResponseWriter, r *http.Request, t vocab.Type) error {
+				w.WriteHeader(http.StatusOK)
+				return nil
+			}),
+		}
+		rt, err := NewRouter(&routerTestActor{}, db, NewMockClock(ctl), htmlCfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(acceptHeader, "text/html,application/xhtml+xml,*/*;q=0.8")
+		ctx := gomock.Any()
+		db.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(true, nil)
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1)).Return(nil)
+		db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(testMyNote, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1)).Return(nil)
+		// Run the test
+		rt.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, resp.Header().Get(contentTypeHeader), HTMLMediaType)
+		assertEqual(t, resp.Header().Get(varyHeader), acceptHeader)
+	})
+	t.Run("ObjectNotFound", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, db, _, rt := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testNoteId1, nil))
+		db.EXPECT().Exists(gomock.Any(), mustParse(testNoteId1)).Return(false, nil)
+		// Run the test
+		rt.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusNotFound)
+	})
+	t.Run("ErrorIsInternalServerError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a, _, _, rt := setupFn(ctl)
+		a.err = errors.New("expected")
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "https://example.com/users/alice/inbox", nil)
+		// Run the test
+		rt.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusInternalServerError)
+	})
+	t.Run("CanceledIsServiceUnavailable", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a, _, _, rt := setupFn(ctl)
+		a.err = context.Canceled
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "https://example.com/users/alice/inbox", nil)
+		// Run the test
+		rt.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusServiceUnavailable)
+	})
+}
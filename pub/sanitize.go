@@ -0,0 +1,407 @@
+package pub
+
+import (
+	"github.com/go-fed/activity/streams/vocab"
+	"html"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// linkRel is the 'rel' attribute set on every sanitized link.
+const linkRel = "nofollow noopener"
+
+// HTMLPolicy determines which HTML a Sanitizer keeps.
+type HTMLPolicy struct {
+	// Elements maps the names of the allowed elements to the names of
+	// their allowed attributes. Elements not in the map are removed, but
+	// their text is kept. An empty map removes all markup.
+	Elements map[string][]string
+	// URLSchemes are the allowed schemes of the 'href', 'src', and 'cite'
+	// attributes. Attributes with any other scheme are removed. Relative
+	// IRIs are always allowed.
+	URLSchemes []string
+}
+
+// DefaultHTMLPolicy allows the formatting commonly found in the content of
+// federated Notes: paragraphs, line breaks, emphasis, lists, quotes, code, and
+// http, https, and mailto links.
+func DefaultHTMLPolicy() HTMLPolicy {
+	return HTMLPolicy{
+		Elements: map[string][]string{
+			"a":          {"href"},
+			"b":          nil,
+			"blockquote": {"cite"},
+			"br":         nil,
+			"code":       nil,
+			"del":        nil,
+			"em":         nil,
+			"i":          nil,
+			"li":         nil,
+			"ol":         nil,
+			"p":          nil,
+			"pre":        nil,
+			"span":       {"class"},
+			"strong":     nil,
+			"u":          nil,
+			"ul":         nil,
+		},
+		URLSchemes: []string{"http", "https", "mailto"},
+	}
+}
+
+// Sanitizer removes unsafe HTML from the natural language values of
+// ActivityStreams types, so they can be served to web browsers.
+//
+// It is safe for concurrent use.
+type Sanitizer struct {
+	elements map[string]map[string]bool
+	schemes  map[string]bool
+}
+
+// NewSanitizer creates a Sanitizer applying the policy.
+func NewSanitizer(p HTMLPolicy) *Sanitizer {
+	s := &Sanitizer{
+		elements: make(map[string]map[string]bool, len(p.Elements)),
+		schemes:  make(map[string]bool, len(p.URLSchemes)),
+	}
+	for name, attrs := range p.Elements {
+		m := make(map[string]bool, len(attrs))
+		for _, attr := range attrs {
+			m[strings.ToLower(attr)] = true
+		}
+		s.elements[strings.ToLower(name)] = m
+	}
+	for _, scheme := range p.URLSchemes {
+		s.schemes[strings.ToLower(scheme)] = true
+	}
+	return s
+}
+
+// naturalLanguageValue is a value of the 'content', 'summary', or 'name'
+// properties, which is either a string or a map of languages to strings.
+type naturalLanguageValue interface {
+	IsXMLSchemaString() bool
+	GetXMLSchemaString() string
+	SetXMLSchemaString(v string)
+	IsRDFLangString() bool
+	GetRDFLangString() map[string]string
+	SetRDFLangString(v map[string]string)
+}
+
+// Sanitize removes unsafe HTML from the 'content' and 'summary' of the type,
+// in every language of their maps, and of the types embedded in its 'object'
+// property. Their 'name', which is plain text, has all markup removed instead.
+func (s *Sanitizer) Sanitize(t vocab.Type) {
+	if c, ok := t.(contenter); ok {
+		if p := c.GetActivityStreamsContent(); p != nil {
+			for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+				sanitizeValue(iter, s.SanitizeHTML)
+			}
+		}
+	}
+	if sm, ok := t.(summaryer); ok {
+		if p := sm.GetActivityStreamsSummary(); p != nil {
+			for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+				sanitizeValue(iter, s.SanitizeHTML)
+			}
+		}
+	}
+	if n, ok := t.(namer); ok {
+		if p := n.GetActivityStreamsName(); p != nil {
+			for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+				sanitizeValue(iter, stripHTML)
+			}
+		}
+	}
+	if o, ok := t.(objecter); ok {
+		if p := o.GetActivityStreamsObject(); p != nil {
+			for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+				if et := iter.GetType(); et != nil {
+					s.Sanitize(et)
+				}
+			}
+		}
+	}
+}
+
+// sanitizeValue sanitizes a string or each language of a map.
+func sanitizeValue(v naturalLanguageValue, sanitize func(string) string) {
+	if v.IsXMLSchemaString() {
+		v.SetXMLSchemaString(sanitize(v.GetXMLSchemaString()))
+	} else if v.IsRDFLangString() {
+		m := v.GetRDFLangString()
+		sanitized := make(map[string]string, len(m))
+		for lang, str := range m {
+			sanitized[lang] = sanitize(str)
+		}
+		v.SetRDFLangString(sanitized)
+	}
+}
+
+// markupRemover removes all elements from HTML, keeping their text.
+var markupRemover = NewSanitizer(HTMLPolicy{})
+
+// stripHTML removes the markup from HTML that should have been plain text,
+// without escaping its text, so that "Tom & Jerry" is unchanged.
+func stripHTML(in string) string {
+	return html.UnescapeString(markupRemover.SanitizeHTML(in))
+}
+
+// voidElements have no content nor end tag.
+var voidElements = map[string]bool{
+	"area":   true,
+	"base":   true,
+	"br":     true,
+	"col":    true,
+	"embed":  true,
+	"hr":     true,
+	"img":    true,
+	"input":  true,
+	"link":   true,
+	"meta":   true,
+	"param":  true,
+	"source": true,
+	"track":  true,
+	"wbr":    true,
+}
+
+// droppedElements are removed along with their content when not allowed,
+// since their content is not meant to be displayed as text.
+var droppedElements = map[string]bool{
+	"iframe":   true,
+	"math":     true,
+	"noembed":  true,
+	"noframes": true,
+	"noscript": true,
+	"object":   true,
+	"script":   true,
+	"style":    true,
+	"svg":      true,
+	"template": true,
+	"textarea": true,
+	"title":    true,
+	"xmp":      true,
+}
+
+// urlAttributes are the attributes whose values are IRIs.
+var urlAttributes = map[string]bool{
+	"cite": true,
+	"href": true,
+	"src":  true,
+}
+
+// htmlAttribute is an attribute of a start tag.
+type htmlAttribute struct {
+	name  string
+	value string
+}
+
+// SanitizeHTML removes the elements and attributes not allowed by the policy
+// from the HTML fragment, and returns it well-formed with all text escaped.
+//
+// Links are given a 'rel' of "nofollow noopener". Comments are removed, as are
+// the scripts, styles, and other elements whose content is not text, unless
+// allowed.
+func (s *Sanitizer) SanitizeHTML(in string) string {
+	var b strings.Builder
+	var open []string
+	for i := 0; i < len(in); {
+		if in[i] != '<' {
+			j := strings.IndexByte(in[i:], '<')
+			if j < 0 {
+				j = len(in) - i
+			}
+			b.WriteString(escapeText(in[i : i+j]))
+			i += j
+			continue
+		}
+		rest := in[i:]
+		switch {
+		case strings.HasPrefix(rest, "<!--"):
+			if j := strings.Index(rest[len("<!--"):], "-->"); j >= 0 {
+				i += len("<!--") + j + len("-->")
+			} else {
+				i = len(in)
+			}
+		case len(rest) > 1 && (rest[1] == '!' || rest[1] == '?'):
+			i += skipTag(rest)
+		case len(rest) > 2 && rest[1] == '/' && isASCIILetter(rest[2]):
+			name, _, n := parseTag(rest[2:])
+			i += 2 + n
+			if _, ok := s.elements[name]; !ok {
+				continue
+			}
+			for k := len(open) - 1; k >= 0; k-- {
+				if open[k] == name {
+					for ; len(open) > k; open = open[:len(open)-1] {
+						b.WriteString("</" + open[len(open)-1] + ">")
+					}
+					break
+				}
+			}
+		case len(rest) > 1 && isASCIILetter(rest[1]):
+			name, attrs, n := parseTag(rest[1:])
+			i += 1 + n
+			allowed, ok := s.elements[name]
+			if !ok {
+				if droppedElements[name] {
+					i += skipContent(in[i:], name)
+				}
+				continue
+			}
+			b.WriteString("<" + name)
+			for _, attr := range s.sanitizeAttributes(name, allowed, attrs) {
+				b.WriteString(" " + attr.name + `="` + html.EscapeString(attr.value) + `"`)
+			}
+			b.WriteString(">")
+			if !voidElements[name] {
+				open = append(open, name)
+			}
+		default:
+			b.WriteString("&lt;")
+			i++
+		}
+	}
+	for k := len(open) - 1; k >= 0; k-- {
+		b.WriteString("</" + open[k] + ">")
+	}
+	return b.String()
+}
+
+// sanitizeAttributes keeps the allowed attributes with safe values.
+func (s *Sanitizer) sanitizeAttributes(element string, allowed map[string]bool, attrs []htmlAttribute) []htmlAttribute {
+	var kept []htmlAttribute
+	seen := make(map[string]bool, len(attrs))
+	for _, attr := range attrs {
+		if !allowed[attr.name] || seen[attr.name] || attr.name == "rel" {
+			continue
+		}
+		seen[attr.name] = true
+		if urlAttributes[attr.name] {
+			v, ok := s.sanitizeURL(attr.value)
+			if !ok {
+				continue
+			}
+			attr.value = v
+		}
+		kept = append(kept, attr)
+	}
+	if element == "a" {
+		kept = append(kept, htmlAttribute{name: "rel", value: linkRel})
+	}
+	sort.SliceStable(kept, func(i, j int) bool {
+		return kept[i].name < kept[j].name
+	})
+	return kept
+}
+
+// sanitizeURL determines whether an IRI has an allowed scheme, ignoring the
+// whitespace that web browsers ignore.
+func (s *Sanitizer) sanitizeURL(v string) (string, bool) {
+	v = strings.TrimFunc(v, func(r rune) bool {
+		return r <= ' '
+	})
+	v = strings.NewReplacer("\t", "", "\n", "", "\r", "").Replace(v)
+	u, err := url.Parse(v)
+	if err != nil {
+		return "", false
+	} else if u.Scheme != "" && !s.schemes[strings.ToLower(u.Scheme)] {
+		return "", false
+	}
+	return v, true
+}
+
+// escapeText escapes text, keeping the characters it already refers to.
+func escapeText(s string) string {
+	return html.EscapeString(html.UnescapeString(s))
+}
+
+// isASCIILetter determines whether a byte begins a tag name.
+func isASCIILetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+// isHTMLSpace determines whether a byte is whitespace between attributes.
+func isHTMLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// skipTag returns the length of a tag up to and including its closing '>', or
+// of the rest of the input if it is not closed.
+func skipTag(s string) int {
+	if j := strings.IndexByte(s, '>'); j >= 0 {
+		return j + 1
+	}
+	return len(s)
+}
+
+// skipContent returns the length of the content of an element up to and
+// including its end tag, or of the rest of the input if it is not closed.
+func skipContent(s, name string) int {
+	lower := strings.ToLower(s)
+	end := "</" + name
+	for off := 0; ; {
+		j := strings.Index(lower[off:], end)
+		if j < 0 {
+			return len(s)
+		}
+		off += j + len(end)
+		if off == len(s) || isHTMLSpace(s[off]) || s[off] == '/' || s[off] == '>' {
+			return off + skipTag(s[off:])
+		}
+	}
+}
+
+// parseTag parses the lowercased name and the attributes of a tag following
+// its '<' or '</', and returns the length of the tag up to and including its
+// closing '>'.
+func parseTag(s string) (name string, attrs []htmlAttribute, n int) {
+	for n < len(s) && !isHTMLSpace(s[n]) && s[n] != '/' && s[n] != '>' {
+		n++
+	}
+	name = strings.ToLower(s[:n])
+	for n < len(s) {
+		if isHTMLSpace(s[n]) || s[n] == '/' {
+			n++
+			continue
+		} else if s[n] == '>' {
+			return name, attrs, n + 1
+		}
+		start := n
+		for n < len(s) && !isHTMLSpace(s[n]) && s[n] != '/' && s[n] != '>' && (s[n] != '=' || n == start) {
+			n++
+		}
+		attr := htmlAttribute{name: strings.ToLower(s[start:n])}
+		for n < len(s) && isHTMLSpace(s[n]) {
+			n++
+		}
+		if n < len(s) && s[n] == '=' {
+			n++
+			for n < len(s) && isHTMLSpace(s[n]) {
+				n++
+			}
+			if n < len(s) && (s[n] == '"' || s[n] == '\'') {
+				q := s[n]
+				n++
+				start = n
+				for n < len(s) && s[n] != q {
+					n++
+				}
+				attr.value = s[start:n]
+				if n < len(s) {
+					n++
+				}
+			} else {
+				start = n
+				for n < len(s) && !isHTMLSpace(s[n]) && s[n] != '>' {
+					n++
+				}
+				attr.value = s[start:n]
+			}
+			attr.value = html.UnescapeString(attr.value)
+		}
+		attrs = append(attrs, attr)
+	}
+	return name, attrs, n
+}
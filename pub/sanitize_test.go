@@ -0,0 +1,222 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// TestSanitizeHTML tests sanitizing HTML with the default policy.
+func TestSanitizeHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		expected string
+	}{
+		{"Text", "hello world", "hello world"},
+		{"EscapesText", "1 < 2 & 3 > 2", "1 &lt; 2 &amp; 3 &gt; 2"},
+		{"KeepsEscapedText", "&lt;b&gt; &amp;", "&lt;b&gt; &amp;"},
+		{"KeepsAllowedElements", "<p>a<br>b<strong>c</strong></p>", "<p>a<br>b<strong>c</strong></p>"},
+		{"LowercasesElements", "<P>a</P>", "<p>a</p>"},
+		{"RemovesScript", "a<script>alert(1)</script>b", "ab"},
+		{"RemovesScriptCaseInsensitive", "a<SCRIPT type=x>alert('</p>')</ScRiPt >b", "ab"},
+		{"RemovesUnclosedScript", "a<script>alert(1)", "a"},
+		{"RemovesStyle", "<style>p{}</style><p>a</p>", "<p>a</p>"},
+		{"RemovesComment", "a<!-- <script>alert(1)</script> -->b", "ab"},
+		{"RemovesDoctype", "<!DOCTYPE html>a", "a"},
+		{"KeepsTextOfDisallowedElements", "<div><h1>a</h1></div>", "a"},
+		{"RemovesEventHandlers", `<p onclick="alert(1)">a</p>`, "<p>a</p>"},
+		{"RemovesDisallowedAttributes", `<span class="h-card" style="color:red">a</span>`, `<span class="h-card">a</span>`},
+		{"RemovesImage", `<img src=x onerror=alert(1)>`, ""},
+		{"AddsRelToLinks", `<a href="https://example.com/">a</a>`, `<a href="https://example.com/" rel="nofollow noopener">a</a>`},
+		{"ReplacesRelOfLinks", `<a rel="me" href="https://example.com/">a</a>`, `<a href="https://example.com/" rel="nofollow noopener">a</a>`},
+		{"KeepsRelativeLinks", `<a href="/tags/go">a</a>`, `<a href="/tags/go" rel="nofollow noopener">a</a>`},
+		{"RemovesJavaScriptLinks", `<a href="javascript:alert(1)">a</a>`, `<a rel="nofollow noopener">a</a>`},
+		{"RemovesObfuscatedJavaScriptLinks", "<a href=\" java\tscript:alert(1)\">a</a>", `<a rel="nofollow noopener">a</a>`},
+		{"RemovesEscapedJavaScriptLinks", `<a href="&#106;avascript:alert(1)">a</a>`, `<a rel="nofollow noopener">a</a>`},
+		{"RemovesDataLinks", `<a href=data:text/html,x>a</a>`, `<a rel="nofollow noopener">a</a>`},
+		{"EscapesAttributeValues", `<a href='https://example.com/?a=1&b="2"'>a</a>`, `<a href="https://example.com/?a=1&amp;b=&#34;2&#34;" rel="nofollow noopener">a</a>`},
+		{"ClosesUnclosedElements", "<p><em>a", "<p><em>a</em></p>"},
+		{"ClosesMisnestedElements", "<p><em>a</p>b</em>", "<p><em>a</em></p>b"},
+		{"RemovesStrayEndTags", "a</p></script>b", "ab"},
+		{"EscapesStrayLessThan", "a <3 b", "a &lt;3 b"},
+	}
+	s := NewSanitizer(DefaultHTMLPolicy())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assertEqual(t, s.SanitizeHTML(test.in), test.expected)
+		})
+	}
+}
+
+// TestSanitize tests sanitizing the natural language values of types.
+func TestSanitize(t *testing.T) {
+	t.Run("SanitizesContentSummaryAndName", func(t *testing.T) {
+		// Setup
+		note := streams.NewActivityStreamsNote()
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString("<p>a<script>alert(1)</script></p>")
+		note.SetActivityStreamsContent(content)
+		summary := streams.NewActivityStreamsSummaryProperty()
+		summary.AppendXMLSchemaString("<b onmouseover=alert(1)>cw</b>")
+		note.SetActivityStreamsSummary(summary)
+		name := streams.NewActivityStreamsNameProperty()
+		name.AppendXMLSchemaString("<i>name</i><iframe src=x></iframe>")
+		note.SetActivityStreamsName(name)
+		s := NewSanitizer(DefaultHTMLPolicy())
+		// Run the test
+		s.Sanitize(note)
+		// Verify results
+		assertEqual(t, note.GetActivityStreamsContent().At(0).GetXMLSchemaString(), "<p>a</p>")
+		assertEqual(t, note.GetActivityStreamsSummary().At(0).GetXMLSchemaString(), "<b>cw</b>")
+		assertEqual(t, note.GetActivityStreamsName().At(0).GetXMLSchemaString(), "name")
+	})
+	t.Run("KeepsNamePlainText", func(t *testing.T) {
+		// Setup
+		note := streams.NewActivityStreamsNote()
+		name := streams.NewActivityStreamsNameProperty()
+		name.AppendXMLSchemaString("Tom & Jerry <3")
+		name.AppendRDFLangString(map[string]string{"fr": "Tom &amp; Jerry"})
+		note.SetActivityStreamsName(name)
+		s := NewSanitizer(DefaultHTMLPolicy())
+		// Run the test
+		s.Sanitize(note)
+		// Verify results
+		assertEqual(t, note.GetActivityStreamsName().At(0).GetXMLSchemaString(), "Tom & Jerry <3")
+		assertEqual(t, note.GetActivityStreamsName().At(1).GetRDFLangString()["fr"], "Tom & Jerry")
+	})
+	t.Run("SanitizesEveryLanguage", func(t *testing.T) {
+		// Setup
+		note := streams.NewActivityStreamsNote()
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendRDFLangString(map[string]string{
+			"en": `<a href="javascript:alert(1)">hello</a>`,
+			"fr": "<p>bonjour<script>alert(1)</script></p>",
+		})
+		note.SetActivityStreamsContent(content)
+		s := NewSanitizer(DefaultHTMLPolicy())
+		// Run the test
+		s.Sanitize(note)
+		// Verify results
+		expected := map[string]string{
+			"en": `<a rel="nofollow noopener">hello</a>`,
+			"fr": "<p>bonjour</p>",
+		}
+		assertEqual(t, reflect.DeepEqual(note.GetActivityStreamsContent().At(0).GetRDFLangString(), expected), true)
+	})
+	t.Run("SanitizesEmbeddedObjects", func(t *testing.T) {
+		// Setup
+		note := streams.NewActivityStreamsNote()
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString("<p onclick=alert(1)>a</p>")
+		note.SetActivityStreamsContent(content)
+		create := streams.NewActivityStreamsCreate()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsNote(note)
+		create.SetActivityStreamsObject(op)
+		s := NewSanitizer(DefaultHTMLPolicy())
+		// Run the test
+		s.Sanitize(create)
+		// Verify results
+		assertEqual(t, note.GetActivityStreamsContent().At(0).GetXMLSchemaString(), "<p>a</p>")
+	})
+	t.Run("EmptyPolicyRemovesMarkup", func(t *testing.T) {
+		// Setup
+		s := NewSanitizer(HTMLPolicy{})
+		// Run the test
+		out := s.SanitizeHTML(`<p>a <a href="https://example.com/">b</a></p>`)
+		// Verify results
+		assertEqual(t, out, "a b")
+	})
+}
+
+// TestFederatedCreateSanitizes tests that federated objects are sanitized
+// before being stored.
+func TestFederatedCreateSanitizes(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	db := NewMockDatabase(ctl)
+	note := streams.NewActivityStreamsNote()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(mustParse(testNoteId1))
+	note.SetJSONLDId(id)
+	content := streams.NewActivityStreamsContentProperty()
+	content.AppendXMLSchemaString("<p>a<script>alert(1)</script></p>")
+	note.SetActivityStreamsContent(content)
+	create := streams.NewActivityStreamsCreate()
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendActivityStreamsNote(note)
+	create.SetActivityStreamsObject(op)
+	w := FederatingWrappedCallbacks{
+		db:       db,
+		sanitize: NewSanitizer(DefaultHTMLPolicy()).Sanitize,
+	}
+	var stored string
+	db.EXPECT().Lock(ctx, mustParse(testNoteId1)).Return(nil)
+	db.EXPECT().Create(ctx, note).DoAndReturn(func(c context.Context, t vocab.Type) error {
+		stored = t.(contenter).GetActivityStreamsContent().At(0).GetXMLSchemaString()
+		return nil
+	})
+	db.EXPECT().Unlock(ctx, mustParse(testNoteId1)).Return(nil)
+	// Run the test
+	err := w.create(ctx, create)
+	// Verify results
+	assertEqual(t, err, nil)
+	assertEqual(t, stored, "<p>a</p>")
+}
+
+// TestFederatingActorSanitizes tests that an Actor built with WithSanitizer
+// stores the sanitized objects of activities POSTed to its inbox.
+func TestFederatingActorSanitizes(t *testing.T) {
+	// Setup
+	setupData()
+	ctx := context.Background()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	common := NewMockCommonBehavior(ctl)
+	fp := NewMockFederatingProtocol(ctl)
+	db := NewMockDatabase(ctl)
+	a := NewFederatingActor(common, fp, db, NewMockClock(ctl), WithSanitizer(NewSanitizer(DefaultHTMLPolicy())))
+	create := streams.Clone(testCreate).(vocab.ActivityStreamsCreate)
+	note := create.GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+	content := streams.NewActivityStreamsContentProperty()
+	content.AppendXMLSchemaString("<p>a<script>alert(1)</script></p>")
+	note.SetActivityStreamsContent(content)
+	name := streams.NewActivityStreamsNameProperty()
+	name.AppendXMLSchemaString("<b>Tom</b> & Jerry")
+	note.SetActivityStreamsName(name)
+	resp := httptest.NewRecorder()
+	req := toAPRequest(toPostInboxRequest(create))
+	inboxIRI := mustParse(testMyInboxIRI)
+	var stored vocab.ActivityStreamsNote
+	fp.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+	fp.EXPECT().PostInboxRequestBodyHook(ctx, req, gomock.Any()).Return(ctx, nil)
+	fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+	fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{}, nil, nil)
+	db.EXPECT().Lock(ctx, gomock.Any()).Return(nil).AnyTimes()
+	db.EXPECT().Unlock(ctx, gomock.Any()).Return(nil).AnyTimes()
+	db.EXPECT().InboxContains(ctx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil)
+	db.EXPECT().GetInbox(ctx, inboxIRI).Return(testEmptyOrderedCollection, nil)
+	db.EXPECT().SetInbox(ctx, gomock.Any()).Return(nil)
+	db.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(c context.Context, t vocab.Type) error {
+		stored = t.(vocab.ActivityStreamsNote)
+		return nil
+	})
+	db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(true, nil)
+	// Run the test
+	handled, err := a.PostInbox(ctx, resp, req)
+	// Verify results
+	assertEqual(t, err, nil)
+	assertEqual(t, handled, true)
+	assertEqual(t, resp.Code, http.StatusOK)
+	assertEqual(t, stored.GetActivityStreamsContent().At(0).GetXMLSchemaString(), "<p>a</p>")
+	assertEqual(t, stored.GetActivityStreamsName().At(0).GetXMLSchemaString(), "Tom & Jerry")
+}
@@ -26,6 +26,11 @@ type sideEffectActor struct {
 	c2s    SocialProtocol
 	db     Database
 	clock  Clock
+	// observer is notified of the side effects being applied.
+	observer Observer
+	// sanitizer sanitizes federated objects that are created or updated. It
+	// is nil if they are not sanitized.
+	sanitizer *Sanitizer
 }
 
 // PostInboxRequestBodyHook defers to the delegate.
@@ -118,19 +123,36 @@ func (a *sideEffectActor) PostInbox(c context.Context, inboxIRI *url.URL, activi
 		wrapped.db = a.db
 		wrapped.inboxIRI = inboxIRI
 		wrapped.newTransport = a.common.NewTransport
+		wrapped.newInstanceTransport = a.common.NewInstanceTransport
 		wrapped.deliver = a.Deliver
 		wrapped.addNewIds = a.AddNewIds
+		wrapped.ingest = a.PostInbox
+		wrapped.blocked = a.s2s.Blocked
+		if a.sanitizer != nil {
+			wrapped.sanitize = a.sanitizer.Sanitize
+		}
 		res, err := streams.NewTypeResolver(wrapped.callbacks(other)...)
 		if err != nil {
 			return err
 		}
+		done := observerOrDefault(a.observer).CallbackDispatched(c, EndpointPostInbox, activity.GetTypeName())
 		if err = res.Resolve(c, activity); err != nil && !streams.IsUnmatchedErr(err) {
+			done(err)
 			return err
 		} else if streams.IsUnmatchedErr(err) {
 			err = a.s2s.DefaultCallback(c, activity)
+			done(err)
 			if err != nil {
 				return err
 			}
+		} else {
+			done(nil)
+		}
+		// Relays pass public activities on to their subscribers.
+		if wrapped.RelayPublicActivities {
+			if err = a.rebroadcast(c, inboxIRI, activity); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -344,15 +366,19 @@ func (a *sideEffectActor) PostOutbox(c context.Context, activity Activity, outbo
 		if err != nil {
 			return
 		}
+		done := observerOrDefault(a.observer).CallbackDispatched(c, EndpointPostOutbox, activity.GetTypeName())
 		if err = res.Resolve(c, activity); err != nil && !streams.IsUnmatchedErr(err) {
+			done(err)
 			return
 		} else if streams.IsUnmatchedErr(err) {
 			deliverable = true
 			err = a.c2s.DefaultCallback(c, activity)
+			done(err)
 			if err != nil {
 				return
 			}
 		} else {
+			done(nil)
 			deliverable = !undeliverable
 		}
 	}
@@ -403,7 +429,11 @@ func (a *sideEffectActor) Deliver(c context.Context, outboxIRI *url.URL, activit
 	if err != nil {
 		return err
 	}
-	return a.deliverToRecipients(c, outboxIRI, activity, recipients)
+	stripped, err := stripHiddenRecipients(activity)
+	if err != nil {
+		return err
+	}
+	return a.deliverToRecipients(c, outboxIRI, stripped, recipients)
 }
 
 // WrapInCreate wraps an object with a Create activity.
@@ -591,6 +621,7 @@ func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *
 		if err = json.Unmarshal(b, &m); err != nil {
 			return false, err
 		}
+		NormalizeJSON(m)
 		t, err := streams.ToType(c, m)
 		if err != nil {
 			// Do not fail the entire process if we cannot handle
@@ -611,8 +642,7 @@ func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *
 }
 
 // prepare takes a deliverableObject and returns a list of the proper recipient
-// target URIs. Additionally, the deliverableObject will have any hidden
-// hidden recipients ("bto" and "bcc") stripped from it.
+// target URIs.
 //
 // Only call if both the social and federated protocol are supported.
 func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activity Activity) (r []*url.URL, err error) {
@@ -719,7 +749,6 @@ func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activit
 		return nil, err
 	}
 	r = dedupeIRIs(targets, []*url.URL{ignore})
-	stripHiddenRecipients(activity)
 	return r, nil
 }
 
@@ -774,6 +803,7 @@ func (a *sideEffectActor) dereferenceForResolvingInboxes(c context.Context, t Tr
 	if err = json.Unmarshal(resp, &m); err != nil {
 		return
 	}
+	NormalizeJSON(m)
 	actor, err = streams.ToType(c, m)
 	if err != nil {
 		return
@@ -2,6 +2,8 @@ package pub
 
 import (
 	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
 	"github.com/go-fed/activity/streams/vocab"
 	"github.com/golang/mock/gomock"
 	"net/http/httptest"
@@ -1115,6 +1117,69 @@ func TestDeliver(t *testing.T) {
 	})
 }
 
+// TestSendKeepsHiddenRecipients ensures the activity returned by Send keeps its
+// 'bto' and 'bcc', while the copy delivered to the recipients has neither.
+func TestSendKeepsHiddenRecipients(t *testing.T) {
+	// Setup
+	setupData()
+	ctx := context.Background()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	common := NewMockCommonBehavior(ctl)
+	fp := NewMockFederatingProtocol(ctl)
+	db := NewMockDatabase(ctl)
+	tp := NewMockTransport(ctl)
+	a := NewFederatingActor(common, fp, db, NewMockClock(ctl))
+	newPerson := func(id, inbox string) vocab.ActivityStreamsPerson {
+		p := streams.NewActivityStreamsPerson()
+		idProp := streams.NewJSONLDIdProperty()
+		idProp.Set(mustParse(id))
+		p.SetJSONLDId(idProp)
+		inboxProp := streams.NewActivityStreamsInboxProperty()
+		inboxProp.SetIRI(mustParse(inbox))
+		p.SetActivityStreamsInbox(inboxProp)
+		return p
+	}
+	listen := streams.NewActivityStreamsListen()
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(mustParse(testFederatedActorIRI))
+	listen.SetActivityStreamsTo(to)
+	bcc := streams.NewActivityStreamsBccProperty()
+	bcc.AppendIRI(mustParse(testFederatedActorIRI2))
+	listen.SetActivityStreamsBcc(bcc)
+	outboxIRI := mustParse(testMyOutboxIRI)
+	var delivered map[string]interface{}
+	var recipients []*url.URL
+	db.EXPECT().Lock(ctx, gomock.Any()).Return(nil).AnyTimes()
+	db.EXPECT().Unlock(ctx, gomock.Any()).Return(nil).AnyTimes()
+	db.EXPECT().NewId(ctx, listen).Return(mustParse(testNewActivityIRI), nil)
+	db.EXPECT().Create(ctx, listen).Return(nil)
+	db.EXPECT().GetOutbox(ctx, outboxIRI).Return(testEmptyOrderedCollection, nil)
+	db.EXPECT().SetOutbox(ctx, gomock.Any()).Return(nil)
+	db.EXPECT().ActorForOutbox(ctx, outboxIRI).Return(mustParse(testPersonIRI), nil)
+	db.EXPECT().Get(ctx, mustParse(testPersonIRI)).Return(newPerson(testPersonIRI, testMyInboxIRI), nil)
+	fp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
+	common.EXPECT().NewTransport(ctx, outboxIRI, goFedUserAgent()).Return(tp, nil).Times(2)
+	tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
+		mustSerializeToBytes(newPerson(testFederatedActorIRI, testFederatedActorIRI+"/inbox")), nil)
+	tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
+		mustSerializeToBytes(newPerson(testFederatedActorIRI2, testFederatedActorIRI2+"/inbox")), nil)
+	tp.EXPECT().BatchDeliver(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(c context.Context, b []byte, r []*url.URL) error {
+		recipients = r
+		return json.Unmarshal(b, &delivered)
+	})
+	// Run the test
+	sent, err := a.Send(ctx, outboxIRI, listen)
+	// Verify results
+	assertEqual(t, err, nil)
+	assertEqual(t, sent.(vocab.ActivityStreamsListen).GetActivityStreamsBcc().Len(), 1)
+	assertEqual(t, len(recipients), 2)
+	assertEqual(t, recipients[0].String(), testFederatedActorIRI+"/inbox")
+	assertEqual(t, recipients[1].String(), testFederatedActorIRI2+"/inbox")
+	assertEqual(t, len(delivered["bcc"].([]interface{})), 0)
+	assertEqual(t, delivered["to"], testFederatedActorIRI)
+}
+
 // TestWrapInCreate ensures an object received by the Social Protocol is
 // properly wrapped in a Create Activity.
 func TestWrapInCreate(t *testing.T) {
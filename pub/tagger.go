@@ -0,0 +1,373 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tagPattern matches an '@user@host' mention or a '#tag' hashtag in text.
+var tagPattern = regexp.MustCompile(`@([\w.+-]+)@((?:[\w-]+\.)+[\w-]+(?::\d+)?)|#([\p{L}\p{N}_]*\p{L}[\p{L}\p{N}_]*)`)
+
+// LinkRenderer renders the HTML link that replaces the text of a Mention or
+// Hashtag in content.
+type LinkRenderer func(tag vocab.Type, text string, href *url.URL) string
+
+// DefaultLinkRenderer renders links to mentioned actors and hashtags with the
+// microformats commonly understood by federated software.
+func DefaultLinkRenderer(tag vocab.Type, text string, href *url.URL) string {
+	h := html.EscapeString(href.String())
+	if streams.IsOrExtendsActivityStreamsHashtag(tag) {
+		return `<a href="` + h + `" class="mention hashtag" rel="tag">#<span>` + html.EscapeString(strings.TrimPrefix(text, "#")) + `</span></a>`
+	}
+	user := strings.TrimPrefix(text, "@")
+	if at := strings.IndexByte(user, '@'); at >= 0 {
+		user = user[:at]
+	}
+	return `<span class="h-card"><a href="` + h + `" class="u-url mention">@<span>` + html.EscapeString(user) + `</span></a></span>`
+}
+
+// TaggerConfig determines how a Tagger finds and links mentions and hashtags.
+type TaggerConfig struct {
+	// Resolve finds the actor mentioned as '@user@host', such as one created
+	// by NewWebFingerResolver. It is only called for hosts whose addresses
+	// are all public, so that mentions cannot make the application request
+	// its own networks. Required.
+	Resolve MentionResolver
+	// ResolveFailed is notified of the errors of Resolve, such as when the
+	// host of a mention is unreachable, and of mentions of hosts that are
+	// not public. Such mentions are left as they are either way. May be nil.
+	ResolveFailed func(c context.Context, user, host string, err error)
+	// HashtagIRI returns the IRI of the page of a hashtag, given without its
+	// '#'. If nil, or if it returns nil, the hashtag is not linked nor
+	// tagged.
+	HashtagIRI func(tag string) *url.URL
+	// Render renders the links replacing mentions and hashtags. If nil,
+	// DefaultLinkRenderer is used.
+	Render LinkRenderer
+}
+
+// Tagger extracts the '@user@host' mentions and '#tag' hashtags from the
+// content of outgoing objects.
+//
+// It is safe for concurrent use.
+type Tagger struct {
+	cfg TaggerConfig
+}
+
+// NewTagger creates a Tagger with the configuration.
+func NewTagger(cfg TaggerConfig) *Tagger {
+	if cfg.Render == nil {
+		cfg.Render = DefaultLinkRenderer
+	}
+	return &Tagger{cfg: cfg}
+}
+
+// tagState is the progress of tagging one activity.
+type tagState struct {
+	// resolved caches the actors resolved for the lowercased handles.
+	resolved map[string]*url.URL
+	// tags are the Mentions and Hashtags found in the current object.
+	tags []vocab.Type
+	// tagged are the hrefs of the tags, to not repeat them.
+	tagged map[string]bool
+	// mentioned are the actors mentioned in the current object.
+	mentioned []*url.URL
+}
+
+// Tag replaces the mentions and hashtags in the content of the activity's
+// objects, in every language, with links. It adds a Mention or Hashtag to the
+// 'tag' of each object for them, and adds the mentioned actors to the 'cc' of
+// each object and of the activity if they are not already recipients.
+//
+// Mentions that do not resolve to an actor, including those whose resolution
+// fails, are left as they are. Text that is already within a link is not
+// changed.
+func (t *Tagger) Tag(c context.Context, activity Activity) error {
+	op := activity.GetActivityStreamsObject()
+	if op == nil {
+		return nil
+	}
+	st := &tagState{resolved: make(map[string]*url.URL)}
+	var mentioned []*url.URL
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		obj := iter.GetType()
+		if obj == nil {
+			continue
+		}
+		st.tags = nil
+		st.tagged = make(map[string]bool)
+		st.mentioned = nil
+		if err := t.tagObject(c, obj, st); err != nil {
+			return err
+		}
+		mentioned = append(mentioned, st.mentioned...)
+	}
+	return addCc(activity, mentioned)
+}
+
+// tagObject links the mentions and hashtags in the content of an object, and
+// tags and addresses it accordingly.
+func (t *Tagger) tagObject(c context.Context, obj vocab.Type, st *tagState) error {
+	ct, ok := obj.(contenter)
+	if !ok || ct.GetActivityStreamsContent() == nil {
+		return nil
+	}
+	tg, ok := obj.(tagger)
+	if !ok {
+		return nil
+	}
+	tags := tg.GetActivityStreamsTag()
+	if tags == nil {
+		tags = streams.NewActivityStreamsTagProperty()
+	}
+	for iter := tags.Begin(); iter != tags.End(); iter = iter.Next() {
+		if href := tagHref(iter); href != nil {
+			st.tagged[href.String()] = true
+		}
+	}
+	content := ct.GetActivityStreamsContent()
+	for iter := content.Begin(); iter != content.End(); iter = iter.Next() {
+		if iter.IsXMLSchemaString() {
+			s, err := t.linkify(c, iter.GetXMLSchemaString(), st)
+			if err != nil {
+				return err
+			}
+			iter.SetXMLSchemaString(s)
+		} else if iter.IsRDFLangString() {
+			m := iter.GetRDFLangString()
+			linked := make(map[string]string, len(m))
+			for lang, v := range m {
+				s, err := t.linkify(c, v, st)
+				if err != nil {
+					return err
+				}
+				linked[lang] = s
+			}
+			iter.SetRDFLangString(linked)
+		}
+	}
+	if len(st.tags) == 0 {
+		return nil
+	}
+	for _, tag := range st.tags {
+		if err := tags.AppendType(tag); err != nil {
+			return err
+		}
+	}
+	tg.SetActivityStreamsTag(tags)
+	return addCc(obj, st.mentioned)
+}
+
+// linkify replaces the mentions and hashtags in the text outside of the links
+// in HTML content.
+func (t *Tagger) linkify(c context.Context, s string, st *tagState) (string, error) {
+	var b strings.Builder
+	inLink := 0
+	for i := 0; i < len(s); {
+		if s[i] == '<' {
+			j := strings.IndexByte(s[i:], '>')
+			if j < 0 {
+				j = len(s) - i - 1
+			}
+			el := strings.ToLower(s[i : i+j+1])
+			if isElementTag(el, "<a") {
+				inLink++
+			} else if isElementTag(el, "</a") && inLink > 0 {
+				inLink--
+			}
+			b.WriteString(s[i : i+j+1])
+			i += j + 1
+			continue
+		}
+		j := strings.IndexByte(s[i:], '<')
+		if j < 0 {
+			j = len(s) - i
+		}
+		text := s[i : i+j]
+		if inLink == 0 {
+			var err error
+			if text, err = t.linkifyText(c, text, st); err != nil {
+				return "", err
+			}
+		}
+		b.WriteString(text)
+		i += j
+	}
+	return b.String(), nil
+}
+
+// isElementTag determines whether a lowercased tag is the start or end tag,
+// given by its prefix, of an element.
+func isElementTag(el, prefix string) bool {
+	if !strings.HasPrefix(el, prefix) || len(el) == len(prefix) {
+		return false
+	}
+	c := el[len(prefix)]
+	return c == '>' || c == '/' || isHTMLSpace(c)
+}
+
+// resolve finds the actor mentioned as '@user@host' with the MentionResolver,
+// if the host only resolves to public addresses.
+func (t *Tagger) resolve(c context.Context, user, host string) (*url.URL, error) {
+	hostname := (&url.URL{Host: host}).Hostname()
+	if public, err := resolvesPublicly(c, hostname); err != nil {
+		return nil, err
+	} else if !public {
+		return nil, fmt.Errorf("mentioned host %s is not public", host)
+	}
+	return t.cfg.Resolve(c, user, host)
+}
+
+// linkifyText replaces the mentions and hashtags in text.
+func (t *Tagger) linkifyText(c context.Context, text string, st *tagState) (string, error) {
+	var b strings.Builder
+	last := 0
+	for _, m := range tagPattern.FindAllStringSubmatchIndex(text, -1) {
+		if r, _ := utf8.DecodeLastRuneInString(text[:m[0]]); m[0] > 0 && !canPrecedeTag(r) {
+			continue
+		}
+		match := text[m[0]:m[1]]
+		var tag vocab.Type
+		var href *url.URL
+		if m[2] >= 0 {
+			user, host := text[m[2]:m[3]], text[m[4]:m[5]]
+			handle := strings.ToLower(user + "@" + host)
+			var ok bool
+			if href, ok = st.resolved[handle]; !ok {
+				var err error
+				if href, err = t.resolve(c, user, host); err != nil {
+					// A peer being unreachable must not prevent
+					// posting, so treat it as not found.
+					if t.cfg.ResolveFailed != nil {
+						t.cfg.ResolveFailed(c, user, host, err)
+					}
+					href = nil
+				}
+				st.resolved[handle] = href
+			}
+			if href == nil {
+				continue
+			}
+			mention := streams.NewActivityStreamsMention()
+			setHrefAndName(mention, href, match)
+			tag = mention
+			st.mentioned = append(st.mentioned, href)
+		} else {
+			if t.cfg.HashtagIRI == nil {
+				continue
+			}
+			if href = t.cfg.HashtagIRI(text[m[6]:m[7]]); href == nil {
+				continue
+			}
+			hashtag := streams.NewActivityStreamsHashtag()
+			setHrefAndName(hashtag, href, match)
+			tag = hashtag
+		}
+		if !st.tagged[href.String()] {
+			st.tagged[href.String()] = true
+			st.tags = append(st.tags, tag)
+		}
+		b.WriteString(text[last:m[0]])
+		b.WriteString(t.cfg.Render(tag, match, href))
+		last = m[1]
+	}
+	b.WriteString(text[last:])
+	return b.String(), nil
+}
+
+// canPrecedeTag determines whether a mention or hashtag may follow a rune,
+// so that email addresses, IRI fragments, and character references are not
+// mistaken for them.
+func canPrecedeTag(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("_@/&#.", r)
+}
+
+// hrefNamer is a Link with settable 'href' and 'name' properties.
+type hrefNamer interface {
+	SetActivityStreamsHref(vocab.ActivityStreamsHrefProperty)
+	SetActivityStreamsName(vocab.ActivityStreamsNameProperty)
+}
+
+// setHrefAndName sets the 'href' and 'name' of a Mention or Hashtag.
+func setHrefAndName(l hrefNamer, href *url.URL, name string) {
+	hp := streams.NewActivityStreamsHrefProperty()
+	hp.Set(href)
+	l.SetActivityStreamsHref(hp)
+	np := streams.NewActivityStreamsNameProperty()
+	np.AppendXMLSchemaString(name)
+	l.SetActivityStreamsName(np)
+}
+
+// tagHref returns the 'href' of a tag that is a Link, or the IRI of a tag.
+func tagHref(iter vocab.ActivityStreamsTagPropertyIterator) *url.URL {
+	if iter.IsIRI() {
+		return iter.GetIRI()
+	} else if h, ok := iter.GetType().(hrefer); ok && h.GetActivityStreamsHref() != nil {
+		return h.GetActivityStreamsHref().Get()
+	}
+	return nil
+}
+
+// addCc adds the actors to the 'cc' of a value, unless they are already in its
+// 'to', 'bto', 'cc', or 'bcc'.
+func addCc(t vocab.Type, actors []*url.URL) error {
+	cr, ok := t.(ccer)
+	if !ok || len(actors) == 0 {
+		return nil
+	}
+	existing := make(map[string]bool)
+	addIds := func(iter IdProperty) error {
+		id, err := ToId(iter)
+		if err != nil {
+			return err
+		}
+		existing[id.String()] = true
+		return nil
+	}
+	if v, ok := t.(toer); ok && v.GetActivityStreamsTo() != nil {
+		for iter := v.GetActivityStreamsTo().Begin(); iter != v.GetActivityStreamsTo().End(); iter = iter.Next() {
+			if err := addIds(iter); err != nil {
+				return err
+			}
+		}
+	}
+	if v, ok := t.(btoer); ok && v.GetActivityStreamsBto() != nil {
+		for iter := v.GetActivityStreamsBto().Begin(); iter != v.GetActivityStreamsBto().End(); iter = iter.Next() {
+			if err := addIds(iter); err != nil {
+				return err
+			}
+		}
+	}
+	if v, ok := t.(bccer); ok && v.GetActivityStreamsBcc() != nil {
+		for iter := v.GetActivityStreamsBcc().Begin(); iter != v.GetActivityStreamsBcc().End(); iter = iter.Next() {
+			if err := addIds(iter); err != nil {
+				return err
+			}
+		}
+	}
+	cc := cr.GetActivityStreamsCc()
+	if cc == nil {
+		cc = streams.NewActivityStreamsCcProperty()
+		cr.SetActivityStreamsCc(cc)
+	}
+	for iter := cc.Begin(); iter != cc.End(); iter = iter.Next() {
+		if err := addIds(iter); err != nil {
+			return err
+		}
+	}
+	for _, actor := range actors {
+		if !existing[actor.String()] {
+			existing[actor.String()] = true
+			cc.AppendIRI(actor)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,387 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testTaggerConfig resolves mentions of dakota@other.example.com, and links
+// hashtags to example.com.
+func testTaggerConfig(calls *int) TaggerConfig {
+	return TaggerConfig{
+		Resolve: func(c context.Context, user, host string) (*url.URL, error) {
+			*calls++
+			if strings.EqualFold(user+"@"+host, "dakota@other.example.com") {
+				return mustParse(testFederatedActorIRI), nil
+			}
+			return nil, nil
+		},
+		HashtagIRI: func(tag string) *url.URL {
+			return mustParse("https://example.com/tags/" + strings.ToLower(tag))
+		},
+		Render: func(tag vocab.Type, text string, href *url.URL) string {
+			return `<a href="` + href.String() + `">` + text + `</a>`
+		},
+	}
+}
+
+// resolveTestHosts makes the hosts mentioned in tests resolve to a public
+// address, and IP addresses to themselves. It returns a function restoring the
+// resolver.
+func resolveTestHosts() func() {
+	l := lookupIPAddr
+	lookupIPAddr = func(c context.Context, host string) ([]net.IPAddr, error) {
+		if ip := net.ParseIP(host); ip != nil {
+			return []net.IPAddr{{IP: ip}}, nil
+		}
+		return []net.IPAddr{{IP: net.ParseIP("192.0.2.1")}}, nil
+	}
+	return func() { lookupIPAddr = l }
+}
+
+// testTaggedCreate wraps a Note with the content in a Create.
+func testTaggedCreate(content vocab.ActivityStreamsContentProperty) (vocab.ActivityStreamsCreate, vocab.ActivityStreamsNote) {
+	note := streams.NewActivityStreamsNote()
+	note.SetActivityStreamsContent(content)
+	create := streams.NewActivityStreamsCreate()
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendActivityStreamsNote(note)
+	create.SetActivityStreamsObject(op)
+	return create, note
+}
+
+// testTagNames returns the 'name' of each tag.
+func testTagNames(t vocab.ActivityStreamsTagProperty) (names []string) {
+	if t == nil {
+		return nil
+	}
+	for iter := t.Begin(); iter != t.End(); iter = iter.Next() {
+		n := iter.GetType().(namer).GetActivityStreamsName()
+		names = append(names, n.At(0).GetXMLSchemaString())
+	}
+	return
+}
+
+// testIRIs returns the IRIs of an addressing property.
+func testIRIs(cc vocab.ActivityStreamsCcProperty) (iris []string) {
+	if cc == nil {
+		return nil
+	}
+	for iter := cc.Begin(); iter != cc.End(); iter = iter.Next() {
+		iris = append(iris, iter.GetIRI().String())
+	}
+	return
+}
+
+// TestTagger tests linking mentions and hashtags.
+func TestTagger(t *testing.T) {
+	defer resolveTestHosts()()
+	ctx := context.Background()
+	t.Run("LinksMentionsAndHashtags", func(t *testing.T) {
+		// Setup
+		var calls int
+		tg := NewTagger(testTaggerConfig(&calls))
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString("<p>Hi @dakota@other.example.com, @Dakota@other.example.com! #Cats #100 #cats</p>")
+		create, note := testTaggedCreate(content)
+		// Run the test
+		err := tg.Tag(ctx, create)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, calls, 1)
+		assertEqual(t, note.GetActivityStreamsContent().At(0).GetXMLSchemaString(),
+			`<p>Hi <a href="https://other.example.com/dakota">@dakota@other.example.com</a>, `+
+				`<a href="https://other.example.com/dakota">@Dakota@other.example.com</a>! `+
+				`<a href="https://example.com/tags/cats">#Cats</a> #100 <a href="https://example.com/tags/cats">#cats</a></p>`)
+		assertEqual(t, reflect.DeepEqual(testTagNames(note.GetActivityStreamsTag()), []string{"@dakota@other.example.com", "#Cats"}), true)
+		assertEqual(t, streams.IsOrExtendsActivityStreamsMention(note.GetActivityStreamsTag().At(0).GetType()), true)
+		assertEqual(t, streams.IsOrExtendsActivityStreamsHashtag(note.GetActivityStreamsTag().At(1).GetType()), true)
+		assertEqual(t, reflect.DeepEqual(testIRIs(note.GetActivityStreamsCc()), []string{testFederatedActorIRI}), true)
+		assertEqual(t, reflect.DeepEqual(testIRIs(create.GetActivityStreamsCc()), []string{testFederatedActorIRI}), true)
+	})
+	t.Run("LinksEveryLanguage", func(t *testing.T) {
+		// Setup
+		var calls int
+		tg := NewTagger(testTaggerConfig(&calls))
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendRDFLangString(map[string]string{
+			"en": "hello @dakota@other.example.com",
+			"fr": "bonjour @dakota@other.example.com",
+		})
+		create, note := testTaggedCreate(content)
+		// Run the test
+		err := tg.Tag(ctx, create)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, calls, 1)
+		expected := map[string]string{
+			"en": `hello <a href="https://other.example.com/dakota">@dakota@other.example.com</a>`,
+			"fr": `bonjour <a href="https://other.example.com/dakota">@dakota@other.example.com</a>`,
+		}
+		assertEqual(t, reflect.DeepEqual(note.GetActivityStreamsContent().At(0).GetRDFLangString(), expected), true)
+		assertEqual(t, note.GetActivityStreamsTag().Len(), 1)
+	})
+	t.Run("IgnoresLinksEmailsAndUnresolved", func(t *testing.T) {
+		// Setup
+		var calls int
+		tg := NewTagger(testTaggerConfig(&calls))
+		in := `<a href="https://example.com/#top">@dakota@other.example.com #top</a> mail bob@dakota@other.example.com or @nobody@example.com &#35;x`
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString(in)
+		create, note := testTaggedCreate(content)
+		// Run the test
+		err := tg.Tag(ctx, create)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, calls, 1)
+		assertEqual(t, note.GetActivityStreamsContent().At(0).GetXMLSchemaString(), in)
+		assertEqual(t, note.GetActivityStreamsTag() == nil, true)
+		assertEqual(t, create.GetActivityStreamsCc() == nil, true)
+	})
+	t.Run("DoesNotReaddressRecipients", func(t *testing.T) {
+		// Setup
+		var calls int
+		tg := NewTagger(testTaggerConfig(&calls))
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString("@dakota@other.example.com")
+		create, note := testTaggedCreate(content)
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testFederatedActorIRI))
+		note.SetActivityStreamsTo(to)
+		create.SetActivityStreamsTo(to)
+		// Run the test
+		err := tg.Tag(ctx, create)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, note.GetActivityStreamsCc().Len(), 0)
+		assertEqual(t, create.GetActivityStreamsCc().Len(), 0)
+	})
+	t.Run("ResolveErrorLeavesMention", func(t *testing.T) {
+		// Setup
+		expectErr := errors.New("expected")
+		var failed []string
+		var calls int
+		cfg := testTaggerConfig(&calls)
+		resolve := cfg.Resolve
+		cfg.Resolve = func(c context.Context, user, host string) (*url.URL, error) {
+			if host == "down.example.com" {
+				return nil, expectErr
+			}
+			return resolve(c, user, host)
+		}
+		cfg.ResolveFailed = func(c context.Context, user, host string, err error) {
+			assertEqual(t, err, expectErr)
+			failed = append(failed, user+"@"+host)
+		}
+		tg := NewTagger(cfg)
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString("@lee@down.example.com and @dakota@other.example.com")
+		create, note := testTaggedCreate(content)
+		// Run the test
+		err := tg.Tag(ctx, create)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, reflect.DeepEqual(failed, []string{"lee@down.example.com"}), true)
+		assertEqual(t, note.GetActivityStreamsContent().At(0).GetXMLSchemaString(),
+			`@lee@down.example.com and <a href="`+testFederatedActorIRI+`">@dakota@other.example.com</a>`)
+		assertEqual(t, reflect.DeepEqual(testIRIs(create.GetActivityStreamsCc()), []string{testFederatedActorIRI}), true)
+	})
+	t.Run("DoesNotResolvePrivateHosts", func(t *testing.T) {
+		// Setup
+		var failed []string
+		var calls int
+		cfg := testTaggerConfig(&calls)
+		cfg.ResolveFailed = func(c context.Context, user, host string, err error) {
+			failed = append(failed, user+"@"+host)
+		}
+		tg := NewTagger(cfg)
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString("@x@169.254.169.254 and @x@10.0.0.5:8080")
+		create, note := testTaggedCreate(content)
+		// Run the test
+		err := tg.Tag(ctx, create)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, calls, 0)
+		assertEqual(t, reflect.DeepEqual(failed, []string{"x@169.254.169.254", "x@10.0.0.5:8080"}), true)
+		assertEqual(t, note.GetActivityStreamsContent().At(0).GetXMLSchemaString(), "@x@169.254.169.254 and @x@10.0.0.5:8080")
+	})
+}
+
+// TestBaseActorTagger tests that an Actor built with WithTagger stores and
+// delivers the tagged activities.
+func TestBaseActorTagger(t *testing.T) {
+	// Set up test case
+	setupData()
+	defer resolveTestHosts()()
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller, cfg TaggerConfig) (delegate *MockDelegateActor, a FederatingActor) {
+		delegate = NewMockDelegateActor(ctl)
+		a = NewCustomActor(
+			delegate,
+			/*enableSocialProtocol=*/ true,
+			/*enableFederatedProtocol=*/ true,
+			NewMockClock(ctl),
+			WithTagger(NewTagger(cfg)))
+		return
+	}
+	newCreate := func() vocab.ActivityStreamsCreate {
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString("hi @dakota@other.example.com")
+		create, _ := testTaggedCreate(content)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testPersonIRI))
+		create.SetActivityStreamsActor(actor)
+		return create
+	}
+	// verify checks the content, tags, and addressing of a tagged Create.
+	verify := func(t *testing.T, activity Activity) {
+		note := activity.GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+		assertEqual(t, note.GetActivityStreamsContent().At(0).GetXMLSchemaString(),
+			`hi <a href="`+testFederatedActorIRI+`">@dakota@other.example.com</a>`)
+		assertEqual(t, reflect.DeepEqual(testTagNames(note.GetActivityStreamsTag()), []string{"@dakota@other.example.com"}), true)
+		assertEqual(t, reflect.DeepEqual(testIRIs(note.GetActivityStreamsCc()), []string{testFederatedActorIRI}), true)
+		assertEqual(t, reflect.DeepEqual(testIRIs(activity.(vocab.ActivityStreamsCreate).GetActivityStreamsCc()), []string{testFederatedActorIRI}), true)
+	}
+	// Run tests
+	t.Run("PostOutboxStoresAndDeliversTagged", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		var calls int
+		delegate, a := setupFn(ctl, testTaggerConfig(&calls))
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostOutboxRequest(newCreate()))
+		var stored, delivered Activity
+		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostOutboxRequestBodyHook(ctx, req, gomock.Any()).Return(ctx, nil)
+		delegate.EXPECT().AddNewIds(ctx, gomock.Any()).DoAndReturn(func(c context.Context, activity Activity) error {
+			withNewId(activity)
+			return nil
+		})
+		delegate.EXPECT().PostOutbox(ctx, gomock.Any(), mustParse(testMyOutboxIRI), gomock.Any()).DoAndReturn(func(c context.Context, activity Activity, outbox *url.URL, m map[string]interface{}) (bool, error) {
+			stored = activity
+			return true, nil
+		})
+		delegate.EXPECT().Deliver(ctx, mustParse(testMyOutboxIRI), gomock.Any()).DoAndReturn(func(c context.Context, outbox *url.URL, activity Activity) error {
+			delivered = activity
+			return nil
+		})
+		// Run the test
+		handled, err := a.PostOutbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusCreated)
+		verify(t, stored)
+		verify(t, delivered)
+	})
+	t.Run("SendReturnsTagged", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		var calls int
+		delegate, a := setupFn(ctl, testTaggerConfig(&calls))
+		var stored, delivered Activity
+		delegate.EXPECT().AddNewIds(ctx, gomock.Any()).Return(nil)
+		delegate.EXPECT().PostOutbox(ctx, gomock.Any(), mustParse(testMyOutboxIRI), gomock.Any()).DoAndReturn(func(c context.Context, activity Activity, outbox *url.URL, m map[string]interface{}) (bool, error) {
+			stored = activity
+			return true, nil
+		})
+		delegate.EXPECT().Deliver(ctx, mustParse(testMyOutboxIRI), gomock.Any()).DoAndReturn(func(c context.Context, outbox *url.URL, activity Activity) error {
+			delivered = activity
+			return nil
+		})
+		// Run the test
+		sent, err := a.Send(ctx, mustParse(testMyOutboxIRI), newCreate())
+		// Verify results
+		assertEqual(t, err, nil)
+		verify(t, sent)
+		verify(t, stored)
+		verify(t, delivered)
+	})
+	t.Run("PostOutboxDespiteResolveError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, TaggerConfig{
+			Resolve: func(c context.Context, user, host string) (*url.URL, error) {
+				return nil, errors.New("unreachable")
+			},
+		})
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostOutboxRequest(newCreate()))
+		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostOutboxRequestBodyHook(ctx, req, gomock.Any()).Return(ctx, nil)
+		delegate.EXPECT().AddNewIds(ctx, gomock.Any()).DoAndReturn(func(c context.Context, activity Activity) error {
+			withNewId(activity)
+			return nil
+		})
+		delegate.EXPECT().PostOutbox(ctx, gomock.Any(), mustParse(testMyOutboxIRI), gomock.Any()).Return(true, nil)
+		delegate.EXPECT().Deliver(ctx, mustParse(testMyOutboxIRI), gomock.Any()).Return(nil)
+		// Run the test
+		handled, err := a.PostOutbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusCreated)
+	})
+}
+
+// TestDefaultLinkRenderer tests the default rendering of links.
+func TestDefaultLinkRenderer(t *testing.T) {
+	// Setup
+	href := mustParse("https://example.com/tags/cats")
+	// Run the test
+	mention := DefaultLinkRenderer(streams.NewActivityStreamsMention(), "@dakota@other.example.com", mustParse(testFederatedActorIRI))
+	hashtag := DefaultLinkRenderer(streams.NewActivityStreamsHashtag(), "#cats", href)
+	// Verify results
+	assertEqual(t, mention, `<span class="h-card"><a href="https://other.example.com/dakota" class="u-url mention">@<span>dakota</span></a></span>`)
+	assertEqual(t, hashtag, `<a href="https://example.com/tags/cats" class="mention hashtag" rel="tag">#<span>cats</span></a>`)
+}
+
+// TestWebFingerResolver tests resolving mentions with WebFinger.
+func TestWebFingerResolver(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	var resource, accept string
+	s := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/webfinger" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		resource = r.URL.Query().Get("resource")
+		accept = r.Header.Get(acceptHeader)
+		if !strings.HasPrefix(resource, "acct:dakota@") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set(contentTypeHeader, jrdMediaType)
+		w.Write([]byte(`{"subject":"` + resource + `","links":[` +
+			`{"rel":"http://webfinger.net/rel/profile-page","type":"text/html","href":"https://other.example.com/@dakota"},` +
+			`{"rel":"self","type":"application/activity+json","href":"` + testFederatedActorIRI + `"}]}`))
+	}))
+	defer s.Close()
+	host := mustParse(s.URL).Host
+	resolve := NewWebFingerResolver(s.Client(), "myApp")
+	// Run the test
+	iri, err := resolve(ctx, "dakota", host)
+	missing, missingErr := resolve(ctx, "nobody", host)
+	// Verify results
+	assertEqual(t, err, nil)
+	assertEqual(t, iri.String(), testFederatedActorIRI)
+	assertEqual(t, resource, "acct:nobody@"+host)
+	assertEqual(t, accept, jrdMediaType)
+	assertEqual(t, missingErr, nil)
+	assertEqual(t, missing == nil, true)
+}
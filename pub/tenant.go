@@ -0,0 +1,526 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/streams/vocab"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrNoTenant indicates the context of a call does not contain the Tenant it
+// is for. Requests must be passed through Tenants' Middleware, and other
+// calls must use a context returned by WithTenant.
+var ErrNoTenant = errors.New("no tenant in context")
+
+// Tenant is a single hostname served by an application hosting many, along
+// with the behaviors specific to it.
+//
+// Each Tenant has its own key material and instance actor, which are used by
+// its CommonBehavior's NewTransport and NewInstanceTransport, its own
+// Database, and its own policies such as blocks in its FederatingProtocol.
+type Tenant struct {
+	// Host is the hostname of the Tenant, optionally with a port. It is
+	// compared to the Host header of requests without regard to case.
+	Host string
+	// Common is the CommonBehavior of the Tenant.
+	Common CommonBehavior
+	// Federating is the FederatingProtocol of the Tenant. It is only
+	// required if the Actor handles the Federating Protocol.
+	Federating FederatingProtocol
+	// Social is the SocialProtocol of the Tenant. It is only required if
+	// the Actor handles the Social Protocol.
+	Social SocialProtocol
+	// Database contains the data of the Tenant, and nothing else.
+	Database Database
+}
+
+// tenantKey is the context key of the Tenant of a call.
+type tenantKey struct{}
+
+// WithTenant returns a context for calls on behalf of the Tenant, such as
+// sending an activity with FederatingActor's Send.
+func WithTenant(c context.Context, t *Tenant) context.Context {
+	return context.WithValue(c, tenantKey{}, t)
+}
+
+// TenantFromContext returns the Tenant a call is for.
+func TenantFromContext(c context.Context) (*Tenant, bool) {
+	t, ok := c.Value(tenantKey{}).(*Tenant)
+	return t, ok && t != nil
+}
+
+// Tenants is a set of Tenants served by a single Actor and handler stack.
+//
+// Its Middleware chooses the Tenant of each request from the Host header, and
+// carries it through the request's context. Its CommonBehavior,
+// FederatingProtocol, SocialProtocol, and Database dispatch each call to the
+// Tenant in the context. Build the Actor with them:
+//
+//	tenants, err := pub.NewTenants(exampleCom, exampleOrg)
+//	actor := pub.NewActor(
+//		tenants.CommonBehavior(),
+//		tenants.SocialProtocol(),
+//		tenants.FederatingProtocol(),
+//		tenants.Database(),
+//		clock)
+//	router, err := pub.NewRouter(actor, tenants.Database(), clock, cfg)
+//	server.Handler = tenants.Middleware(router)
+//
+// Calls without a Tenant in their context fail with ErrNoTenant.
+type Tenants struct {
+	hosts map[string]*Tenant
+}
+
+// NewTenants creates a set of Tenants. It returns an error if a host is
+// empty or repeated.
+func NewTenants(tenants ...*Tenant) (*Tenants, error) {
+	t := &Tenants{
+		hosts: make(map[string]*Tenant, len(tenants)),
+	}
+	for _, tenant := range tenants {
+		host := strings.ToLower(tenant.Host)
+		if len(host) == 0 {
+			return nil, fmt.Errorf("tenant has no host")
+		} else if _, ok := t.hosts[host]; ok {
+			return nil, fmt.Errorf("tenant host %q is repeated", tenant.Host)
+		}
+		t.hosts[host] = tenant
+	}
+	return t, nil
+}
+
+// ForHost returns the Tenant serving the host, which may contain a port. A
+// Tenant whose Host has no port serves every port.
+func (t *Tenants) ForHost(host string) (*Tenant, bool) {
+	host = strings.ToLower(host)
+	if tenant, ok := t.hosts[host]; ok {
+		return tenant, true
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		tenant, ok := t.hosts[h]
+		return tenant, ok
+	}
+	return nil, false
+}
+
+// Middleware adds the Tenant of the request's Host header to the request's
+// context before passing it to the next handler. Requests for unknown hosts
+// are responded to with a 404 Not Found status.
+func (t *Tenants) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant, ok := t.ForHost(r.Host)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(WithTenant(r.Context(), tenant)))
+	})
+}
+
+// CommonBehavior returns a CommonBehavior dispatching to the Tenant in the
+// context.
+func (t *Tenants) CommonBehavior() CommonBehavior {
+	return tenantCommonBehavior{}
+}
+
+// FederatingProtocol returns a FederatingProtocol dispatching to the Tenant in
+// the context.
+func (t *Tenants) FederatingProtocol() FederatingProtocol {
+	return tenantFederatingProtocol{}
+}
+
+// SocialProtocol returns a SocialProtocol dispatching to the Tenant in the
+// context.
+func (t *Tenants) SocialProtocol() SocialProtocol {
+	return tenantSocialProtocol{}
+}
+
+// Database returns a Database dispatching to the Tenant in the context.
+func (t *Tenants) Database() Database {
+	return tenantDatabase{}
+}
+
+// tenantOf obtains the Tenant in the context.
+func tenantOf(c context.Context) (*Tenant, error) {
+	t, ok := TenantFromContext(c)
+	if !ok {
+		return nil, ErrNoTenant
+	}
+	return t, nil
+}
+
+// tenantCommonBehavior must satisfy the CommonBehavior interface.
+var _ CommonBehavior = tenantCommonBehavior{}
+
+// tenantCommonBehavior is a CommonBehavior dispatching to the Tenant in the
+// context.
+type tenantCommonBehavior struct{}
+
+// AuthenticateGetInbox defers to the CommonBehavior of the Tenant.
+func (tenantCommonBehavior) AuthenticateGetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return c, false, err
+	}
+	return t.Common.AuthenticateGetInbox(c, w, r)
+}
+
+// AuthenticateGetOutbox defers to the CommonBehavior of the Tenant.
+func (tenantCommonBehavior) AuthenticateGetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return c, false, err
+	}
+	return t.Common.AuthenticateGetOutbox(c, w, r)
+}
+
+// GetOutbox defers to the CommonBehavior of the Tenant.
+func (tenantCommonBehavior) GetOutbox(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Common.GetOutbox(c, r)
+}
+
+// NewTransport defers to the CommonBehavior of the Tenant.
+func (tenantCommonBehavior) NewTransport(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Common.NewTransport(c, actorBoxIRI, gofedAgent)
+}
+
+// NewInstanceTransport defers to the CommonBehavior of the Tenant.
+func (tenantCommonBehavior) NewInstanceTransport(c context.Context, gofedAgent string) (Transport, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Common.NewInstanceTransport(c, gofedAgent)
+}
+
+// tenantFederatingProtocol must satisfy the FederatingProtocol interface.
+var _ FederatingProtocol = tenantFederatingProtocol{}
+
+// tenantFederatingProtocol is a FederatingProtocol dispatching to the Tenant
+// in the context.
+type tenantFederatingProtocol struct{}
+
+// PostInboxRequestBodyHook defers to the FederatingProtocol of the Tenant.
+func (tenantFederatingProtocol) PostInboxRequestBodyHook(c context.Context, r *http.Request, activity Activity) (context.Context, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return c, err
+	}
+	return t.Federating.PostInboxRequestBodyHook(c, r, activity)
+}
+
+// AuthenticatePostInbox defers to the FederatingProtocol of the Tenant.
+func (tenantFederatingProtocol) AuthenticatePostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return c, false, err
+	}
+	return t.Federating.AuthenticatePostInbox(c, w, r)
+}
+
+// Blocked defers to the FederatingProtocol of the Tenant.
+// Calls without a Tenant are treated as blocked.
+func (tenantFederatingProtocol) Blocked(c context.Context, actorIRIs []*url.URL) (bool, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return true, err
+	}
+	return t.Federating.Blocked(c, actorIRIs)
+}
+
+// Callbacks defers to the FederatingProtocol of the Tenant.
+func (tenantFederatingProtocol) Callbacks(c context.Context) (FederatingWrappedCallbacks, []interface{}, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return FederatingWrappedCallbacks{}, nil, err
+	}
+	return t.Federating.Callbacks(c)
+}
+
+// DefaultCallback defers to the FederatingProtocol of the Tenant.
+func (tenantFederatingProtocol) DefaultCallback(c context.Context, activity Activity) error {
+	t, err := tenantOf(c)
+	if err != nil {
+		return err
+	}
+	return t.Federating.DefaultCallback(c, activity)
+}
+
+// MaxInboxForwardingRecursionDepth returns the Tenant's depth, or the most
+// restrictive one if there is no Tenant, as zero means unlimited.
+func (tenantFederatingProtocol) MaxInboxForwardingRecursionDepth(c context.Context) int {
+	t, err := tenantOf(c)
+	if err != nil {
+		return 1
+	}
+	return t.Federating.MaxInboxForwardingRecursionDepth(c)
+}
+
+// MaxDeliveryRecursionDepth returns the Tenant's depth, or the most
+// restrictive one if there is no Tenant, as zero means unlimited.
+func (tenantFederatingProtocol) MaxDeliveryRecursionDepth(c context.Context) int {
+	t, err := tenantOf(c)
+	if err != nil {
+		return 1
+	}
+	return t.Federating.MaxDeliveryRecursionDepth(c)
+}
+
+// FilterForwarding defers to the FederatingProtocol of the Tenant.
+func (tenantFederatingProtocol) FilterForwarding(c context.Context, potentialRecipients []*url.URL, a Activity) ([]*url.URL, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Federating.FilterForwarding(c, potentialRecipients, a)
+}
+
+// GetInbox defers to the FederatingProtocol of the Tenant.
+func (tenantFederatingProtocol) GetInbox(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Federating.GetInbox(c, r)
+}
+
+// tenantSocialProtocol must satisfy the SocialProtocol interface.
+var _ SocialProtocol = tenantSocialProtocol{}
+
+// tenantSocialProtocol is a SocialProtocol dispatching to the Tenant in the
+// context.
+type tenantSocialProtocol struct{}
+
+// PostOutboxRequestBodyHook defers to the SocialProtocol of the Tenant.
+func (tenantSocialProtocol) PostOutboxRequestBodyHook(c context.Context, r *http.Request, data vocab.Type) (context.Context, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return c, err
+	}
+	return t.Social.PostOutboxRequestBodyHook(c, r, data)
+}
+
+// AuthenticatePostOutbox defers to the SocialProtocol of the Tenant.
+func (tenantSocialProtocol) AuthenticatePostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return c, false, err
+	}
+	return t.Social.AuthenticatePostOutbox(c, w, r)
+}
+
+// Callbacks defers to the SocialProtocol of the Tenant.
+func (tenantSocialProtocol) Callbacks(c context.Context) (SocialWrappedCallbacks, []interface{}, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return SocialWrappedCallbacks{}, nil, err
+	}
+	return t.Social.Callbacks(c)
+}
+
+// DefaultCallback defers to the SocialProtocol of the Tenant.
+func (tenantSocialProtocol) DefaultCallback(c context.Context, activity Activity) error {
+	t, err := tenantOf(c)
+	if err != nil {
+		return err
+	}
+	return t.Social.DefaultCallback(c, activity)
+}
+
+// tenantDatabase must satisfy the Database interface.
+var _ Database = tenantDatabase{}
+
+// tenantDatabase is a Database dispatching to the Tenant in the context.
+type tenantDatabase struct{}
+
+// Lock defers to the Database of the Tenant.
+func (tenantDatabase) Lock(c context.Context, id *url.URL) error {
+	t, err := tenantOf(c)
+	if err != nil {
+		return err
+	}
+	return t.Database.Lock(c, id)
+}
+
+// Unlock defers to the Database of the Tenant.
+func (tenantDatabase) Unlock(c context.Context, id *url.URL) error {
+	t, err := tenantOf(c)
+	if err != nil {
+		return err
+	}
+	return t.Database.Unlock(c, id)
+}
+
+// InboxContains defers to the Database of the Tenant.
+func (tenantDatabase) InboxContains(c context.Context, inbox, id *url.URL) (bool, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return false, err
+	}
+	return t.Database.InboxContains(c, inbox, id)
+}
+
+// GetInbox defers to the Database of the Tenant.
+func (tenantDatabase) GetInbox(c context.Context, inboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Database.GetInbox(c, inboxIRI)
+}
+
+// SetInbox defers to the Database of the Tenant.
+func (tenantDatabase) SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	t, err := tenantOf(c)
+	if err != nil {
+		return err
+	}
+	return t.Database.SetInbox(c, inbox)
+}
+
+// Owns defers to the Database of the Tenant.
+func (tenantDatabase) Owns(c context.Context, id *url.URL) (bool, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return false, err
+	}
+	return t.Database.Owns(c, id)
+}
+
+// ActorForOutbox defers to the Database of the Tenant.
+func (tenantDatabase) ActorForOutbox(c context.Context, outboxIRI *url.URL) (*url.URL, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Database.ActorForOutbox(c, outboxIRI)
+}
+
+// ActorForInbox defers to the Database of the Tenant.
+func (tenantDatabase) ActorForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Database.ActorForInbox(c, inboxIRI)
+}
+
+// OutboxForInbox defers to the Database of the Tenant.
+func (tenantDatabase) OutboxForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Database.OutboxForInbox(c, inboxIRI)
+}
+
+// Exists defers to the Database of the Tenant.
+func (tenantDatabase) Exists(c context.Context, id *url.URL) (bool, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return false, err
+	}
+	return t.Database.Exists(c, id)
+}
+
+// Get defers to the Database of the Tenant.
+func (tenantDatabase) Get(c context.Context, id *url.URL) (vocab.Type, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Database.Get(c, id)
+}
+
+// Create defers to the Database of the Tenant.
+func (tenantDatabase) Create(c context.Context, asType vocab.Type) error {
+	t, err := tenantOf(c)
+	if err != nil {
+		return err
+	}
+	return t.Database.Create(c, asType)
+}
+
+// Update defers to the Database of the Tenant.
+func (tenantDatabase) Update(c context.Context, asType vocab.Type) error {
+	t, err := tenantOf(c)
+	if err != nil {
+		return err
+	}
+	return t.Database.Update(c, asType)
+}
+
+// Delete defers to the Database of the Tenant.
+func (tenantDatabase) Delete(c context.Context, id *url.URL) error {
+	t, err := tenantOf(c)
+	if err != nil {
+		return err
+	}
+	return t.Database.Delete(c, id)
+}
+
+// GetOutbox defers to the Database of the Tenant.
+func (tenantDatabase) GetOutbox(c context.Context, outboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Database.GetOutbox(c, outboxIRI)
+}
+
+// SetOutbox defers to the Database of the Tenant.
+func (tenantDatabase) SetOutbox(c context.Context, outbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	t, err := tenantOf(c)
+	if err != nil {
+		return err
+	}
+	return t.Database.SetOutbox(c, outbox)
+}
+
+// NewId defers to the Database of the Tenant.
+func (tenantDatabase) NewId(c context.Context, v vocab.Type) (*url.URL, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Database.NewId(c, v)
+}
+
+// Followers defers to the Database of the Tenant.
+func (tenantDatabase) Followers(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Database.Followers(c, actorIRI)
+}
+
+// Following defers to the Database of the Tenant.
+func (tenantDatabase) Following(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Database.Following(c, actorIRI)
+}
+
+// Liked defers to the Database of the Tenant.
+func (tenantDatabase) Liked(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	t, err := tenantOf(c)
+	if err != nil {
+		return nil, err
+	}
+	return t.Database.Liked(c, actorIRI)
+}
@@ -0,0 +1,185 @@
+package pub
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestNewTenants tests validating the hosts of Tenants.
+func TestNewTenants(t *testing.T) {
+	tests := []struct {
+		name  string
+		hosts []string
+		valid bool
+	}{
+		{"None", nil, true},
+		{"Distinct", []string{"example.com", "example.org"}, true},
+		{"Empty", []string{""}, false},
+		{"Repeated", []string{"example.com", "Example.com"}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var tenants []*Tenant
+			for _, h := range test.hosts {
+				tenants = append(tenants, &Tenant{Host: h})
+			}
+			_, err := NewTenants(tenants...)
+			assertEqual(t, err == nil, test.valid)
+		})
+	}
+}
+
+// TestTenantsForHost tests choosing the Tenant of a host.
+func TestTenantsForHost(t *testing.T) {
+	com := &Tenant{Host: "example.com"}
+	org := &Tenant{Host: "example.org:8080"}
+	ts, err := NewTenants(com, org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		host     string
+		expected *Tenant
+	}{
+		{"Exact", "example.com", com},
+		{"Case", "EXAMPLE.com", com},
+		{"Any Port", "example.com:8443", com},
+		{"Exact Port", "example.org:8080", org},
+		{"Other Port", "example.org:8443", nil},
+		{"Unknown", "example.net", nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tenant, ok := ts.ForHost(test.host)
+			assertEqual(t, ok, test.expected != nil)
+			assertEqual(t, tenant, test.expected)
+		})
+	}
+}
+
+// TestTenants tests serving several hosts with one set of behaviors.
+func TestTenants(t *testing.T) {
+	// Set up test case
+	setupData()
+	setupFn := func(ctl *gomock.Controller) (comDB, orgDB *MockDatabase, ts *Tenants) {
+		comDB = NewMockDatabase(ctl)
+		orgDB = NewMockDatabase(ctl)
+		var err error
+		ts, err = NewTenants(
+			&Tenant{Host: "example.com", Database: comDB},
+			&Tenant{Host: "example.org", Database: orgDB})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	// Run tests
+	t.Run("MiddlewareAddsTenant", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, ts := setupFn(ctl)
+		var host string
+		h := ts.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenant, ok := TenantFromContext(r.Context())
+			assertEqual(t, ok, true)
+			host = tenant.Host
+		}))
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "https://example.org/users/alice", nil)
+		// Run the test
+		h.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, host, "example.org")
+	})
+	t.Run("MiddlewareRejectsUnknownHost", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, ts := setupFn(ctl)
+		called := false
+		h := ts.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "https://example.net/users/alice", nil)
+		// Run the test
+		h.ServeHTTP(resp, req)
+		// Verify results
+		assertEqual(t, resp.Code, http.StatusNotFound)
+		assertEqual(t, called, false)
+	})
+	t.Run("DispatchesToTenantDatabase", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		comDB, orgDB, ts := setupFn(ctl)
+		com, _ := ts.ForHost("example.com")
+		org, _ := ts.ForHost("example.org")
+		comC := WithTenant(context.Background(), com)
+		orgC := WithTenant(context.Background(), org)
+		comDB.EXPECT().Exists(comC, mustParse(testNoteId1)).Return(true, nil)
+		orgDB.EXPECT().Exists(orgC, mustParse(testNoteId1)).Return(false, nil)
+		db := ts.Database()
+		// Run the test
+		comExists, comErr := db.Exists(comC, mustParse(testNoteId1))
+		orgExists, orgErr := db.Exists(orgC, mustParse(testNoteId1))
+		// Verify results
+		assertEqual(t, comExists, true)
+		assertEqual(t, comErr, nil)
+		assertEqual(t, orgExists, false)
+		assertEqual(t, orgErr, nil)
+	})
+	t.Run("DispatchesToTenantBehaviors", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, ts := setupFn(ctl)
+		com, _ := ts.ForHost("example.com")
+		mockCB := NewMockCommonBehavior(ctl)
+		mockFP := NewMockFederatingProtocol(ctl)
+		mockSP := NewMockSocialProtocol(ctl)
+		mockTp := NewMockTransport(ctl)
+		com.Common = mockCB
+		com.Federating = mockFP
+		com.Social = mockSP
+		c := WithTenant(context.Background(), com)
+		mockCB.EXPECT().NewInstanceTransport(c, goFedUserAgent()).Return(mockTp, nil)
+		mockFP.EXPECT().MaxDeliveryRecursionDepth(c).Return(3)
+		mockSP.EXPECT().DefaultCallback(c, testListen).Return(nil)
+		// Run the test
+		tp, err := ts.CommonBehavior().NewInstanceTransport(c, goFedUserAgent())
+		depth := ts.FederatingProtocol().MaxDeliveryRecursionDepth(c)
+		cbErr := ts.SocialProtocol().DefaultCallback(c, testListen)
+		// Verify results
+		assertEqual(t, tp, mockTp)
+		assertEqual(t, err, nil)
+		assertEqual(t, depth, 3)
+		assertEqual(t, cbErr, nil)
+	})
+	t.Run("ErrorsWithoutTenant", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, ts := setupFn(ctl)
+		c := context.Background()
+		// Run the test
+		_, dbErr := ts.Database().Get(c, mustParse(testNoteId1))
+		_, cbErr := ts.CommonBehavior().NewInstanceTransport(c, goFedUserAgent())
+		blocked, fpErr := ts.FederatingProtocol().Blocked(c, nil)
+		depth := ts.FederatingProtocol().MaxInboxForwardingRecursionDepth(c)
+		spErr := ts.SocialProtocol().DefaultCallback(c, testListen)
+		// Verify results
+		assertEqual(t, dbErr, ErrNoTenant)
+		assertEqual(t, cbErr, ErrNoTenant)
+		assertEqual(t, fpErr, ErrNoTenant)
+		assertEqual(t, blocked, true)
+		assertEqual(t, depth, 1)
+		assertEqual(t, spErr, ErrNoTenant)
+	})
+}
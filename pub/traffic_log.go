@@ -0,0 +1,299 @@
+package pub
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// maxRecordedBodySize bounds the part of each body that is recorded.
+const maxRecordedBodySize = 1 << 20
+
+// TrafficDirection is whether traffic was received or sent by this server.
+type TrafficDirection string
+
+const (
+	// TrafficInbound is a request received by an Actor's inbox.
+	TrafficInbound TrafficDirection = "inbound"
+	// TrafficOutbound is a request sent to a peer server.
+	TrafficOutbound TrafficDirection = "outbound"
+)
+
+// TrafficRecord is a single request captured by a TrafficRecorder, along with
+// the response to it.
+//
+// Bodies are recorded as strings, so they are readable in the log. They are
+// expected to be UTF-8 encoded JSON; other bytes are not preserved faithfully.
+// Only the first MiB of each body is recorded.
+type TrafficRecord struct {
+	// Time is when the request was received or sent, according to the
+	// recorder's Clock.
+	Time time.Time `json:"time"`
+	// Direction is whether the request was received or sent.
+	Direction TrafficDirection `json:"direction"`
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+	// URL is the absolute URL of the request.
+	URL string `json:"url"`
+	// Header contains the headers of the request.
+	Header http.Header `json:"header,omitempty"`
+	// Body is the body of the request. For inbound requests, only the part
+	// read while handling the request is recorded.
+	Body string `json:"body,omitempty"`
+	// BodyTruncated is whether the body was longer than what is recorded.
+	BodyTruncated bool `json:"bodyTruncated,omitempty"`
+	// StatusCode is the status code of the response. It is zero if no
+	// response was obtained.
+	StatusCode int `json:"statusCode,omitempty"`
+	// ResponseHeader contains the headers of the response to an outbound
+	// request.
+	ResponseHeader http.Header `json:"responseHeader,omitempty"`
+	// ResponseBody is the body of the response to an outbound request.
+	ResponseBody string `json:"responseBody,omitempty"`
+	// ResponseBodyTruncated is whether the body of the response was longer
+	// than what is recorded.
+	ResponseBodyTruncated bool `json:"responseBodyTruncated,omitempty"`
+	// Error is the error handling the request, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// TrafficRecorder appends federation traffic to a log, so that problems with
+// the activities of peers can be reproduced offline. The log has one JSON
+// encoded TrafficRecord per line, and is read with ReadTrafficLog.
+//
+// Inbound requests are recorded by passing WithTrafficRecorder when creating
+// an Actor. Outbound requests are recorded by a RecordingClient given to the
+// Transports returned by CommonBehavior.
+//
+// The log contains full payloads and signatures of requests, so it must be
+// stored as carefully as the rest of the application's data.
+type TrafficRecorder struct {
+	clock Clock
+	// mu guards w and err.
+	mu  sync.Mutex
+	w   io.Writer
+	err error
+}
+
+// NewTrafficRecorder creates a TrafficRecorder writing to w, such as a file
+// opened for appending.
+func NewTrafficRecorder(w io.Writer, clock Clock) *TrafficRecorder {
+	return &TrafficRecorder{
+		clock: clock,
+		w:     w,
+	}
+}
+
+// Record appends a record to the log. Each record is written with a single
+// call to the underlying writer.
+func (t *TrafficRecorder) Record(r TrafficRecord) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, err = t.w.Write(b); err != nil && t.err == nil {
+		t.err = err
+	}
+	return err
+}
+
+// Err returns the first error writing to the log.
+//
+// Failing to record traffic does not fail the requests being recorded, so
+// applications should check Err periodically.
+func (t *TrafficRecorder) Err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.err
+}
+
+// recordInbound starts recording a request to an inbox. The returned
+// ResponseWriter must be used for the response, and the returned function
+// called once the request is handled.
+func (t *TrafficRecorder) recordInbound(w http.ResponseWriter, r *http.Request) (http.ResponseWriter, func(err error)) {
+	rec := TrafficRecord{
+		Time:      t.clock.Now(),
+		Direction: TrafficInbound,
+		Method:    r.Method,
+		URL:       requestId(r).String(),
+		Header:    cloneHeader(r.Header),
+	}
+	body := &cappedBuffer{}
+	if r.Body != nil {
+		r.Body = &teeBody{
+			ReadCloser: r.Body,
+			tee:        io.TeeReader(r.Body, body),
+		}
+	}
+	sw := &statusWriter{ResponseWriter: w}
+	return sw, func(err error) {
+		rec.Body = body.String()
+		rec.BodyTruncated = body.truncated
+		rec.StatusCode = sw.code
+		if err != nil {
+			rec.Error = err.Error()
+		}
+		t.Record(rec)
+	}
+}
+
+// RecordingClient must satisfy the HttpClient interface.
+var _ HttpClient = &RecordingClient{}
+
+// RecordingClient is an HttpClient that records every request and response
+// with a TrafficRecorder.
+//
+// To apply to all traffic initiated by the library, a single RecordingClient
+// must be shared by every Transport returned by CommonBehavior's NewTransport
+// and NewInstanceTransport.
+type RecordingClient struct {
+	client   HttpClient
+	recorder *TrafficRecorder
+}
+
+// NewRecordingClient wraps an HttpClient, such as the standard library's
+// http.Client, to record its traffic.
+func NewRecordingClient(client HttpClient, recorder *TrafficRecorder) *RecordingClient {
+	return &RecordingClient{
+		client:   client,
+		recorder: recorder,
+	}
+}
+
+// Do sends the request and records it along with the response.
+func (r *RecordingClient) Do(req *http.Request) (*http.Response, error) {
+	rec := TrafficRecord{
+		Time:      r.recorder.clock.Now(),
+		Direction: TrafficOutbound,
+		Method:    req.Method,
+		URL:       req.URL.String(),
+		Header:    cloneHeader(req.Header),
+	}
+	if req.Body != nil {
+		var err error
+		if rec.Body, rec.BodyTruncated, req.Body, err = recordBody(req.Body); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := r.client.Do(req)
+	if err == nil {
+		rec.StatusCode = resp.StatusCode
+		rec.ResponseHeader = cloneHeader(resp.Header)
+		rec.ResponseBody, rec.ResponseBodyTruncated, resp.Body, err = recordBody(resp.Body)
+	}
+	if err != nil {
+		rec.Error = err.Error()
+	}
+	r.recorder.Record(rec)
+	return resp, err
+}
+
+// recordBody reads the part of a body that is recorded. It returns whether
+// the body is longer, and a body replaying what was read before streaming the
+// rest of the original. The original is closed if reading it fails.
+func recordBody(body io.ReadCloser) (string, bool, io.ReadCloser, error) {
+	b, err := ioutil.ReadAll(io.LimitReader(body, maxRecordedBodySize+1))
+	if err != nil {
+		body.Close()
+		return "", false, nil, err
+	}
+	replay := &replayBody{
+		Reader: io.MultiReader(bytes.NewReader(b), body),
+		Closer: body,
+	}
+	if len(b) > maxRecordedBodySize {
+		return string(b[:maxRecordedBodySize]), true, replay, nil
+	}
+	return string(b), false, replay, nil
+}
+
+// ReadTrafficLog reads all records of a log written by a TrafficRecorder.
+func ReadTrafficLog(r io.Reader) ([]TrafficRecord, error) {
+	var records []TrafficRecord
+	dec := json.NewDecoder(r)
+	for {
+		var rec TrafficRecord
+		if err := dec.Decode(&rec); err == io.EOF {
+			return records, nil
+		} else if err != nil {
+			return records, err
+		}
+		records = append(records, rec)
+	}
+}
+
+// teeBody is a request body that copies what is read from it.
+type teeBody struct {
+	io.ReadCloser
+	tee io.Reader
+}
+
+// Read reads from the underlying body, copying into the tee.
+func (t *teeBody) Read(p []byte) (int, error) {
+	return t.tee.Read(p)
+}
+
+// replayBody is a body replaying what was read from it before the rest.
+type replayBody struct {
+	io.Reader
+	io.Closer
+}
+
+// cappedBuffer keeps what is written to it up to the recorded size of bodies.
+type cappedBuffer struct {
+	bytes.Buffer
+	truncated bool
+}
+
+// Write keeps as much of the bytes as fits, and never fails.
+func (c *cappedBuffer) Write(p []byte) (int, error) {
+	if room := maxRecordedBodySize - c.Len(); len(p) > room {
+		c.truncated = true
+		c.Buffer.Write(p[:room])
+	} else {
+		c.Buffer.Write(p)
+	}
+	return len(p), nil
+}
+
+// statusWriter is a ResponseWriter that remembers the status code written.
+type statusWriter struct {
+	http.ResponseWriter
+	code int
+}
+
+// WriteHeader remembers the status code and writes it.
+func (s *statusWriter) WriteHeader(code int) {
+	if s.code == 0 {
+		s.code = code
+	}
+	s.ResponseWriter.WriteHeader(code)
+}
+
+// Write writes the body, with an implicit OK status if no status code was
+// written.
+func (s *statusWriter) Write(b []byte) (int, error) {
+	if s.code == 0 {
+		s.code = http.StatusOK
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+// cloneHeader deeply copies headers.
+func cloneHeader(h http.Header) http.Header {
+	if h == nil {
+		return nil
+	}
+	c := make(http.Header, len(h))
+	for k, v := range h {
+		c[k] = append([]string(nil), v...)
+	}
+	return c
+}
@@ -0,0 +1,229 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestTrafficRecorder tests writing and reading back a traffic log.
+func TestTrafficRecorder(t *testing.T) {
+	// Setup
+	var buf bytes.Buffer
+	rec := NewTrafficRecorder(&buf, &fakeClock{})
+	in := []TrafficRecord{
+		{
+			Time:      time.Date(2000, 2, 3, 4, 5, 6, 0, time.UTC),
+			Direction: TrafficInbound,
+			Method:    "POST",
+			URL:       testMyInboxIRI,
+			Header:    http.Header{"Signature": []string{"sig"}},
+			Body:      `{"type":"Create"}`,
+		},
+		{
+			Time:       time.Date(2000, 2, 3, 4, 5, 7, 0, time.UTC),
+			Direction:  TrafficOutbound,
+			Method:     "GET",
+			URL:        testFederatedActorIRI,
+			StatusCode: http.StatusGone,
+			Error:      "gone",
+		},
+	}
+	// Run
+	for _, r := range in {
+		assertEqual(t, rec.Record(r), nil)
+	}
+	out, err := ReadTrafficLog(&buf)
+	// Verify
+	assertEqual(t, err, nil)
+	assertEqual(t, len(out), len(in))
+	for i := range in {
+		assertEqual(t, out[i].Time.Equal(in[i].Time), true)
+		out[i].Time = in[i].Time
+		assertEqual(t, reflect.DeepEqual(out[i], in[i]), true)
+	}
+	assertEqual(t, rec.Err(), nil)
+}
+
+// TestRecordingClient tests recording outbound requests without disturbing
+// them.
+func TestRecordingClient(t *testing.T) {
+	// Setup
+	now := time.Date(2000, 2, 3, 4, 5, 6, 0, time.UTC)
+	var buf bytes.Buffer
+	var sentBody string
+	inner := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		b, err := ioutil.ReadAll(req.Body)
+		sentBody = string(b)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/activity+json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"type":"Person"}`)),
+		}, err
+	})
+	client := NewRecordingClient(inner, NewTrafficRecorder(&buf, &fakeClock{now: now}))
+	req := httptest.NewRequest("POST", testFederatedActorIRI, strings.NewReader(`{"type":"Follow"}`))
+	// Run
+	resp, err := client.Do(req)
+	// Verify
+	assertEqual(t, err, nil)
+	b, err := ioutil.ReadAll(resp.Body)
+	assertEqual(t, err, nil)
+	assertEqual(t, string(b), `{"type":"Person"}`)
+	assertEqual(t, sentBody, `{"type":"Follow"}`)
+	out, err := ReadTrafficLog(&buf)
+	assertEqual(t, err, nil)
+	assertEqual(t, len(out), 1)
+	assertEqual(t, out[0].Time.Equal(now), true)
+	assertEqual(t, out[0].Direction, TrafficOutbound)
+	assertEqual(t, out[0].Method, "POST")
+	assertEqual(t, out[0].URL, testFederatedActorIRI)
+	assertEqual(t, out[0].Body, `{"type":"Follow"}`)
+	assertEqual(t, out[0].StatusCode, http.StatusOK)
+	assertEqual(t, out[0].ResponseHeader.Get("Content-Type"), "application/activity+json")
+	assertEqual(t, out[0].ResponseBody, `{"type":"Person"}`)
+	assertEqual(t, out[0].BodyTruncated, false)
+	assertEqual(t, out[0].ResponseBodyTruncated, false)
+}
+
+// TestRecordingClientTruncatesBodies tests recording only the start of large
+// bodies, while still sending and returning them in full.
+func TestRecordingClientTruncatesBodies(t *testing.T) {
+	// Setup
+	var buf bytes.Buffer
+	large := strings.Repeat("a", maxRecordedBodySize+10)
+	var sentBody string
+	inner := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		b, err := ioutil.ReadAll(req.Body)
+		sentBody = string(b)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(large + "b")),
+		}, err
+	})
+	client := NewRecordingClient(inner, NewTrafficRecorder(&buf, &fakeClock{}))
+	req := httptest.NewRequest("POST", testFederatedActorIRI, strings.NewReader(large))
+	// Run
+	resp, err := client.Do(req)
+	// Verify
+	assertEqual(t, err, nil)
+	b, err := ioutil.ReadAll(resp.Body)
+	assertEqual(t, err, nil)
+	assertEqual(t, string(b), large+"b")
+	assertEqual(t, sentBody, large)
+	out, err := ReadTrafficLog(&buf)
+	assertEqual(t, err, nil)
+	assertEqual(t, len(out), 1)
+	assertEqual(t, out[0].Body, large[:maxRecordedBodySize])
+	assertEqual(t, out[0].BodyTruncated, true)
+	assertEqual(t, out[0].ResponseBody, large[:maxRecordedBodySize])
+	assertEqual(t, out[0].ResponseBodyTruncated, true)
+}
+
+// httpClientFunc is an HttpClient implemented by a function.
+type httpClientFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls the function.
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// TestBaseActorTrafficRecorder tests that an Actor records the requests to
+// its inbox.
+func TestBaseActorTrafficRecorder(t *testing.T) {
+	// Set up test case
+	setupData()
+	ctx := context.Background()
+	now := time.Date(2000, 2, 3, 4, 5, 6, 0, time.UTC)
+	setupFn := func(ctl *gomock.Controller, buf *bytes.Buffer) (delegate *MockDelegateActor, a Actor) {
+		delegate = NewMockDelegateActor(ctl)
+		clock := NewMockClock(ctl)
+		a = NewCustomActor(
+			delegate,
+			/*enableSocialProtocol=*/ false,
+			/*enableFederatedProtocol=*/ true,
+			clock,
+			WithTrafficRecorder(NewTrafficRecorder(buf, &fakeClock{now: now})))
+		return
+	}
+	// Run tests
+	t.Run("RecordsHandledRequest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		var buf bytes.Buffer
+		delegate, a := setupFn(ctl, &buf)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		raw, err := ioutil.ReadAll(req.Body)
+		assertEqual(t, err, nil)
+		req.Body = ioutil.NopCloser(bytes.NewReader(raw))
+		delegate.EXPECT().AuthenticatePostInbox(ctx, gomock.Any(), req).Return(ctx, true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(ctx, req, toDeserializedForm(testCreate)).Return(ctx, nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, gomock.Any(), toDeserializedForm(testCreate)).Return(true, nil)
+		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), toDeserializedForm(testCreate)).Return(nil)
+		delegate.EXPECT().InboxForwarding(ctx, mustParse(testMyInboxIRI), toDeserializedForm(testCreate)).Return(nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+		out, err := ReadTrafficLog(&buf)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(out), 1)
+		assertEqual(t, out[0].Time.Equal(now), true)
+		assertEqual(t, out[0].Direction, TrafficInbound)
+		assertEqual(t, out[0].Method, "POST")
+		assertEqual(t, out[0].URL, testMyInboxIRI)
+		assertEqual(t, out[0].Header.Get(contentTypeHeader), req.Header.Get(contentTypeHeader))
+		assertEqual(t, out[0].Body, string(raw))
+		assertEqual(t, out[0].StatusCode, http.StatusOK)
+		assertEqual(t, out[0].Error, "")
+	})
+	t.Run("RecordsError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		var buf bytes.Buffer
+		delegate, a := setupFn(ctl, &buf)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		expectErr := errors.New("expected")
+		delegate.EXPECT().AuthenticatePostInbox(ctx, gomock.Any(), req).Return(ctx, false, expectErr)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, expectErr)
+		assertEqual(t, handled, true)
+		out, err := ReadTrafficLog(&buf)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(out), 1)
+		assertEqual(t, out[0].Body, "")
+		assertEqual(t, out[0].StatusCode, 0)
+		assertEqual(t, out[0].Error, "expected")
+	})
+	t.Run("IgnoresOtherRequests", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		var buf bytes.Buffer
+		_, a := setupFn(ctl, &buf)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", testMyInboxIRI, nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, false)
+		assertEqual(t, buf.Len(), 0)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"crypto"
 	"fmt"
 	"github.com/go-fed/httpsig"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -19,6 +20,47 @@ const (
 	acceptHeaderValue = "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\""
 )
 
+// maxResponseSizeKey is the context key of the largest response body a
+// Transport should read when dereferencing.
+type maxResponseSizeKey struct{}
+
+// withMaxResponseSize returns a context limiting the size of the responses
+// read by HttpSigTransport's Dereference.
+func withMaxResponseSize(c context.Context, n int64) context.Context {
+	return context.WithValue(c, maxResponseSizeKey{}, n)
+}
+
+// readLimited reads the body up to the limit in the context, if any, failing
+// once it is exceeded without reading the rest.
+func readLimited(c context.Context, body io.Reader) ([]byte, error) {
+	max, ok := c.Value(maxResponseSizeKey{}).(int64)
+	if !ok {
+		return ioutil.ReadAll(body)
+	}
+	b, err := ioutil.ReadAll(io.LimitReader(body, max+1))
+	if err == nil && int64(len(b)) > max {
+		err = fmt.Errorf("response is larger than %d bytes", max)
+	}
+	return b, err
+}
+
+// publicOnlyKey is the context key marking requests that must only connect to
+// public addresses.
+type publicOnlyKey struct{}
+
+// withPublicOnly returns a context restricting HttpSigTransport's Dereference
+// to connecting to public addresses, including when following redirects.
+func withPublicOnly(c context.Context) context.Context {
+	return context.WithValue(c, publicOnlyKey{}, true)
+}
+
+// isPublicOnly determines whether requests must only connect to public
+// addresses.
+func isPublicOnly(c context.Context) bool {
+	publicOnly, _ := c.Value(publicOnlyKey{}).(bool)
+	return publicOnly
+}
+
 // isSuccess returns true if the HTTP status code is either OK, Created, or
 // Accepted.
 func isSuccess(code int) bool {
@@ -34,8 +76,10 @@ func isSuccess(code int) bool {
 // requests if needed, and facilitating the traffic between this server and
 // another.
 //
-// The transport is exclusively used to issue requests on behalf of an actor,
-// and is never sending requests on behalf of the server in general.
+// The transport is exclusively used to issue requests on behalf of an actor.
+// Requests on behalf of the server in general are issued by the instance
+// actor, a Transport for which is obtained with CommonBehavior's
+// NewInstanceTransport.
 //
 // It may be reused multiple times, but never concurrently.
 type Transport interface {
@@ -54,7 +98,7 @@ var _ Transport = &HttpSigTransport{}
 // HttpSigTransport makes a dereference call using HTTP signatures to
 // authenticate the request on behalf of a particular actor.
 //
-// No rate limiting is applied.
+// No rate limiting is applied, unless its HttpClient is a RateLimitedClient.
 //
 // Only one request is tried per call.
 type HttpSigTransport struct {
@@ -68,6 +112,27 @@ type HttpSigTransport struct {
 	postSignerMu *sync.Mutex
 	pubKeyId     string
 	privKey      crypto.PrivateKey
+	observer     Observer
+	maxBatch     int
+}
+
+// HttpSigTransportOption customizes optional behavior of an HttpSigTransport.
+type HttpSigTransportOption func(h *HttpSigTransport)
+
+// WithTransportObserver notifies the Observer of every delivery and
+// dereference made by the HttpSigTransport.
+func WithTransportObserver(o Observer) HttpSigTransportOption {
+	return func(h *HttpSigTransport) {
+		h.observer = o
+	}
+}
+
+// WithMaxBatchConcurrency bounds the number of deliveries a single call to
+// BatchDeliver makes concurrently. Zero or negative numbers do not bound it.
+func WithMaxBatchConcurrency(n int) HttpSigTransportOption {
+	return func(h *HttpSigTransport) {
+		h.maxBatch = n
+	}
 }
 
 // NewHttpSigTransport returns a new Transport.
@@ -91,8 +156,9 @@ func NewHttpSigTransport(
 	clock Clock,
 	getSigner, postSigner httpsig.Signer,
 	pubKeyId string,
-	privKey crypto.PrivateKey) *HttpSigTransport {
-	return &HttpSigTransport{
+	privKey crypto.PrivateKey,
+	opts ...HttpSigTransportOption) *HttpSigTransport {
+	h := &HttpSigTransport{
 		client:       client,
 		appAgent:     appAgent,
 		gofedAgent:   goFedUserAgent(),
@@ -103,7 +169,12 @@ func NewHttpSigTransport(
 		postSignerMu: &sync.Mutex{},
 		pubKeyId:     pubKeyId,
 		privKey:      privKey,
+		observer:     NoOpObserver{},
+	}
+	for _, opt := range opts {
+		opt(h)
 	}
+	return h
 }
 
 // Dereference sends a GET request signed with an HTTP Signature to obtain an
@@ -124,15 +195,25 @@ func (h HttpSigTransport) Dereference(c context.Context, iri *url.URL) ([]byte,
 	if err != nil {
 		return nil, err
 	}
-	resp, err := h.client.Do(req)
+	client := h.client
+	if isPublicOnly(c) {
+		client = publicOnlyClient(client)
+	}
+	done := observerOrDefault(h.observer).DereferenceAttempted(c, iri)
+	resp, err := client.Do(req)
 	if err != nil {
+		done(0, err)
 		return nil, err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GET request to %s failed (%d): %s", iri.String(), resp.StatusCode, resp.Status)
+		err = fmt.Errorf("GET request to %s failed (%d): %s", iri.String(), resp.StatusCode, resp.Status)
+		done(resp.StatusCode, err)
+		return nil, err
 	}
-	return ioutil.ReadAll(resp.Body)
+	b, err := readLimited(c, resp.Body)
+	done(resp.StatusCode, err)
+	return b, err
 }
 
 // Deliver sends a POST request with an HTTP Signature.
@@ -156,26 +237,42 @@ func (h HttpSigTransport) Deliver(c context.Context, b []byte, to *url.URL) erro
 	if err != nil {
 		return err
 	}
+	done := observerOrDefault(h.observer).DeliveryAttempted(c, to)
 	resp, err := h.client.Do(req)
 	if err != nil {
+		done(0, err)
 		return err
 	}
 	defer resp.Body.Close()
 	if !isSuccess(resp.StatusCode) {
-		return fmt.Errorf("POST request to %s failed (%d): %s", to.String(), resp.StatusCode, resp.Status)
+		err = fmt.Errorf("POST request to %s failed (%d): %s", to.String(), resp.StatusCode, resp.Status)
+		done(resp.StatusCode, err)
+		return err
 	}
+	done(resp.StatusCode, nil)
 	return nil
 }
 
 // BatchDeliver sends concurrent POST requests. Returns an error if any of the
 // requests had an error.
+//
+// The number of concurrent requests is bounded by WithMaxBatchConcurrency.
 func (h HttpSigTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
 	var wg sync.WaitGroup
-	errCh := make(chan error, len(recipients))
+	var sem chan struct{}
+	if h.maxBatch > 0 {
+		sem = make(chan struct{}, h.maxBatch)
+	}
+	errCh := make(chan error, len(recipients)+1)
 	for _, recipient := range recipients {
+		if err := acquire(c, sem); err != nil {
+			errCh <- err
+			break
+		}
 		wg.Add(1)
 		go func(r *url.URL) {
 			defer wg.Done()
+			defer release(sem)
 			if err := h.Deliver(c, b, r); err != nil {
 				errCh <- err
 			}
@@ -76,10 +76,14 @@ func isActivityPubPost(r *http.Request) bool {
 	return r.Method == "POST" && headerIsActivityPubMediaType(r.Header.Get(contentTypeHeader))
 }
 
-// isActivityPubGet returns true if the request is a GET request that has the
-// ActivityStreams content type header
+// isActivityPubGet returns true if the request is a GET request whose Accept
+// header prefers an ActivityStreams media type.
 func isActivityPubGet(r *http.Request) bool {
-	return r.Method == "GET" && headerIsActivityPubMediaType(r.Header.Get(acceptHeader))
+	if r.Method != "GET" {
+		return false
+	}
+	isAS, _ := negotiateGet(r, nil)
+	return isAS
 }
 
 // dedupeOrderedItems deduplicates the 'orderedItems' within an ordered
@@ -140,9 +144,11 @@ const (
 )
 
 // addResponseHeaders sets headers needed in the HTTP response, such but not
-// limited to the Content-Type, Date, and Digest headers.
+// limited to the Content-Type, Date, Digest, and Vary headers.
 func addResponseHeaders(h http.Header, c Clock, responseContent []byte) {
 	h.Set(contentTypeHeader, contentTypeHeaderValue)
+	// RFC 7231 §7.1.4
+	addVaryAccept(h)
 	// RFC 7231 §7.1.1.2
 	h.Set(dateHeader, c.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
 	// RFC 3230 and RFC 5843
@@ -247,6 +253,13 @@ func getInboxForwardingValues(o vocab.Type) (t []vocab.Type, iri []*url.URL) {
 	return
 }
 
+// WrapInCreate wraps the provided object in a Create activity by the actor,
+// the same way a server does for an object POSTed to an outbox. It is for C2S
+// clients that wrap objects themselves.
+func WrapInCreate(c context.Context, o vocab.Type, actor *url.URL) (vocab.ActivityStreamsCreate, error) {
+	return wrapInCreate(c, o, actor)
+}
+
 // wrapInCreate will automatically wrap the provided object in a Create
 // activity. This will copy over the 'to', 'bto', 'cc', 'bcc', and 'audience'
 // properties. It will also copy over the published time if present.
@@ -261,11 +274,11 @@ func wrapInCreate(ctx context.Context, o vocab.Type, actor *url.URL) (c vocab.Ac
 	actorProp.AppendIRI(actor)
 	c.SetActivityStreamsActor(actorProp)
 	// Published Property
-	if v, ok := o.(publisheder); ok {
+	if v, ok := o.(publisheder); ok && v.GetActivityStreamsPublished() != nil {
 		c.SetActivityStreamsPublished(v.GetActivityStreamsPublished())
 	}
 	// Copying over properties.
-	if v, ok := o.(toer); ok {
+	if v, ok := o.(toer); ok && v.GetActivityStreamsTo() != nil {
 		activityTo := streams.NewActivityStreamsToProperty()
 		to := v.GetActivityStreamsTo()
 		for iter := to.Begin(); iter != to.End(); iter = iter.Next() {
@@ -278,7 +291,7 @@ func wrapInCreate(ctx context.Context, o vocab.Type, actor *url.URL) (c vocab.Ac
 		}
 		c.SetActivityStreamsTo(activityTo)
 	}
-	if v, ok := o.(btoer); ok {
+	if v, ok := o.(btoer); ok && v.GetActivityStreamsBto() != nil {
 		activityBto := streams.NewActivityStreamsBtoProperty()
 		bto := v.GetActivityStreamsBto()
 		for iter := bto.Begin(); iter != bto.End(); iter = iter.Next() {
@@ -291,7 +304,7 @@ func wrapInCreate(ctx context.Context, o vocab.Type, actor *url.URL) (c vocab.Ac
 		}
 		c.SetActivityStreamsBto(activityBto)
 	}
-	if v, ok := o.(ccer); ok {
+	if v, ok := o.(ccer); ok && v.GetActivityStreamsCc() != nil {
 		activityCc := streams.NewActivityStreamsCcProperty()
 		cc := v.GetActivityStreamsCc()
 		for iter := cc.Begin(); iter != cc.End(); iter = iter.Next() {
@@ -304,7 +317,7 @@ func wrapInCreate(ctx context.Context, o vocab.Type, actor *url.URL) (c vocab.Ac
 		}
 		c.SetActivityStreamsCc(activityCc)
 	}
-	if v, ok := o.(bccer); ok {
+	if v, ok := o.(bccer); ok && v.GetActivityStreamsBcc() != nil {
 		activityBcc := streams.NewActivityStreamsBccProperty()
 		bcc := v.GetActivityStreamsBcc()
 		for iter := bcc.Begin(); iter != bcc.End(); iter = iter.Next() {
@@ -317,7 +330,7 @@ func wrapInCreate(ctx context.Context, o vocab.Type, actor *url.URL) (c vocab.Ac
 		}
 		c.SetActivityStreamsBcc(activityBcc)
 	}
-	if v, ok := o.(audiencer); ok {
+	if v, ok := o.(audiencer); ok && v.GetActivityStreamsAudience() != nil {
 		activityAudience := streams.NewActivityStreamsAudienceProperty()
 		aud := v.GetActivityStreamsAudience()
 		for iter := aud.Begin(); iter != aud.End(); iter = iter.Next() {
@@ -402,11 +415,17 @@ func dedupeIRIs(recipients, ignored []*url.URL) (out []*url.URL) {
 	return
 }
 
-// stripHiddenRecipients removes "bto" and "bcc" from the activity.
+// stripHiddenRecipients returns a copy of the activity without "bto" and
+// "bcc", leaving the activity itself unchanged.
 //
 // Note that this requirement of the specification is under "Section 6: Client
 // to Server Interactions", the Social API, and not the Federative API.
-func stripHiddenRecipients(activity Activity) {
+func stripHiddenRecipients(activity Activity) (Activity, error) {
+	clone := streams.Clone(activity)
+	activity, ok := clone.(Activity)
+	if !ok {
+		return nil, fmt.Errorf("clone of activity is not an Activity: %T", clone)
+	}
 	bto := activity.GetActivityStreamsBto()
 	if bto != nil {
 		for i := bto.Len() - 1; i >= 0; i-- {
@@ -419,6 +438,7 @@ func stripHiddenRecipients(activity Activity) {
 			bcc.Remove(i)
 		}
 	}
+	return activity, nil
 }
 
 // mustHaveActivityOriginMatchObjects ensures that the Host in the activity id
@@ -769,6 +789,7 @@ func mustHaveActivityActorsMatchObjectActors(c context.Context,
 			if err = json.Unmarshal(b, &m); err != nil {
 				return err
 			}
+			NormalizeJSON(m)
 			t, err = streams.ToType(c, m)
 			if err != nil {
 				return err
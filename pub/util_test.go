@@ -1,6 +1,8 @@
 package pub
 
 import (
+	"context"
+	"github.com/go-fed/activity/streams"
 	"testing"
 )
 
@@ -74,3 +76,65 @@ func TestHeaderIsActivityPubMediaType(t *testing.T) {
 		})
 	}
 }
+
+// TestStripHiddenRecipients ensures the hidden recipients are removed from a
+// copy of an activity, and not from the activity itself.
+func TestStripHiddenRecipients(t *testing.T) {
+	// Setup
+	create := streams.NewActivityStreamsCreate()
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(mustParse(testToIRI))
+	create.SetActivityStreamsTo(to)
+	bto := streams.NewActivityStreamsBtoProperty()
+	bto.AppendIRI(mustParse(testToIRI2))
+	create.SetActivityStreamsBto(bto)
+	bcc := streams.NewActivityStreamsBccProperty()
+	bcc.AppendIRI(mustParse(testAudienceIRI2))
+	create.SetActivityStreamsBcc(bcc)
+	// Run the test
+	stripped, err := stripHiddenRecipients(create)
+	// Verify results
+	assertEqual(t, err, nil)
+	assertEqual(t, stripped.GetActivityStreamsTo().Len(), 1)
+	assertEqual(t, stripped.GetActivityStreamsBto().Len(), 0)
+	assertEqual(t, stripped.GetActivityStreamsBcc().Len(), 0)
+	assertEqual(t, create.GetActivityStreamsBto().Len(), 1)
+	assertEqual(t, create.GetActivityStreamsBcc().Len(), 1)
+}
+
+// TestWrapInCreateUnsetProperties ensures that wrapping an object without a
+// 'published' time or addressing leaves those properties unset on the Create,
+// while copying over the ones the object has.
+func TestWrapInCreateUnsetProperties(t *testing.T) {
+	t.Run("NoPublishedOrAddressing", func(t *testing.T) {
+		// Setup
+		note := streams.NewActivityStreamsNote()
+		// Run the test
+		create, err := wrapInCreate(context.Background(), note, mustParse(testPersonIRI))
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, create.GetActivityStreamsActor().At(0).GetIRI().String(), testPersonIRI)
+		assertEqual(t, create.GetActivityStreamsObject().At(0).GetActivityStreamsNote(), note)
+		assertEqual(t, create.GetActivityStreamsPublished(), nil)
+		assertEqual(t, create.GetActivityStreamsTo(), nil)
+		assertEqual(t, create.GetActivityStreamsBto(), nil)
+		assertEqual(t, create.GetActivityStreamsCc(), nil)
+		assertEqual(t, create.GetActivityStreamsBcc(), nil)
+		assertEqual(t, create.GetActivityStreamsAudience(), nil)
+	})
+	t.Run("OnlyTo", func(t *testing.T) {
+		// Setup
+		note := streams.NewActivityStreamsNote()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testToIRI))
+		note.SetActivityStreamsTo(to)
+		// Run the test
+		create, err := wrapInCreate(context.Background(), note, mustParse(testPersonIRI))
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, create.GetActivityStreamsTo().Len(), 1)
+		assertEqual(t, create.GetActivityStreamsTo().At(0).GetIRI().String(), testToIRI)
+		assertEqual(t, create.GetActivityStreamsCc(), nil)
+		assertEqual(t, create.GetActivityStreamsPublished(), nil)
+	})
+}
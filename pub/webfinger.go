@@ -0,0 +1,94 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// jrdMediaType is the media type of WebFinger responses.
+	jrdMediaType = "application/jrd+json"
+	// webFingerSelfRel is the relation of the WebFinger link to the actor.
+	webFingerSelfRel = "self"
+	// maxWebFingerSize bounds the size of WebFinger responses.
+	maxWebFingerSize = 1 << 16
+)
+
+// webFingerLink is a link in a WebFinger response.
+type webFingerLink struct {
+	Rel  string `json:"rel"`
+	Type string `json:"type"`
+	Href string `json:"href"`
+}
+
+// webFingerResponse is the part of a WebFinger response used to find an
+// actor.
+type webFingerResponse struct {
+	Subject string          `json:"subject"`
+	Links   []webFingerLink `json:"links"`
+}
+
+// MentionResolver resolves the 'user@host' handle of an actor to its IRI.
+//
+// It returns a nil IRI and no error if there is no such actor.
+type MentionResolver func(c context.Context, user, host string) (*url.URL, error)
+
+// NewWebFingerResolver creates a MentionResolver that looks up actors with
+// WebFinger over https, sending requests with the HttpClient.
+//
+// The appAgent identifies the application in the User-Agent header.
+func NewWebFingerResolver(client HttpClient, appAgent string) MentionResolver {
+	return func(c context.Context, user, host string) (*url.URL, error) {
+		acct := "acct:" + user + "@" + host
+		u := &url.URL{
+			Scheme:   "https",
+			Host:     host,
+			Path:     "/.well-known/webfinger",
+			RawQuery: url.Values{"resource": []string{acct}}.Encode(),
+		}
+		req, err := http.NewRequest("GET", u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		req = req.WithContext(c)
+		req.Header.Add(acceptHeader, jrdMediaType)
+		req.Header.Add("User-Agent", fmt.Sprintf("%s %s", appAgent, goFedUserAgent()))
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		} else if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("WebFinger request to %s failed (%d): %s", host, resp.StatusCode, resp.Status)
+		}
+		b, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxWebFingerSize))
+		if err != nil {
+			return nil, err
+		}
+		var jrd webFingerResponse
+		if err = json.Unmarshal(b, &jrd); err != nil {
+			return nil, fmt.Errorf("WebFinger response of %s is not JSON: %s", host, err)
+		}
+		for _, l := range jrd.Links {
+			if l.Rel == webFingerSelfRel && isActivityStreamsMediaType(l.Type) {
+				return url.Parse(l.Href)
+			}
+		}
+		return nil, nil
+	}
+}
+
+// isActivityStreamsMediaType determines whether a WebFinger link's media type
+// is one for ActivityStreams.
+func isActivityStreamsMediaType(t string) bool {
+	return t == activityJSONMediaType ||
+		(strings.HasPrefix(t, "application/ld+json") && strings.Contains(t, activityStreamsContext))
+}
@@ -0,0 +1,313 @@
+package streams
+
+import (
+	"encoding/json"
+	"github.com/go-fed/activity/streams/vocab"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ChangeKind is the kind of a Change between two ActivityStreams values.
+type ChangeKind int
+
+const (
+	// Added is a property or value only present in the newer value.
+	Added ChangeKind = iota
+	// Removed is a property or value only present in the older value.
+	Removed
+	// Changed is a property or value present in both values but with
+	// different contents.
+	Changed
+)
+
+// String returns the name of the kind of change.
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Changed:
+		return "changed"
+	default:
+		return "ChangeKind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// Change is a single difference between two ActivityStreams values.
+type Change struct {
+	// Kind is whether the value was added, removed, or changed.
+	Kind ChangeKind
+	// Path is the JSON Pointer (RFC 6901) to the value in the serialized
+	// form, such as "/name", "/to/1" or "/object/contentMap/fr". Removed
+	// values are located in the older value, the others in the newer one.
+	Path string
+	// Old is the serialized older value, which is nil if it was added.
+	Old interface{}
+	// New is the serialized newer value, which is nil if it was removed.
+	New interface{}
+}
+
+// Difference is the set of changes between two ActivityStreams values,
+// computed by Diff.
+type Difference struct {
+	// Changes are the differences between the values, in a deterministic
+	// order.
+	Changes []Change
+	from    map[string]interface{}
+	to      map[string]interface{}
+}
+
+// Diff compares two ActivityStreams values as they serialize, reporting the
+// properties added, removed, or changed from a to b. Embedded values and
+// natural language maps are compared property by property.
+//
+// Ordered properties, such as orderedItems, are compared value by value in
+// order. Other multi-valued properties are compared as sets: each value is
+// reported as added or removed, except embedded values having the same id,
+// which are compared in turn, and values that are only reordered are reported
+// as a change of the whole property, as Equals also depends on their order. A
+// single value is treated as a list of one value when compared to a list. The
+// @context of the values is ignored.
+func Diff(a, b vocab.Type) (*Difference, error) {
+	from, err := toJSONObject(a)
+	if err != nil {
+		return nil, err
+	}
+	to, err := toJSONObject(b)
+	if err != nil {
+		return nil, err
+	}
+	return &Difference{
+		Changes: diffObjects("", from, to, nil),
+		from:    from,
+		to:      to,
+	}, nil
+}
+
+// Empty determines whether the values compared are the same.
+func (d *Difference) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// MergePatch returns the JSON Merge Patch (RFC 7396) transforming the older
+// value into the newer one. Removed properties are set to nil, which marshals
+// to null, and multi-valued and ordered properties that changed are replaced
+// entirely, as a merge patch cannot change the elements of a list.
+func (d *Difference) MergePatch() map[string]interface{} {
+	return mergePatch(d.from, d.to)
+}
+
+// orderedProperties are the names of the properties whose values are an
+// ordered list rather than a set.
+var orderedProperties = map[string]bool{
+	ActivityStreamsOrderedItemsPropertyName: true,
+}
+
+// toJSONObject serializes a value into the values produced by unmarshalling
+// JSON, so that the values of different Go types are compared alike.
+func toJSONObject(t vocab.Type) (map[string]interface{}, error) {
+	m, err := t.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// pointerTo returns the JSON Pointer to a key or index under another pointer.
+func pointerTo(path, token string) string {
+	token = strings.Replace(token, "~", "~0", -1)
+	token = strings.Replace(token, "/", "~1", -1)
+	return path + "/" + token
+}
+
+// sortedKeys returns the keys of a JSON object other than its @context, in
+// order.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		if k != jsonLDContext {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// jsonEqual determines whether two JSON values are the same, in the sense of
+// Diff.
+func jsonEqual(a, b interface{}) bool {
+	return len(diffValues("", a, b, false, nil)) == 0
+}
+
+// toArray returns the values of a JSON value, which is either a list of values
+// or a single one.
+func toArray(v interface{}) []interface{} {
+	if arr, ok := v.([]interface{}); ok {
+		return arr
+	}
+	return []interface{}{v}
+}
+
+// diffValues appends the changes between two JSON values, comparing lists as
+// sets unless ordered is true.
+func diffValues(path string, a, b interface{}, ordered bool, changes []Change) []Change {
+	am, aIsObject := a.(map[string]interface{})
+	bm, bIsObject := b.(map[string]interface{})
+	if aIsObject && bIsObject {
+		return diffObjects(path, am, bm, changes)
+	}
+	_, aIsArray := a.([]interface{})
+	_, bIsArray := b.([]interface{})
+	if (aIsArray || bIsArray) && ordered {
+		return diffLists(path, toArray(a), toArray(b), changes)
+	} else if aIsArray || bIsArray {
+		return diffArrays(path, toArray(a), toArray(b), changes)
+	}
+	if !reflect.DeepEqual(a, b) {
+		changes = append(changes, Change{Kind: Changed, Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
+// diffObjects appends the changes between two JSON objects, ignoring their
+// @context.
+func diffObjects(path string, a, b map[string]interface{}, changes []Change) []Change {
+	for _, k := range sortedKeys(a) {
+		if _, ok := b[k]; !ok {
+			changes = append(changes, Change{Kind: Removed, Path: pointerTo(path, k), Old: a[k]})
+		}
+	}
+	for _, k := range sortedKeys(b) {
+		if av, ok := a[k]; !ok {
+			changes = append(changes, Change{Kind: Added, Path: pointerTo(path, k), New: b[k]})
+		} else {
+			changes = diffValues(pointerTo(path, k), av, b[k], orderedProperties[k], changes)
+		}
+	}
+	return changes
+}
+
+// jsonID returns the id of an embedded value, if it has one.
+func jsonID(i interface{}) string {
+	if m, ok := i.(map[string]interface{}); ok {
+		if id, ok := m["id"].(string); ok {
+			return id
+		}
+	}
+	return ""
+}
+
+// diffLists appends the changes between two ordered lists of values, compared
+// value by value.
+func diffLists(path string, a, b []interface{}, changes []Change) []Change {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		changes = diffValues(pointerTo(path, strconv.Itoa(i)), a[i], b[i], false, changes)
+	}
+	for i := len(b); i < len(a); i++ {
+		changes = append(changes, Change{Kind: Removed, Path: pointerTo(path, strconv.Itoa(i)), Old: a[i]})
+	}
+	for j := len(a); j < len(b); j++ {
+		changes = append(changes, Change{Kind: Added, Path: pointerTo(path, strconv.Itoa(j)), New: b[j]})
+	}
+	return changes
+}
+
+// diffArrays appends the changes between two lists of values, compared as
+// sets. If they only differ in order, the whole list is reported as changed.
+func diffArrays(path string, a, b []interface{}, changes []Change) []Change {
+	// Pair the values that are the same in both lists.
+	matchedA := make([]bool, len(a))
+	matchedB := make([]bool, len(b))
+	reordered := false
+	for i, av := range a {
+		for j, bv := range b {
+			if !matchedB[j] && jsonEqual(av, bv) {
+				matchedA[i] = true
+				matchedB[j] = true
+				reordered = reordered || i != j
+				break
+			}
+		}
+	}
+	if reordered && len(a) == len(b) && allTrue(matchedA) {
+		return append(changes, Change{Kind: Changed, Path: path, Old: a, New: b})
+	}
+	// Pair the remaining embedded values having the same id, which changed.
+	pairs := make(map[int]int)
+	for i, av := range a {
+		id := jsonID(av)
+		if matchedA[i] || len(id) == 0 {
+			continue
+		}
+		for j, bv := range b {
+			if !matchedB[j] && jsonID(bv) == id {
+				matchedA[i] = true
+				matchedB[j] = true
+				pairs[j] = i
+				break
+			}
+		}
+	}
+	for i, av := range a {
+		if !matchedA[i] {
+			changes = append(changes, Change{Kind: Removed, Path: pointerTo(path, strconv.Itoa(i)), Old: av})
+		}
+	}
+	for j, bv := range b {
+		if i, ok := pairs[j]; ok {
+			changes = diffValues(pointerTo(path, strconv.Itoa(j)), a[i], bv, false, changes)
+		} else if !matchedB[j] {
+			changes = append(changes, Change{Kind: Added, Path: pointerTo(path, strconv.Itoa(j)), New: bv})
+		}
+	}
+	return changes
+}
+
+// allTrue determines whether all the values are true.
+func allTrue(bs []bool) bool {
+	for _, b := range bs {
+		if !b {
+			return false
+		}
+	}
+	return true
+}
+
+// mergePatch returns the JSON Merge Patch between two JSON objects, ignoring
+// their @context.
+func mergePatch(a, b map[string]interface{}) map[string]interface{} {
+	patch := make(map[string]interface{})
+	for _, k := range sortedKeys(a) {
+		if _, ok := b[k]; !ok {
+			patch[k] = nil
+		}
+	}
+	for _, k := range sortedKeys(b) {
+		av, ok := a[k]
+		if !ok {
+			patch[k] = b[k]
+			continue
+		}
+		am, aIsObject := av.(map[string]interface{})
+		bm, bIsObject := b[k].(map[string]interface{})
+		if aIsObject && bIsObject && !orderedProperties[k] {
+			if p := mergePatch(am, bm); len(p) > 0 {
+				patch[k] = p
+			}
+		} else if len(diffValues("", av, b[k], orderedProperties[k], nil)) > 0 {
+			patch[k] = b[k]
+		}
+	}
+	return patch
+}
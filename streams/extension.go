@@ -0,0 +1,412 @@
+package streams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// ExtensionTypeDeserializer deserializes a type of an Extension into the
+// application's Go type, given the JSON map and its context's vocabulary
+// aliases.
+//
+// The Go type serializes itself and reports the vocabularies of its @context
+// like any other vocab.Type.
+type ExtensionTypeDeserializer func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error)
+
+// Extension is a vocabulary handled by the application at runtime, instead of
+// being code-generated by astool.
+type Extension struct {
+	// VocabularyURI identifies the vocabulary in the @context, such as
+	// "https://example.com/ns". Required.
+	VocabularyURI string
+	// Alias prefixes the terms of the vocabulary when serialized, such as
+	// "ex" for "ex:color". If empty, the terms are not prefixed.
+	Alias string
+	// Types maps the names of the types of the vocabulary to their
+	// deserializers.
+	Types map[string]ExtensionTypeDeserializer
+	// Properties are the names of the properties of the vocabulary, which
+	// are kept among the unknown properties of the types and are accessed
+	// with an ExtensionProperty.
+	Properties []string
+}
+
+var (
+	// extensionsMu guards extensions.
+	extensionsMu sync.RWMutex
+	// extensions are the registered Extensions, in order of registration.
+	extensions []Extension
+)
+
+func init() {
+	extensionTypeResolver = resolveExtensionType
+}
+
+// RegisterExtension registers an extension vocabulary, so that its types are
+// resolved by the JSONResolver and ToType, and Serialize adds it to the
+// @context of values using its terms. Registering a vocabulary again replaces
+// it.
+//
+// The types of the ActivityStreams and other code-generated vocabularies take
+// precedence over those of extensions.
+func RegisterExtension(e Extension) error {
+	if len(e.VocabularyURI) == 0 {
+		return errors.New("extension has no vocabulary URI")
+	}
+	extensionsMu.Lock()
+	defer extensionsMu.Unlock()
+	for i, ext := range extensions {
+		if sameVocabulary(ext.VocabularyURI, e.VocabularyURI) {
+			extensions[i] = e
+			return nil
+		}
+	}
+	extensions = append(extensions, e)
+	return nil
+}
+
+// UnregisterExtension removes a registered extension vocabulary.
+func UnregisterExtension(vocabularyURI string) {
+	extensionsMu.Lock()
+	defer extensionsMu.Unlock()
+	for i, ext := range extensions {
+		if sameVocabulary(ext.VocabularyURI, vocabularyURI) {
+			extensions = append(extensions[:i], extensions[i+1:]...)
+			return
+		}
+	}
+}
+
+// Property returns the accessor of a property of the extension.
+func (e Extension) Property(name string) ExtensionProperty {
+	return ExtensionProperty{
+		vocabularyURI: e.VocabularyURI,
+		alias:         e.Alias,
+		name:          name,
+	}
+}
+
+// resolveExtensionType deserializes a type of a registered extension for the
+// JSONResolver.
+func resolveExtensionType(typeString string, m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+	var fn ExtensionTypeDeserializer
+	extensionsMu.RLock()
+	for _, ext := range extensions {
+		// Only the vocabularies of the value's context are its types.
+		alias, ok := lookupExtensionAlias(aliasMap, ext.VocabularyURI)
+		if !ok {
+			continue
+		}
+		prefix := aliasPrefix(alias)
+		for name, deserialize := range ext.Types {
+			if typeString == prefix+name {
+				fn = deserialize
+				break
+			}
+		}
+		if fn != nil {
+			break
+		}
+	}
+	extensionsMu.RUnlock()
+	if fn == nil {
+		return nil, ErrUnhandledType
+	}
+	return fn(m, aliasMap)
+}
+
+// httpAndHttps returns the http and https forms of a vocabulary URI, or the URI
+// itself for other schemes.
+func httpAndHttps(uri string) (http, https string) {
+	if strings.HasPrefix(uri, "http://") {
+		return uri, "https" + strings.TrimPrefix(uri, "http")
+	} else if strings.HasPrefix(uri, "https://") {
+		return "http" + strings.TrimPrefix(uri, "https"), uri
+	}
+	return uri, uri
+}
+
+// sameVocabulary determines whether two vocabulary URIs differ by at most their
+// http or https scheme.
+func sameVocabulary(a, b string) bool {
+	_, httpsA := httpAndHttps(a)
+	_, httpsB := httpAndHttps(b)
+	return httpsA == httpsB
+}
+
+// extensionAlias returns the alias of a vocabulary in a context's alias map,
+// which is empty if the vocabulary is imported without an alias or not at all.
+func extensionAlias(aliasMap map[string]string, uri string) string {
+	alias, _ := lookupExtensionAlias(aliasMap, uri)
+	return alias
+}
+
+// lookupExtensionAlias returns the alias of a vocabulary in a context's alias
+// map, and whether the context imports the vocabulary at all.
+func lookupExtensionAlias(aliasMap map[string]string, uri string) (string, bool) {
+	http, https := httpAndHttps(uri)
+	if alias, ok := aliasMap[https]; ok {
+		return alias, true
+	} else if alias, ok := aliasMap[http]; ok {
+		return alias, true
+	}
+	// Contexts defining aliases, such as {"ex": "https://example.com/ns"},
+	// map the alias to the vocabulary instead.
+	for k, v := range aliasMap {
+		if v == http || v == https {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// aliasPrefix returns the prefix of the terms of a vocabulary with an alias.
+func aliasPrefix(alias string) string {
+	if len(alias) > 0 {
+		return alias + ":"
+	}
+	return ""
+}
+
+// addExtensionContext adds the registered extensions whose terms are used as
+// keys or types anywhere in the serialized value to the vocabularies of its
+// context.
+func addExtensionContext(ctx map[string]string, m map[string]interface{}) {
+	extensionsMu.RLock()
+	defer extensionsMu.RUnlock()
+	if len(extensions) == 0 {
+		return
+	}
+	terms := make(map[string]bool)
+	var collectFn func(interface{})
+	collectFn = func(i interface{}) {
+		switch v := i.(type) {
+		case map[string]interface{}:
+			for k, elem := range v {
+				terms[k] = true
+				if k == "type" {
+					if s, ok := elem.(string); ok {
+						terms[s] = true
+					} else if arr, ok := elem.([]interface{}); ok {
+						for _, t := range arr {
+							if s, ok := t.(string); ok {
+								terms[s] = true
+							}
+						}
+					}
+				}
+				collectFn(elem)
+			}
+		case []interface{}:
+			for _, elem := range v {
+				collectFn(elem)
+			}
+		}
+	}
+	collectFn(m)
+	for _, ext := range extensions {
+		if _, ok := ctx[ext.VocabularyURI]; ok {
+			continue
+		}
+		prefix := aliasPrefix(ext.Alias)
+		used := false
+		for name := range ext.Types {
+			used = used || terms[prefix+name]
+		}
+		for _, name := range ext.Properties {
+			used = used || terms[prefix+name]
+		}
+		if used {
+			ctx[ext.VocabularyURI] = ext.Alias
+		}
+	}
+}
+
+// unknownPropertier is a type keeping the properties not handled by the
+// generated code.
+type unknownPropertier interface {
+	GetUnknownProperties() map[string]interface{}
+}
+
+// ExtensionProperty reads and writes a property of an Extension among the
+// unknown properties of a type, as returned by GetUnknownProperties.
+type ExtensionProperty struct {
+	vocabularyURI string
+	alias         string
+	name          string
+}
+
+// Name returns the name of the property.
+func (p ExtensionProperty) Name() string {
+	return p.name
+}
+
+// keys returns the keys the property may have among the unknown properties,
+// in order of preference. The type's own @context, if it was deserialized
+// with one, determines the alias it used.
+func (p ExtensionProperty) keys(unknown map[string]interface{}) []string {
+	var keys []string
+	if ctx, ok := unknown[jsonLDContext]; ok {
+		keys = append(keys, aliasPrefix(extensionAlias(toAliasMap(ctx), p.vocabularyURI))+p.name)
+	}
+	keys = append(keys, aliasPrefix(p.alias)+p.name, p.name)
+	return keys
+}
+
+// Get returns the JSON value of the property on the type, and whether it is
+// set.
+func (p ExtensionProperty) Get(t vocab.Type) (interface{}, bool) {
+	u, ok := t.(unknownPropertier)
+	if !ok {
+		return nil, false
+	}
+	unknown := u.GetUnknownProperties()
+	for _, k := range p.keys(unknown) {
+		if v, ok := unknown[k]; ok {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// Set sets the JSON value of the property on the type, which must be one that
+// can be marshalled into JSON. It returns an error if the type does not keep
+// unknown properties.
+func (p ExtensionProperty) Set(t vocab.Type, v interface{}) error {
+	u, ok := t.(unknownPropertier)
+	if !ok || u.GetUnknownProperties() == nil {
+		return fmt.Errorf("%s cannot have the extension property %q", t.GetTypeName(), p.name)
+	}
+	p.Delete(t)
+	u.GetUnknownProperties()[aliasPrefix(p.alias)+p.name] = v
+	return nil
+}
+
+// Delete removes the property from the type.
+func (p ExtensionProperty) Delete(t vocab.Type) {
+	u, ok := t.(unknownPropertier)
+	if !ok {
+		return
+	}
+	unknown := u.GetUnknownProperties()
+	for _, k := range p.keys(unknown) {
+		delete(unknown, k)
+	}
+}
+
+// GetString returns the property's value if it is a string.
+func (p ExtensionProperty) GetString(t vocab.Type) (string, bool) {
+	v, _ := p.Get(t)
+	s, ok := v.(string)
+	return s, ok
+}
+
+// SetString sets the property to a string.
+func (p ExtensionProperty) SetString(t vocab.Type, v string) error {
+	return p.Set(t, v)
+}
+
+// GetBool returns the property's value if it is a boolean.
+func (p ExtensionProperty) GetBool(t vocab.Type) (bool, bool) {
+	v, _ := p.Get(t)
+	b, ok := v.(bool)
+	return b, ok
+}
+
+// SetBool sets the property to a boolean.
+func (p ExtensionProperty) SetBool(t vocab.Type, v bool) error {
+	return p.Set(t, v)
+}
+
+// GetFloat64 returns the property's value if it is a number.
+func (p ExtensionProperty) GetFloat64(t vocab.Type) (float64, bool) {
+	v, _ := p.Get(t)
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// SetFloat64 sets the property to a number.
+func (p ExtensionProperty) SetFloat64(t vocab.Type, v float64) error {
+	return p.Set(t, v)
+}
+
+// GetIRI returns the property's value if it is an IRI.
+func (p ExtensionProperty) GetIRI(t vocab.Type) (*url.URL, bool) {
+	s, ok := p.GetString(t)
+	if !ok {
+		return nil, false
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, false
+	}
+	return u, true
+}
+
+// SetIRI sets the property to an IRI.
+func (p ExtensionProperty) SetIRI(t vocab.Type, v *url.URL) error {
+	return p.Set(t, v.String())
+}
+
+// GetType resolves the property's value into a Type, as ToType does. It
+// returns nil and no error if the property is not set, and an error if its
+// value is not an object.
+func (p ExtensionProperty) GetType(c context.Context, t vocab.Type) (vocab.Type, error) {
+	v, ok := p.Get(t)
+	if !ok {
+		return nil, nil
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("extension property %q is not an object", p.name)
+	} else if _, ok := m[jsonLDContext]; ok {
+		return ToType(c, m)
+	}
+	// Embedded values have no context of their own, so resolve it with the
+	// context of the type having the property.
+	embedded := make(map[string]interface{}, len(m)+1)
+	for k, elem := range m {
+		embedded[k] = elem
+	}
+	if ctx, ok := t.(unknownPropertier).GetUnknownProperties()[jsonLDContext]; ok {
+		embedded[jsonLDContext] = ctx
+	} else {
+		vocabs := make(map[string]string)
+		for vocab, alias := range t.JSONLDContext() {
+			vocabs[vocab] = alias
+		}
+		addExtensionContext(vocabs, m)
+		ctx := []interface{}{}
+		aliases := make(map[string]interface{})
+		for vocab, alias := range vocabs {
+			if len(alias) == 0 {
+				ctx = append(ctx, vocab)
+			} else {
+				aliases[alias] = vocab
+			}
+		}
+		embedded[jsonLDContext] = append(ctx, aliases)
+	}
+	return ToType(c, embedded)
+}
+
+// SetType sets the property to the serialized Type.
+func (p ExtensionProperty) SetType(t vocab.Type, v vocab.Type) error {
+	m, err := v.Serialize()
+	if err != nil {
+		return err
+	}
+	return p.Set(t, m)
+}
@@ -35,6 +35,9 @@ var ActivityStreamsCollectionName string = "Collection"
 // ActivityStreamsCollectionPageName is the string literal of the name for the CollectionPage type in the ActivityStreams vocabulary.
 var ActivityStreamsCollectionPageName string = "CollectionPage"
 
+// ForgeFedCommitName is the string literal of the name for the Commit type in the ForgeFed vocabulary.
+var ForgeFedCommitName string = "Commit"
+
 // ActivityStreamsCreateName is the string literal of the name for the Create type in the ActivityStreams vocabulary.
 var ActivityStreamsCreateName string = "Create"
 
@@ -47,6 +50,9 @@ var ActivityStreamsDislikeName string = "Dislike"
 // ActivityStreamsDocumentName is the string literal of the name for the Document type in the ActivityStreams vocabulary.
 var ActivityStreamsDocumentName string = "Document"
 
+// TootEmojiName is the string literal of the name for the Emoji type in the Toot vocabulary.
+var TootEmojiName string = "Emoji"
+
 // ActivityStreamsEventName is the string literal of the name for the Event type in the ActivityStreams vocabulary.
 var ActivityStreamsEventName string = "Event"
 
@@ -59,6 +65,9 @@ var ActivityStreamsFollowName string = "Follow"
 // ActivityStreamsGroupName is the string literal of the name for the Group type in the ActivityStreams vocabulary.
 var ActivityStreamsGroupName string = "Group"
 
+// ActivityStreamsHashtagName is the string literal of the name for the Hashtag type in the ActivityStreams vocabulary.
+var ActivityStreamsHashtagName string = "Hashtag"
+
 // ActivityStreamsIgnoreName is the string literal of the name for the Ignore type in the ActivityStreams vocabulary.
 var ActivityStreamsIgnoreName string = "Ignore"
 
@@ -113,6 +122,9 @@ var ActivityStreamsOrganizationName string = "Organization"
 // ActivityStreamsPageName is the string literal of the name for the Page type in the ActivityStreams vocabulary.
 var ActivityStreamsPageName string = "Page"
 
+// ForgeFedPatchName is the string literal of the name for the Patch type in the ForgeFed vocabulary.
+var ForgeFedPatchName string = "Patch"
+
 // ActivityStreamsPersonName is the string literal of the name for the Person type in the ActivityStreams vocabulary.
 var ActivityStreamsPersonName string = "Person"
 
@@ -122,9 +134,15 @@ var ActivityStreamsPlaceName string = "Place"
 // ActivityStreamsProfileName is the string literal of the name for the Profile type in the ActivityStreams vocabulary.
 var ActivityStreamsProfileName string = "Profile"
 
+// SchemaPropertyValueName is the string literal of the name for the PropertyValue type in the Schema vocabulary.
+var SchemaPropertyValueName string = "PropertyValue"
+
 // W3IDSecurityV1PublicKeyName is the string literal of the name for the PublicKey type in the W3IDSecurityV1 vocabulary.
 var W3IDSecurityV1PublicKeyName string = "PublicKey"
 
+// ForgeFedPushName is the string literal of the name for the Push type in the ForgeFed vocabulary.
+var ForgeFedPushName string = "Push"
+
 // ActivityStreamsQuestionName is the string literal of the name for the Question type in the ActivityStreams vocabulary.
 var ActivityStreamsQuestionName string = "Question"
 
@@ -140,6 +158,9 @@ var ActivityStreamsRelationshipName string = "Relationship"
 // ActivityStreamsRemoveName is the string literal of the name for the Remove type in the ActivityStreams vocabulary.
 var ActivityStreamsRemoveName string = "Remove"
 
+// ForgeFedRepositoryName is the string literal of the name for the Repository type in the ForgeFed vocabulary.
+var ForgeFedRepositoryName string = "Repository"
+
 // ActivityStreamsServiceName is the string literal of the name for the Service type in the ActivityStreams vocabulary.
 var ActivityStreamsServiceName string = "Service"
 
@@ -149,6 +170,12 @@ var ActivityStreamsTentativeAcceptName string = "TentativeAccept"
 // ActivityStreamsTentativeRejectName is the string literal of the name for the TentativeReject type in the ActivityStreams vocabulary.
 var ActivityStreamsTentativeRejectName string = "TentativeReject"
 
+// ForgeFedTicketName is the string literal of the name for the Ticket type in the ForgeFed vocabulary.
+var ForgeFedTicketName string = "Ticket"
+
+// ForgeFedTicketDependencyName is the string literal of the name for the TicketDependency type in the ForgeFed vocabulary.
+var ForgeFedTicketDependencyName string = "TicketDependency"
+
 // ActivityStreamsTombstoneName is the string literal of the name for the Tombstone type in the ActivityStreams vocabulary.
 var ActivityStreamsTombstoneName string = "Tombstone"
 
@@ -173,12 +200,18 @@ var ActivityStreamsAccuracyPropertyName string = "accuracy"
 // ActivityStreamsActorPropertyName is the string literal of the name for the actor property in the ActivityStreams vocabulary.
 var ActivityStreamsActorPropertyName string = "actor"
 
+// ActivityStreamsAlsoKnownAsPropertyName is the string literal of the name for the alsoKnownAs property in the ActivityStreams vocabulary.
+var ActivityStreamsAlsoKnownAsPropertyName string = "alsoKnownAs"
+
 // ActivityStreamsAltitudePropertyName is the string literal of the name for the altitude property in the ActivityStreams vocabulary.
 var ActivityStreamsAltitudePropertyName string = "altitude"
 
 // ActivityStreamsAnyOfPropertyName is the string literal of the name for the anyOf property in the ActivityStreams vocabulary.
 var ActivityStreamsAnyOfPropertyName string = "anyOf"
 
+// ForgeFedAssignedToPropertyName is the string literal of the name for the assignedTo property in the ForgeFed vocabulary.
+var ForgeFedAssignedToPropertyName string = "assignedTo"
+
 // ActivityStreamsAttachmentPropertyName is the string literal of the name for the attachment property in the ActivityStreams vocabulary.
 var ActivityStreamsAttachmentPropertyName string = "attachment"
 
@@ -191,6 +224,9 @@ var ActivityStreamsAudiencePropertyName string = "audience"
 // ActivityStreamsBccPropertyName is the string literal of the name for the bcc property in the ActivityStreams vocabulary.
 var ActivityStreamsBccPropertyName string = "bcc"
 
+// TootBlurhashPropertyName is the string literal of the name for the blurhash property in the Toot vocabulary.
+var TootBlurhashPropertyName string = "blurhash"
+
 // ActivityStreamsBtoPropertyName is the string literal of the name for the bto property in the ActivityStreams vocabulary.
 var ActivityStreamsBtoPropertyName string = "bto"
 
@@ -200,6 +236,12 @@ var ActivityStreamsCcPropertyName string = "cc"
 // ActivityStreamsClosedPropertyName is the string literal of the name for the closed property in the ActivityStreams vocabulary.
 var ActivityStreamsClosedPropertyName string = "closed"
 
+// ForgeFedCommittedPropertyName is the string literal of the name for the committed property in the ForgeFed vocabulary.
+var ForgeFedCommittedPropertyName string = "committed"
+
+// ForgeFedCommittedByPropertyName is the string literal of the name for the committedBy property in the ForgeFed vocabulary.
+var ForgeFedCommittedByPropertyName string = "committedBy"
+
 // ActivityStreamsContentPropertyName is the string literal of the name for the content property in the ActivityStreams vocabulary.
 var ActivityStreamsContentPropertyName string = "content"
 
@@ -209,36 +251,90 @@ var ActivityStreamsContentPropertyMapName string = "contentMap"
 // ActivityStreamsContextPropertyName is the string literal of the name for the context property in the ActivityStreams vocabulary.
 var ActivityStreamsContextPropertyName string = "context"
 
+// ForgeFedCreatedPropertyName is the string literal of the name for the created property in the ForgeFed vocabulary.
+var ForgeFedCreatedPropertyName string = "created"
+
 // ActivityStreamsCurrentPropertyName is the string literal of the name for the current property in the ActivityStreams vocabulary.
 var ActivityStreamsCurrentPropertyName string = "current"
 
 // ActivityStreamsDeletedPropertyName is the string literal of the name for the deleted property in the ActivityStreams vocabulary.
 var ActivityStreamsDeletedPropertyName string = "deleted"
 
+// ForgeFedDependantsPropertyName is the string literal of the name for the dependants property in the ForgeFed vocabulary.
+var ForgeFedDependantsPropertyName string = "dependants"
+
+// ForgeFedDependedByPropertyName is the string literal of the name for the dependedBy property in the ForgeFed vocabulary.
+var ForgeFedDependedByPropertyName string = "dependedBy"
+
+// ForgeFedDependenciesPropertyName is the string literal of the name for the dependencies property in the ForgeFed vocabulary.
+var ForgeFedDependenciesPropertyName string = "dependencies"
+
+// ForgeFedDependsOnPropertyName is the string literal of the name for the dependsOn property in the ForgeFed vocabulary.
+var ForgeFedDependsOnPropertyName string = "dependsOn"
+
 // ActivityStreamsDescribesPropertyName is the string literal of the name for the describes property in the ActivityStreams vocabulary.
 var ActivityStreamsDescribesPropertyName string = "describes"
 
+// ForgeFedDescriptionPropertyName is the string literal of the name for the description property in the ForgeFed vocabulary.
+var ForgeFedDescriptionPropertyName string = "description"
+
+// TootDiscoverablePropertyName is the string literal of the name for the discoverable property in the Toot vocabulary.
+var TootDiscoverablePropertyName string = "discoverable"
+
 // ActivityStreamsDurationPropertyName is the string literal of the name for the duration property in the ActivityStreams vocabulary.
 var ActivityStreamsDurationPropertyName string = "duration"
 
+// ForgeFedEarlyItemsPropertyName is the string literal of the name for the earlyItems property in the ForgeFed vocabulary.
+var ForgeFedEarlyItemsPropertyName string = "earlyItems"
+
 // ActivityStreamsEndTimePropertyName is the string literal of the name for the endTime property in the ActivityStreams vocabulary.
 var ActivityStreamsEndTimePropertyName string = "endTime"
 
+// TootFeaturedPropertyName is the string literal of the name for the featured property in the Toot vocabulary.
+var TootFeaturedPropertyName string = "featured"
+
+// TootFeaturedTagsPropertyName is the string literal of the name for the featuredTags property in the Toot vocabulary.
+var TootFeaturedTagsPropertyName string = "featuredTags"
+
+// ForgeFedFilesAddedPropertyName is the string literal of the name for the filesAdded property in the ForgeFed vocabulary.
+var ForgeFedFilesAddedPropertyName string = "filesAdded"
+
+// ForgeFedFilesModifiedPropertyName is the string literal of the name for the filesModified property in the ForgeFed vocabulary.
+var ForgeFedFilesModifiedPropertyName string = "filesModified"
+
+// ForgeFedFilesRemovedPropertyName is the string literal of the name for the filesRemoved property in the ForgeFed vocabulary.
+var ForgeFedFilesRemovedPropertyName string = "filesRemoved"
+
 // ActivityStreamsFirstPropertyName is the string literal of the name for the first property in the ActivityStreams vocabulary.
 var ActivityStreamsFirstPropertyName string = "first"
 
+// TootFocalPointPropertyName is the string literal of the name for the focalPoint property in the Toot vocabulary.
+var TootFocalPointPropertyName string = "focalPoint"
+
 // ActivityStreamsFollowersPropertyName is the string literal of the name for the followers property in the ActivityStreams vocabulary.
 var ActivityStreamsFollowersPropertyName string = "followers"
 
 // ActivityStreamsFollowingPropertyName is the string literal of the name for the following property in the ActivityStreams vocabulary.
 var ActivityStreamsFollowingPropertyName string = "following"
 
+// ForgeFedForksPropertyName is the string literal of the name for the forks property in the ForgeFed vocabulary.
+var ForgeFedForksPropertyName string = "forks"
+
 // ActivityStreamsFormerTypePropertyName is the string literal of the name for the formerType property in the ActivityStreams vocabulary.
 var ActivityStreamsFormerTypePropertyName string = "formerType"
 
 // ActivityStreamsGeneratorPropertyName is the string literal of the name for the generator property in the ActivityStreams vocabulary.
 var ActivityStreamsGeneratorPropertyName string = "generator"
 
+// ForgeFedHashPropertyName is the string literal of the name for the hash property in the ForgeFed vocabulary.
+var ForgeFedHashPropertyName string = "hash"
+
+// ForgeFedHashAfterPropertyName is the string literal of the name for the hashAfter property in the ForgeFed vocabulary.
+var ForgeFedHashAfterPropertyName string = "hashAfter"
+
+// ForgeFedHashBeforePropertyName is the string literal of the name for the hashBefore property in the ForgeFed vocabulary.
+var ForgeFedHashBeforePropertyName string = "hashBefore"
+
 // ActivityStreamsHeightPropertyName is the string literal of the name for the height property in the ActivityStreams vocabulary.
 var ActivityStreamsHeightPropertyName string = "height"
 
@@ -263,6 +359,9 @@ var ActivityStreamsInboxPropertyName string = "inbox"
 // ActivityStreamsInstrumentPropertyName is the string literal of the name for the instrument property in the ActivityStreams vocabulary.
 var ActivityStreamsInstrumentPropertyName string = "instrument"
 
+// ForgeFedIsResolvedPropertyName is the string literal of the name for the isResolved property in the ForgeFed vocabulary.
+var ForgeFedIsResolvedPropertyName string = "isResolved"
+
 // ActivityStreamsItemsPropertyName is the string literal of the name for the items property in the ActivityStreams vocabulary.
 var ActivityStreamsItemsPropertyName string = "items"
 
@@ -284,9 +383,15 @@ var ActivityStreamsLocationPropertyName string = "location"
 // ActivityStreamsLongitudePropertyName is the string literal of the name for the longitude property in the ActivityStreams vocabulary.
 var ActivityStreamsLongitudePropertyName string = "longitude"
 
+// ActivityStreamsManuallyApprovesFollowersPropertyName is the string literal of the name for the manuallyApprovesFollowers property in the ActivityStreams vocabulary.
+var ActivityStreamsManuallyApprovesFollowersPropertyName string = "manuallyApprovesFollowers"
+
 // ActivityStreamsMediaTypePropertyName is the string literal of the name for the mediaType property in the ActivityStreams vocabulary.
 var ActivityStreamsMediaTypePropertyName string = "mediaType"
 
+// ActivityStreamsMovedToPropertyName is the string literal of the name for the movedTo property in the ActivityStreams vocabulary.
+var ActivityStreamsMovedToPropertyName string = "movedTo"
+
 // ActivityStreamsNamePropertyName is the string literal of the name for the name property in the ActivityStreams vocabulary.
 var ActivityStreamsNamePropertyName string = "name"
 
@@ -341,6 +446,9 @@ var ActivityStreamsPublishedPropertyName string = "published"
 // ActivityStreamsRadiusPropertyName is the string literal of the name for the radius property in the ActivityStreams vocabulary.
 var ActivityStreamsRadiusPropertyName string = "radius"
 
+// ForgeFedRefPropertyName is the string literal of the name for the ref property in the ForgeFed vocabulary.
+var ForgeFedRefPropertyName string = "ref"
+
 // ActivityStreamsRelPropertyName is the string literal of the name for the rel property in the ActivityStreams vocabulary.
 var ActivityStreamsRelPropertyName string = "rel"
 
@@ -350,9 +458,18 @@ var ActivityStreamsRelationshipPropertyName string = "relationship"
 // ActivityStreamsRepliesPropertyName is the string literal of the name for the replies property in the ActivityStreams vocabulary.
 var ActivityStreamsRepliesPropertyName string = "replies"
 
+// ForgeFedResolvedPropertyName is the string literal of the name for the resolved property in the ForgeFed vocabulary.
+var ForgeFedResolvedPropertyName string = "resolved"
+
+// ForgeFedResolvedByPropertyName is the string literal of the name for the resolvedBy property in the ForgeFed vocabulary.
+var ForgeFedResolvedByPropertyName string = "resolvedBy"
+
 // ActivityStreamsResultPropertyName is the string literal of the name for the result property in the ActivityStreams vocabulary.
 var ActivityStreamsResultPropertyName string = "result"
 
+// ActivityStreamsSensitivePropertyName is the string literal of the name for the sensitive property in the ActivityStreams vocabulary.
+var ActivityStreamsSensitivePropertyName string = "sensitive"
+
 // ActivityStreamsSharesPropertyName is the string literal of the name for the shares property in the ActivityStreams vocabulary.
 var ActivityStreamsSharesPropertyName string = "shares"
 
@@ -380,12 +497,21 @@ var ActivityStreamsTagPropertyName string = "tag"
 // ActivityStreamsTargetPropertyName is the string literal of the name for the target property in the ActivityStreams vocabulary.
 var ActivityStreamsTargetPropertyName string = "target"
 
+// ForgeFedTeamPropertyName is the string literal of the name for the team property in the ForgeFed vocabulary.
+var ForgeFedTeamPropertyName string = "team"
+
+// ForgeFedTicketsTrackedByPropertyName is the string literal of the name for the ticketsTrackedBy property in the ForgeFed vocabulary.
+var ForgeFedTicketsTrackedByPropertyName string = "ticketsTrackedBy"
+
 // ActivityStreamsToPropertyName is the string literal of the name for the to property in the ActivityStreams vocabulary.
 var ActivityStreamsToPropertyName string = "to"
 
 // ActivityStreamsTotalItemsPropertyName is the string literal of the name for the totalItems property in the ActivityStreams vocabulary.
 var ActivityStreamsTotalItemsPropertyName string = "totalItems"
 
+// ForgeFedTracksTicketsForPropertyName is the string literal of the name for the tracksTicketsFor property in the ForgeFed vocabulary.
+var ForgeFedTracksTicketsForPropertyName string = "tracksTicketsFor"
+
 // ActivityStreamsUnitsPropertyName is the string literal of the name for the units property in the ActivityStreams vocabulary.
 var ActivityStreamsUnitsPropertyName string = "units"
 
@@ -395,5 +521,11 @@ var ActivityStreamsUpdatedPropertyName string = "updated"
 // ActivityStreamsUrlPropertyName is the string literal of the name for the url property in the ActivityStreams vocabulary.
 var ActivityStreamsUrlPropertyName string = "url"
 
+// SchemaValuePropertyName is the string literal of the name for the value property in the Schema vocabulary.
+var SchemaValuePropertyName string = "value"
+
+// TootVotersCountPropertyName is the string literal of the name for the votersCount property in the Toot vocabulary.
+var TootVotersCountPropertyName string = "votersCount"
+
 // ActivityStreamsWidthPropertyName is the string literal of the name for the width property in the ActivityStreams vocabulary.
 var ActivityStreamsWidthPropertyName string = "width"
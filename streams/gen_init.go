@@ -5,6 +5,7 @@ package streams
 import (
 	propertyaccuracy "github.com/go-fed/activity/streams/impl/activitystreams/property_accuracy"
 	propertyactor "github.com/go-fed/activity/streams/impl/activitystreams/property_actor"
+	propertyalsoknownas "github.com/go-fed/activity/streams/impl/activitystreams/property_alsoknownas"
 	propertyaltitude "github.com/go-fed/activity/streams/impl/activitystreams/property_altitude"
 	propertyanyof "github.com/go-fed/activity/streams/impl/activitystreams/property_anyof"
 	propertyattachment "github.com/go-fed/activity/streams/impl/activitystreams/property_attachment"
@@ -41,7 +42,9 @@ import (
 	propertylikes "github.com/go-fed/activity/streams/impl/activitystreams/property_likes"
 	propertylocation "github.com/go-fed/activity/streams/impl/activitystreams/property_location"
 	propertylongitude "github.com/go-fed/activity/streams/impl/activitystreams/property_longitude"
+	propertymanuallyapprovesfollowers "github.com/go-fed/activity/streams/impl/activitystreams/property_manuallyapprovesfollowers"
 	propertymediatype "github.com/go-fed/activity/streams/impl/activitystreams/property_mediatype"
+	propertymovedto "github.com/go-fed/activity/streams/impl/activitystreams/property_movedto"
 	propertyname "github.com/go-fed/activity/streams/impl/activitystreams/property_name"
 	propertynext "github.com/go-fed/activity/streams/impl/activitystreams/property_next"
 	propertyobject "github.com/go-fed/activity/streams/impl/activitystreams/property_object"
@@ -59,6 +62,7 @@ import (
 	propertyrelationship "github.com/go-fed/activity/streams/impl/activitystreams/property_relationship"
 	propertyreplies "github.com/go-fed/activity/streams/impl/activitystreams/property_replies"
 	propertyresult "github.com/go-fed/activity/streams/impl/activitystreams/property_result"
+	propertysensitive "github.com/go-fed/activity/streams/impl/activitystreams/property_sensitive"
 	propertyshares "github.com/go-fed/activity/streams/impl/activitystreams/property_shares"
 	propertystartindex "github.com/go-fed/activity/streams/impl/activitystreams/property_startindex"
 	propertystarttime "github.com/go-fed/activity/streams/impl/activitystreams/property_starttime"
@@ -92,6 +96,7 @@ import (
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -127,6 +132,45 @@ import (
 	typeupdate "github.com/go-fed/activity/streams/impl/activitystreams/type_update"
 	typevideo "github.com/go-fed/activity/streams/impl/activitystreams/type_video"
 	typeview "github.com/go-fed/activity/streams/impl/activitystreams/type_view"
+	propertyassignedto "github.com/go-fed/activity/streams/impl/forgefed/property_assignedto"
+	propertycommitted "github.com/go-fed/activity/streams/impl/forgefed/property_committed"
+	propertycommittedby "github.com/go-fed/activity/streams/impl/forgefed/property_committedby"
+	propertycreated "github.com/go-fed/activity/streams/impl/forgefed/property_created"
+	propertydependants "github.com/go-fed/activity/streams/impl/forgefed/property_dependants"
+	propertydependedby "github.com/go-fed/activity/streams/impl/forgefed/property_dependedby"
+	propertydependencies "github.com/go-fed/activity/streams/impl/forgefed/property_dependencies"
+	propertydependson "github.com/go-fed/activity/streams/impl/forgefed/property_dependson"
+	propertydescription "github.com/go-fed/activity/streams/impl/forgefed/property_description"
+	propertyearlyitems "github.com/go-fed/activity/streams/impl/forgefed/property_earlyitems"
+	propertyfilesadded "github.com/go-fed/activity/streams/impl/forgefed/property_filesadded"
+	propertyfilesmodified "github.com/go-fed/activity/streams/impl/forgefed/property_filesmodified"
+	propertyfilesremoved "github.com/go-fed/activity/streams/impl/forgefed/property_filesremoved"
+	propertyforks "github.com/go-fed/activity/streams/impl/forgefed/property_forks"
+	propertyhash "github.com/go-fed/activity/streams/impl/forgefed/property_hash"
+	propertyhashafter "github.com/go-fed/activity/streams/impl/forgefed/property_hashafter"
+	propertyhashbefore "github.com/go-fed/activity/streams/impl/forgefed/property_hashbefore"
+	propertyisresolved "github.com/go-fed/activity/streams/impl/forgefed/property_isresolved"
+	propertyref "github.com/go-fed/activity/streams/impl/forgefed/property_ref"
+	propertyresolved "github.com/go-fed/activity/streams/impl/forgefed/property_resolved"
+	propertyresolvedby "github.com/go-fed/activity/streams/impl/forgefed/property_resolvedby"
+	propertyteam "github.com/go-fed/activity/streams/impl/forgefed/property_team"
+	propertyticketstrackedby "github.com/go-fed/activity/streams/impl/forgefed/property_ticketstrackedby"
+	propertytracksticketsfor "github.com/go-fed/activity/streams/impl/forgefed/property_tracksticketsfor"
+	typecommit "github.com/go-fed/activity/streams/impl/forgefed/type_commit"
+	typepatch "github.com/go-fed/activity/streams/impl/forgefed/type_patch"
+	typepush "github.com/go-fed/activity/streams/impl/forgefed/type_push"
+	typerepository "github.com/go-fed/activity/streams/impl/forgefed/type_repository"
+	typeticket "github.com/go-fed/activity/streams/impl/forgefed/type_ticket"
+	typeticketdependency "github.com/go-fed/activity/streams/impl/forgefed/type_ticketdependency"
+	propertyvalue "github.com/go-fed/activity/streams/impl/schema/property_value"
+	typepropertyvalue "github.com/go-fed/activity/streams/impl/schema/type_propertyvalue"
+	propertyblurhash "github.com/go-fed/activity/streams/impl/toot/property_blurhash"
+	propertydiscoverable "github.com/go-fed/activity/streams/impl/toot/property_discoverable"
+	propertyfeatured "github.com/go-fed/activity/streams/impl/toot/property_featured"
+	propertyfeaturedtags "github.com/go-fed/activity/streams/impl/toot/property_featuredtags"
+	propertyfocalpoint "github.com/go-fed/activity/streams/impl/toot/property_focalpoint"
+	propertyvoterscount "github.com/go-fed/activity/streams/impl/toot/property_voterscount"
+	typeemoji "github.com/go-fed/activity/streams/impl/toot/type_emoji"
 	propertyowner "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_owner"
 	propertypublickey "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_publickey"
 	propertypublickeypem "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_publickeypem"
@@ -148,6 +192,7 @@ func init() {
 	mgr = &Manager{}
 	propertyaccuracy.SetManager(mgr)
 	propertyactor.SetManager(mgr)
+	propertyalsoknownas.SetManager(mgr)
 	propertyaltitude.SetManager(mgr)
 	propertyanyof.SetManager(mgr)
 	propertyattachment.SetManager(mgr)
@@ -184,7 +229,9 @@ func init() {
 	propertylikes.SetManager(mgr)
 	propertylocation.SetManager(mgr)
 	propertylongitude.SetManager(mgr)
+	propertymanuallyapprovesfollowers.SetManager(mgr)
 	propertymediatype.SetManager(mgr)
+	propertymovedto.SetManager(mgr)
 	propertyname.SetManager(mgr)
 	propertynext.SetManager(mgr)
 	propertyobject.SetManager(mgr)
@@ -202,6 +249,7 @@ func init() {
 	propertyrelationship.SetManager(mgr)
 	propertyreplies.SetManager(mgr)
 	propertyresult.SetManager(mgr)
+	propertysensitive.SetManager(mgr)
 	propertyshares.SetManager(mgr)
 	propertystartindex.SetManager(mgr)
 	propertystarttime.SetManager(mgr)
@@ -235,6 +283,7 @@ func init() {
 	typeflag.SetManager(mgr)
 	typefollow.SetManager(mgr)
 	typegroup.SetManager(mgr)
+	typehashtag.SetManager(mgr)
 	typeignore.SetManager(mgr)
 	typeimage.SetManager(mgr)
 	typeintransitiveactivity.SetManager(mgr)
@@ -270,6 +319,45 @@ func init() {
 	typeupdate.SetManager(mgr)
 	typevideo.SetManager(mgr)
 	typeview.SetManager(mgr)
+	propertyassignedto.SetManager(mgr)
+	propertycommitted.SetManager(mgr)
+	propertycommittedby.SetManager(mgr)
+	propertycreated.SetManager(mgr)
+	propertydependants.SetManager(mgr)
+	propertydependedby.SetManager(mgr)
+	propertydependencies.SetManager(mgr)
+	propertydependson.SetManager(mgr)
+	propertydescription.SetManager(mgr)
+	propertyearlyitems.SetManager(mgr)
+	propertyfilesadded.SetManager(mgr)
+	propertyfilesmodified.SetManager(mgr)
+	propertyfilesremoved.SetManager(mgr)
+	propertyforks.SetManager(mgr)
+	propertyhash.SetManager(mgr)
+	propertyhashafter.SetManager(mgr)
+	propertyhashbefore.SetManager(mgr)
+	propertyisresolved.SetManager(mgr)
+	propertyref.SetManager(mgr)
+	propertyresolved.SetManager(mgr)
+	propertyresolvedby.SetManager(mgr)
+	propertyteam.SetManager(mgr)
+	propertyticketstrackedby.SetManager(mgr)
+	propertytracksticketsfor.SetManager(mgr)
+	typecommit.SetManager(mgr)
+	typepatch.SetManager(mgr)
+	typepush.SetManager(mgr)
+	typerepository.SetManager(mgr)
+	typeticket.SetManager(mgr)
+	typeticketdependency.SetManager(mgr)
+	propertyvalue.SetManager(mgr)
+	typepropertyvalue.SetManager(mgr)
+	propertyblurhash.SetManager(mgr)
+	propertydiscoverable.SetManager(mgr)
+	propertyfeatured.SetManager(mgr)
+	propertyfeaturedtags.SetManager(mgr)
+	propertyfocalpoint.SetManager(mgr)
+	propertyvoterscount.SetManager(mgr)
+	typeemoji.SetManager(mgr)
 	propertyowner.SetManager(mgr)
 	propertypublickey.SetManager(mgr)
 	propertypublickeypem.SetManager(mgr)
@@ -293,6 +381,7 @@ func init() {
 	typeflag.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typefollow.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typegroup.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typehashtag.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeignore.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeimage.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeintransitiveactivity.SetTypePropertyConstructor(NewJSONLDTypeProperty)
@@ -328,5 +417,13 @@ func init() {
 	typeupdate.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typevideo.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeview.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typecommit.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typepatch.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typepush.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typerepository.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typeticket.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typeticketdependency.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typepropertyvalue.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typeemoji.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typepublickey.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 }
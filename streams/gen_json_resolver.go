@@ -10,6 +10,9 @@ import (
 	"strings"
 )
 
+// extensionTypeResolver deserializes the types not handled by the generated code, such as those of extension vocabularies registered at runtime. It returns ErrUnhandledType if it cannot. It is nil unless set outside of the generated code.
+var extensionTypeResolver func(typeString string, m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error)
+
 // JSONResolver resolves a JSON-deserialized map into its concrete ActivityStreams
 // type
 type JSONResolver struct {
@@ -25,8 +28,13 @@ type JSONResolver struct {
 //   func(context.Context, <TypeInterface>) error
 //
 // where TypeInterface is the code-generated interface for an ActivityStream
-// type. An error is returned if a callback function does not match this
-// signature.
+// type. A callback of the form:
+//
+//   func(context.Context, vocab.Type) error
+//
+// receives the types not handled by the generated code that are resolved by
+// extensions, such as those registered at runtime. An error is returned if a
+// callback function does not match these signatures.
 func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 	for _, cb := range callbacks {
 		// Each callback function must satisfy one known function signature, or else we will generate a runtime error instead of silently fail.
@@ -53,6 +61,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsCollectionPage) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedCommit) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsCreate) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsDelete) error:
@@ -61,6 +71,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsDocument) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.TootEmoji) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsEvent) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsFlag) error:
@@ -69,6 +81,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsGroup) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ActivityStreamsHashtag) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsIgnore) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsImage) error:
@@ -105,14 +119,20 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsPage) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedPatch) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsPerson) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsPlace) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsProfile) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.SchemaPropertyValue) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.W3IDSecurityV1PublicKey) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedPush) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsQuestion) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsRead) error:
@@ -123,12 +143,18 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsRemove) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedRepository) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsService) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTentativeAccept) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTentativeReject) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedTicket) error:
+			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedTicketDependency) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTombstone) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTravel) error:
@@ -141,6 +167,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsView) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.Type) error:
+			// Do nothing, this callback accepts the types resolved by extensions.
 		default:
 			return nil, errors.New("a callback function is of the wrong signature and would never be called")
 		}
@@ -223,6 +251,27 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 		if len(ActivityStreamsAlias) > 0 {
 			ActivityStreamsAlias += ":"
 		}
+		ForgeFedAlias, ok := aliasMap["https://forgefed.org/ns"]
+		if !ok {
+			ForgeFedAlias = aliasMap["http://forgefed.org/ns"]
+		}
+		if len(ForgeFedAlias) > 0 {
+			ForgeFedAlias += ":"
+		}
+		TootAlias, ok := aliasMap["https://joinmastodon.org/ns"]
+		if !ok {
+			TootAlias = aliasMap["http://joinmastodon.org/ns"]
+		}
+		if len(TootAlias) > 0 {
+			TootAlias += ":"
+		}
+		SchemaAlias, ok := aliasMap["https://schema.org"]
+		if !ok {
+			SchemaAlias = aliasMap["http://schema.org"]
+		}
+		if len(SchemaAlias) > 0 {
+			SchemaAlias += ":"
+		}
 		W3IDSecurityV1Alias, ok := aliasMap["https://w3id.org/security/v1"]
 		if !ok {
 			W3IDSecurityV1Alias = aliasMap["http://w3id.org/security/v1"]
@@ -352,6 +401,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ForgeFedAlias+"Commit" {
+			v, err := mgr.DeserializeCommitForgeFed()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ForgeFedCommit) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Create" {
 			v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -396,6 +456,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == TootAlias+"Emoji" {
+			v, err := mgr.DeserializeEmojiToot()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.TootEmoji) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Event" {
 			v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -440,6 +511,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ActivityStreamsAlias+"Hashtag" {
+			v, err := mgr.DeserializeHashtagActivityStreams()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ActivityStreamsHashtag) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Ignore" {
 			v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -638,6 +720,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ForgeFedAlias+"Patch" {
+			v, err := mgr.DeserializePatchForgeFed()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ForgeFedPatch) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Person" {
 			v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -671,6 +764,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == SchemaAlias+"PropertyValue" {
+			v, err := mgr.DeserializePropertyValueSchema()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.SchemaPropertyValue) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == W3IDSecurityV1Alias+"PublicKey" {
 			v, err := mgr.DeserializePublicKeyW3IDSecurityV1()(m, aliasMap)
 			if err != nil {
@@ -682,6 +786,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ForgeFedAlias+"Push" {
+			v, err := mgr.DeserializePushForgeFed()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ForgeFedPush) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Question" {
 			v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -737,6 +852,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ForgeFedAlias+"Repository" {
+			v, err := mgr.DeserializeRepositoryForgeFed()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ForgeFedRepository) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Service" {
 			v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -770,6 +896,28 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ForgeFedAlias+"Ticket" {
+			v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ForgeFedTicket) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
+		} else if typeString == ForgeFedAlias+"TicketDependency" {
+			v, err := mgr.DeserializeTicketDependencyForgeFed()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ForgeFedTicketDependency) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Tombstone" {
 			v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -836,6 +984,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if extensionTypeResolver != nil {
+			v, err := extensionTypeResolver(typeString, m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.Type) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else {
 			return ErrUnhandledType
 		}
@@ -5,6 +5,7 @@ package streams
 import (
 	propertyaccuracy "github.com/go-fed/activity/streams/impl/activitystreams/property_accuracy"
 	propertyactor "github.com/go-fed/activity/streams/impl/activitystreams/property_actor"
+	propertyalsoknownas "github.com/go-fed/activity/streams/impl/activitystreams/property_alsoknownas"
 	propertyaltitude "github.com/go-fed/activity/streams/impl/activitystreams/property_altitude"
 	propertyanyof "github.com/go-fed/activity/streams/impl/activitystreams/property_anyof"
 	propertyattachment "github.com/go-fed/activity/streams/impl/activitystreams/property_attachment"
@@ -41,7 +42,9 @@ import (
 	propertylikes "github.com/go-fed/activity/streams/impl/activitystreams/property_likes"
 	propertylocation "github.com/go-fed/activity/streams/impl/activitystreams/property_location"
 	propertylongitude "github.com/go-fed/activity/streams/impl/activitystreams/property_longitude"
+	propertymanuallyapprovesfollowers "github.com/go-fed/activity/streams/impl/activitystreams/property_manuallyapprovesfollowers"
 	propertymediatype "github.com/go-fed/activity/streams/impl/activitystreams/property_mediatype"
+	propertymovedto "github.com/go-fed/activity/streams/impl/activitystreams/property_movedto"
 	propertyname "github.com/go-fed/activity/streams/impl/activitystreams/property_name"
 	propertynext "github.com/go-fed/activity/streams/impl/activitystreams/property_next"
 	propertyobject "github.com/go-fed/activity/streams/impl/activitystreams/property_object"
@@ -59,6 +62,7 @@ import (
 	propertyrelationship "github.com/go-fed/activity/streams/impl/activitystreams/property_relationship"
 	propertyreplies "github.com/go-fed/activity/streams/impl/activitystreams/property_replies"
 	propertyresult "github.com/go-fed/activity/streams/impl/activitystreams/property_result"
+	propertysensitive "github.com/go-fed/activity/streams/impl/activitystreams/property_sensitive"
 	propertyshares "github.com/go-fed/activity/streams/impl/activitystreams/property_shares"
 	propertystartindex "github.com/go-fed/activity/streams/impl/activitystreams/property_startindex"
 	propertystarttime "github.com/go-fed/activity/streams/impl/activitystreams/property_starttime"
@@ -92,6 +96,7 @@ import (
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -127,8 +132,47 @@ import (
 	typeupdate "github.com/go-fed/activity/streams/impl/activitystreams/type_update"
 	typevideo "github.com/go-fed/activity/streams/impl/activitystreams/type_video"
 	typeview "github.com/go-fed/activity/streams/impl/activitystreams/type_view"
+	propertyassignedto "github.com/go-fed/activity/streams/impl/forgefed/property_assignedto"
+	propertycommitted "github.com/go-fed/activity/streams/impl/forgefed/property_committed"
+	propertycommittedby "github.com/go-fed/activity/streams/impl/forgefed/property_committedby"
+	propertycreated "github.com/go-fed/activity/streams/impl/forgefed/property_created"
+	propertydependants "github.com/go-fed/activity/streams/impl/forgefed/property_dependants"
+	propertydependedby "github.com/go-fed/activity/streams/impl/forgefed/property_dependedby"
+	propertydependencies "github.com/go-fed/activity/streams/impl/forgefed/property_dependencies"
+	propertydependson "github.com/go-fed/activity/streams/impl/forgefed/property_dependson"
+	propertydescription "github.com/go-fed/activity/streams/impl/forgefed/property_description"
+	propertyearlyitems "github.com/go-fed/activity/streams/impl/forgefed/property_earlyitems"
+	propertyfilesadded "github.com/go-fed/activity/streams/impl/forgefed/property_filesadded"
+	propertyfilesmodified "github.com/go-fed/activity/streams/impl/forgefed/property_filesmodified"
+	propertyfilesremoved "github.com/go-fed/activity/streams/impl/forgefed/property_filesremoved"
+	propertyforks "github.com/go-fed/activity/streams/impl/forgefed/property_forks"
+	propertyhash "github.com/go-fed/activity/streams/impl/forgefed/property_hash"
+	propertyhashafter "github.com/go-fed/activity/streams/impl/forgefed/property_hashafter"
+	propertyhashbefore "github.com/go-fed/activity/streams/impl/forgefed/property_hashbefore"
+	propertyisresolved "github.com/go-fed/activity/streams/impl/forgefed/property_isresolved"
+	propertyref "github.com/go-fed/activity/streams/impl/forgefed/property_ref"
+	propertyresolved "github.com/go-fed/activity/streams/impl/forgefed/property_resolved"
+	propertyresolvedby "github.com/go-fed/activity/streams/impl/forgefed/property_resolvedby"
+	propertyteam "github.com/go-fed/activity/streams/impl/forgefed/property_team"
+	propertyticketstrackedby "github.com/go-fed/activity/streams/impl/forgefed/property_ticketstrackedby"
+	propertytracksticketsfor "github.com/go-fed/activity/streams/impl/forgefed/property_tracksticketsfor"
+	typecommit "github.com/go-fed/activity/streams/impl/forgefed/type_commit"
+	typepatch "github.com/go-fed/activity/streams/impl/forgefed/type_patch"
+	typepush "github.com/go-fed/activity/streams/impl/forgefed/type_push"
+	typerepository "github.com/go-fed/activity/streams/impl/forgefed/type_repository"
+	typeticket "github.com/go-fed/activity/streams/impl/forgefed/type_ticket"
+	typeticketdependency "github.com/go-fed/activity/streams/impl/forgefed/type_ticketdependency"
 	propertyid "github.com/go-fed/activity/streams/impl/jsonld/property_id"
 	propertytype "github.com/go-fed/activity/streams/impl/jsonld/property_type"
+	propertyvalue "github.com/go-fed/activity/streams/impl/schema/property_value"
+	typepropertyvalue "github.com/go-fed/activity/streams/impl/schema/type_propertyvalue"
+	propertyblurhash "github.com/go-fed/activity/streams/impl/toot/property_blurhash"
+	propertydiscoverable "github.com/go-fed/activity/streams/impl/toot/property_discoverable"
+	propertyfeatured "github.com/go-fed/activity/streams/impl/toot/property_featured"
+	propertyfeaturedtags "github.com/go-fed/activity/streams/impl/toot/property_featuredtags"
+	propertyfocalpoint "github.com/go-fed/activity/streams/impl/toot/property_focalpoint"
+	propertyvoterscount "github.com/go-fed/activity/streams/impl/toot/property_voterscount"
+	typeemoji "github.com/go-fed/activity/streams/impl/toot/type_emoji"
 	propertyowner "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_owner"
 	propertypublickey "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_publickey"
 	propertypublickeypem "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_publickeypem"
@@ -207,6 +251,19 @@ func (this Manager) DeserializeAddActivityStreams() func(map[string]interface{},
 	}
 }
 
+// DeserializeAlsoKnownAsPropertyActivityStreams returns the deserialization
+// method for the "ActivityStreamsAlsoKnownAsProperty" non-functional property
+// in the vocabulary "ActivityStreams"
+func (this Manager) DeserializeAlsoKnownAsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error) {
+		i, err := propertyalsoknownas.DeserializeAlsoKnownAsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeAltitudePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsAltitudeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -285,6 +342,19 @@ func (this Manager) DeserializeArticleActivityStreams() func(map[string]interfac
 	}
 }
 
+// DeserializeAssignedToPropertyForgeFed returns the deserialization method for
+// the "ForgeFedAssignedToProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeAssignedToPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedAssignedToProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedAssignedToProperty, error) {
+		i, err := propertyassignedto.DeserializeAssignedToProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeAttachmentPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsAttachmentProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -363,6 +433,18 @@ func (this Manager) DeserializeBlockActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeBlurhashPropertyToot returns the deserialization method for the
+// "TootBlurhashProperty" non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeBlurhashPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootBlurhashProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootBlurhashProperty, error) {
+		i, err := propertyblurhash.DeserializeBlurhashProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeBtoPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsBtoProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -428,6 +510,44 @@ func (this Manager) DeserializeCollectionPageActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeCommitForgeFed returns the deserialization method for the
+// "ForgeFedCommit" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeCommitForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedCommit, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedCommit, error) {
+		i, err := typecommit.DeserializeCommit(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeCommittedByPropertyForgeFed returns the deserialization method for
+// the "ForgeFedCommittedByProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeCommittedByPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedCommittedByProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedCommittedByProperty, error) {
+		i, err := propertycommittedby.DeserializeCommittedByProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeCommittedPropertyForgeFed returns the deserialization method for the
+// "ForgeFedCommittedProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeCommittedPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedCommittedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedCommittedProperty, error) {
+		i, err := propertycommitted.DeserializeCommittedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeContentPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsContentProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -467,6 +587,19 @@ func (this Manager) DeserializeCreateActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeCreatedPropertyForgeFed returns the deserialization method for the
+// "ForgeFedCreatedProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeCreatedPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedCreatedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedCreatedProperty, error) {
+		i, err := propertycreated.DeserializeCreatedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeCurrentPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsCurrentProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -506,6 +639,58 @@ func (this Manager) DeserializeDeletedPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeDependantsPropertyForgeFed returns the deserialization method for
+// the "ForgeFedDependantsProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeDependantsPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedDependantsProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDependantsProperty, error) {
+		i, err := propertydependants.DeserializeDependantsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeDependedByPropertyForgeFed returns the deserialization method for
+// the "ForgeFedDependedByProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeDependedByPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedDependedByProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDependedByProperty, error) {
+		i, err := propertydependedby.DeserializeDependedByProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeDependenciesPropertyForgeFed returns the deserialization method for
+// the "ForgeFedDependenciesProperty" non-functional property in the
+// vocabulary "ForgeFed"
+func (this Manager) DeserializeDependenciesPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedDependenciesProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDependenciesProperty, error) {
+		i, err := propertydependencies.DeserializeDependenciesProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeDependsOnPropertyForgeFed returns the deserialization method for the
+// "ForgeFedDependsOnProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeDependsOnPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedDependsOnProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDependsOnProperty, error) {
+		i, err := propertydependson.DeserializeDependsOnProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeDescribesPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsDescribesProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -519,6 +704,31 @@ func (this Manager) DeserializeDescribesPropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeDescriptionPropertyForgeFed returns the deserialization method for
+// the "ForgeFedDescriptionProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeDescriptionPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedDescriptionProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDescriptionProperty, error) {
+		i, err := propertydescription.DeserializeDescriptionProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeDiscoverablePropertyToot returns the deserialization method for the
+// "TootDiscoverableProperty" non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeDiscoverablePropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootDiscoverableProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootDiscoverableProperty, error) {
+		i, err := propertydiscoverable.DeserializeDiscoverableProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeDislikeActivityStreams returns the deserialization method for the
 // "ActivityStreamsDislike" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -558,6 +768,31 @@ func (this Manager) DeserializeDurationPropertyActivityStreams() func(map[string
 	}
 }
 
+// DeserializeEarlyItemsPropertyForgeFed returns the deserialization method for
+// the "ForgeFedEarlyItemsProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeEarlyItemsPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedEarlyItemsProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedEarlyItemsProperty, error) {
+		i, err := propertyearlyitems.DeserializeEarlyItemsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeEmojiToot returns the deserialization method for the "TootEmoji"
+// non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeEmojiToot() func(map[string]interface{}, map[string]string) (vocab.TootEmoji, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootEmoji, error) {
+		i, err := typeemoji.DeserializeEmoji(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeEndTimePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsEndTimeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -584,6 +819,69 @@ func (this Manager) DeserializeEventActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeFeaturedPropertyToot returns the deserialization method for the
+// "TootFeaturedProperty" non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeFeaturedPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootFeaturedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootFeaturedProperty, error) {
+		i, err := propertyfeatured.DeserializeFeaturedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeFeaturedTagsPropertyToot returns the deserialization method for the
+// "TootFeaturedTagsProperty" non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeFeaturedTagsPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootFeaturedTagsProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootFeaturedTagsProperty, error) {
+		i, err := propertyfeaturedtags.DeserializeFeaturedTagsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeFilesAddedPropertyForgeFed returns the deserialization method for
+// the "ForgeFedFilesAddedProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeFilesAddedPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedFilesAddedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedFilesAddedProperty, error) {
+		i, err := propertyfilesadded.DeserializeFilesAddedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeFilesModifiedPropertyForgeFed returns the deserialization method for
+// the "ForgeFedFilesModifiedProperty" non-functional property in the
+// vocabulary "ForgeFed"
+func (this Manager) DeserializeFilesModifiedPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedFilesModifiedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedFilesModifiedProperty, error) {
+		i, err := propertyfilesmodified.DeserializeFilesModifiedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeFilesRemovedPropertyForgeFed returns the deserialization method for
+// the "ForgeFedFilesRemovedProperty" non-functional property in the
+// vocabulary "ForgeFed"
+func (this Manager) DeserializeFilesRemovedPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedFilesRemovedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedFilesRemovedProperty, error) {
+		i, err := propertyfilesremoved.DeserializeFilesRemovedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeFirstPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsFirstProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -610,6 +908,18 @@ func (this Manager) DeserializeFlagActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeFocalPointPropertyToot returns the deserialization method for the
+// "TootFocalPointProperty" non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeFocalPointPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootFocalPointProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootFocalPointProperty, error) {
+		i, err := propertyfocalpoint.DeserializeFocalPointProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeFollowActivityStreams returns the deserialization method for the
 // "ActivityStreamsFollow" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -649,6 +959,18 @@ func (this Manager) DeserializeFollowingPropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeForksPropertyForgeFed returns the deserialization method for the
+// "ForgeFedForksProperty" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeForksPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedForksProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedForksProperty, error) {
+		i, err := propertyforks.DeserializeForksProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeFormerTypePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsFormerTypeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -688,6 +1010,57 @@ func (this Manager) DeserializeGroupActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeHashAfterPropertyForgeFed returns the deserialization method for the
+// "ForgeFedHashAfterProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeHashAfterPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedHashAfterProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedHashAfterProperty, error) {
+		i, err := propertyhashafter.DeserializeHashAfterProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeHashBeforePropertyForgeFed returns the deserialization method for
+// the "ForgeFedHashBeforeProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeHashBeforePropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedHashBeforeProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedHashBeforeProperty, error) {
+		i, err := propertyhashbefore.DeserializeHashBeforeProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeHashPropertyForgeFed returns the deserialization method for the
+// "ForgeFedHashProperty" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeHashPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedHashProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedHashProperty, error) {
+		i, err := propertyhash.DeserializeHashProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeHashtagActivityStreams returns the deserialization method for the
+// "ActivityStreamsHashtag" non-functional property in the vocabulary
+// "ActivityStreams"
+func (this Manager) DeserializeHashtagActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsHashtag, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHashtag, error) {
+		i, err := typehashtag.DeserializeHashtag(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeHeightPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsHeightProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -856,6 +1229,19 @@ func (this Manager) DeserializeInviteActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeIsResolvedPropertyForgeFed returns the deserialization method for
+// the "ForgeFedIsResolvedProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeIsResolvedPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedIsResolvedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedIsResolvedProperty, error) {
+		i, err := propertyisresolved.DeserializeIsResolvedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeItemsPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsItemsProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1012,6 +1398,20 @@ func (this Manager) DeserializeLongitudePropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeManuallyApprovesFollowersPropertyActivityStreams returns the
+// deserialization method for the
+// "ActivityStreamsManuallyApprovesFollowersProperty" non-functional property
+// in the vocabulary "ActivityStreams"
+func (this Manager) DeserializeManuallyApprovesFollowersPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsManuallyApprovesFollowersProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsManuallyApprovesFollowersProperty, error) {
+		i, err := propertymanuallyapprovesfollowers.DeserializeManuallyApprovesFollowersProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeMediaTypePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsMediaTypeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1051,6 +1451,19 @@ func (this Manager) DeserializeMoveActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeMovedToPropertyActivityStreams returns the deserialization method
+// for the "ActivityStreamsMovedToProperty" non-functional property in the
+// vocabulary "ActivityStreams"
+func (this Manager) DeserializeMovedToPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsMovedToProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsMovedToProperty, error) {
+		i, err := propertymovedto.DeserializeMovedToProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeNamePropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsNameProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1259,6 +1672,18 @@ func (this Manager) DeserializePartOfPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializePatchForgeFed returns the deserialization method for the
+// "ForgeFedPatch" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializePatchForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedPatch, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedPatch, error) {
+		i, err := typepatch.DeserializePatch(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializePersonActivityStreams returns the deserialization method for the
 // "ActivityStreamsPerson" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1337,6 +1762,18 @@ func (this Manager) DeserializeProfileActivityStreams() func(map[string]interfac
 	}
 }
 
+// DeserializePropertyValueSchema returns the deserialization method for the
+// "SchemaPropertyValue" non-functional property in the vocabulary "Schema"
+func (this Manager) DeserializePropertyValueSchema() func(map[string]interface{}, map[string]string) (vocab.SchemaPropertyValue, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.SchemaPropertyValue, error) {
+		i, err := typepropertyvalue.DeserializePropertyValue(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializePublicKeyPemPropertyW3IDSecurityV1 returns the deserialization
 // method for the "W3IDSecurityV1PublicKeyPemProperty" non-functional property
 // in the vocabulary "W3IDSecurityV1"
@@ -1389,6 +1826,18 @@ func (this Manager) DeserializePublishedPropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializePushForgeFed returns the deserialization method for the
+// "ForgeFedPush" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializePushForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedPush, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedPush, error) {
+		i, err := typepush.DeserializePush(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeQuestionActivityStreams returns the deserialization method for the
 // "ActivityStreamsQuestion" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1428,6 +1877,18 @@ func (this Manager) DeserializeReadActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeRefPropertyForgeFed returns the deserialization method for the
+// "ForgeFedRefProperty" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeRefPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedRefProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedRefProperty, error) {
+		i, err := propertyref.DeserializeRefProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeRejectActivityStreams returns the deserialization method for the
 // "ActivityStreamsReject" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1506,6 +1967,44 @@ func (this Manager) DeserializeRepliesPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeRepositoryForgeFed returns the deserialization method for the
+// "ForgeFedRepository" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeRepositoryForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedRepository, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedRepository, error) {
+		i, err := typerepository.DeserializeRepository(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeResolvedByPropertyForgeFed returns the deserialization method for
+// the "ForgeFedResolvedByProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeResolvedByPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedResolvedByProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedResolvedByProperty, error) {
+		i, err := propertyresolvedby.DeserializeResolvedByProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeResolvedPropertyForgeFed returns the deserialization method for the
+// "ForgeFedResolvedProperty" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeResolvedPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedResolvedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedResolvedProperty, error) {
+		i, err := propertyresolved.DeserializeResolvedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeResultPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsResultProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1519,6 +2018,19 @@ func (this Manager) DeserializeResultPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeSensitivePropertyActivityStreams returns the deserialization method
+// for the "ActivityStreamsSensitiveProperty" non-functional property in the
+// vocabulary "ActivityStreams"
+func (this Manager) DeserializeSensitivePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsSensitiveProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsSensitiveProperty, error) {
+		i, err := propertysensitive.DeserializeSensitiveProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeServiceActivityStreams returns the deserialization method for the
 // "ActivityStreamsService" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1636,6 +2148,18 @@ func (this Manager) DeserializeTargetPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeTeamPropertyForgeFed returns the deserialization method for the
+// "ForgeFedTeamProperty" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeTeamPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTeamProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTeamProperty, error) {
+		i, err := propertyteam.DeserializeTeamProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeTentativeAcceptActivityStreams returns the deserialization method
 // for the "ActivityStreamsTentativeAccept" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1662,6 +2186,44 @@ func (this Manager) DeserializeTentativeRejectActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeTicketDependencyForgeFed returns the deserialization method for the
+// "ForgeFedTicketDependency" non-functional property in the vocabulary
+// "ForgeFed"
+func (this Manager) DeserializeTicketDependencyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTicketDependency, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTicketDependency, error) {
+		i, err := typeticketdependency.DeserializeTicketDependency(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeTicketForgeFed returns the deserialization method for the
+// "ForgeFedTicket" non-functional property in the vocabulary "ForgeFed"
+func (this Manager) DeserializeTicketForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTicket, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTicket, error) {
+		i, err := typeticket.DeserializeTicket(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeTicketsTrackedByPropertyForgeFed returns the deserialization method
+// for the "ForgeFedTicketsTrackedByProperty" non-functional property in the
+// vocabulary "ForgeFed"
+func (this Manager) DeserializeTicketsTrackedByPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTicketsTrackedByProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTicketsTrackedByProperty, error) {
+		i, err := propertyticketstrackedby.DeserializeTicketsTrackedByProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeToPropertyActivityStreams returns the deserialization method for the
 // "ActivityStreamsToProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1701,6 +2263,19 @@ func (this Manager) DeserializeTotalItemsPropertyActivityStreams() func(map[stri
 	}
 }
 
+// DeserializeTracksTicketsForPropertyForgeFed returns the deserialization method
+// for the "ForgeFedTracksTicketsForProperty" non-functional property in the
+// vocabulary "ForgeFed"
+func (this Manager) DeserializeTracksTicketsForPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTracksTicketsForProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTracksTicketsForProperty, error) {
+		i, err := propertytracksticketsfor.DeserializeTracksTicketsForProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeTravelActivityStreams returns the deserialization method for the
 // "ActivityStreamsTravel" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1791,6 +2366,18 @@ func (this Manager) DeserializeUrlPropertyActivityStreams() func(map[string]inte
 	}
 }
 
+// DeserializeValuePropertySchema returns the deserialization method for the
+// "SchemaValueProperty" non-functional property in the vocabulary "Schema"
+func (this Manager) DeserializeValuePropertySchema() func(map[string]interface{}, map[string]string) (vocab.SchemaValueProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.SchemaValueProperty, error) {
+		i, err := propertyvalue.DeserializeValueProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeVideoActivityStreams returns the deserialization method for the
 // "ActivityStreamsVideo" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1817,6 +2404,18 @@ func (this Manager) DeserializeViewActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeVotersCountPropertyToot returns the deserialization method for the
+// "TootVotersCountProperty" non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeVotersCountPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootVotersCountProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootVotersCountProperty, error) {
+		i, err := propertyvoterscount.DeserializeVotersCountProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeWidthPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsWidthProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -22,6 +22,7 @@ import (
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -174,6 +175,12 @@ func ActivityStreamsGroupIsDisjointWith(other vocab.Type) bool {
 	return typegroup.GroupIsDisjointWith(other)
 }
 
+// ActivityStreamsHashtagIsDisjointWith returns true if Hashtag is disjoint with
+// the other's type.
+func ActivityStreamsHashtagIsDisjointWith(other vocab.Type) bool {
+	return typehashtag.HashtagIsDisjointWith(other)
+}
+
 // ActivityStreamsIgnoreIsDisjointWith returns true if Ignore is disjoint with the
 // other's type.
 func ActivityStreamsIgnoreIsDisjointWith(other vocab.Type) bool {
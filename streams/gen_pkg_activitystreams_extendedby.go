@@ -22,6 +22,7 @@ import (
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -193,6 +194,13 @@ func ActivityStreamsGroupIsExtendedBy(other vocab.Type) bool {
 	return typegroup.GroupIsExtendedBy(other)
 }
 
+// ActivityStreamsHashtagIsExtendedBy returns true if the other's type extends
+// from Hashtag. Note that it returns false if the types are the same; see the
+// "IsOrExtends" variant instead.
+func ActivityStreamsHashtagIsExtendedBy(other vocab.Type) bool {
+	return typehashtag.HashtagIsExtendedBy(other)
+}
+
 // ActivityStreamsIgnoreIsExtendedBy returns true if the other's type extends from
 // Ignore. Note that it returns false if the types are the same; see the
 // "IsOrExtends" variant instead.
@@ -22,6 +22,7 @@ import (
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -174,6 +175,12 @@ func ActivityStreamsActivityStreamsGroupExtends(other vocab.Type) bool {
 	return typegroup.ActivityStreamsGroupExtends(other)
 }
 
+// ActivityStreamsActivityStreamsHashtagExtends returns true if Hashtag extends
+// from the other's type.
+func ActivityStreamsActivityStreamsHashtagExtends(other vocab.Type) bool {
+	return typehashtag.ActivityStreamsHashtagExtends(other)
+}
+
 // ActivityStreamsActivityStreamsIgnoreExtends returns true if Ignore extends from
 // the other's type.
 func ActivityStreamsActivityStreamsIgnoreExtends(other vocab.Type) bool {
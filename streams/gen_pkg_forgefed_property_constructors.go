@@ -0,0 +1,156 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	propertyassignedto "github.com/go-fed/activity/streams/impl/forgefed/property_assignedto"
+	propertycommitted "github.com/go-fed/activity/streams/impl/forgefed/property_committed"
+	propertycommittedby "github.com/go-fed/activity/streams/impl/forgefed/property_committedby"
+	propertycreated "github.com/go-fed/activity/streams/impl/forgefed/property_created"
+	propertydependants "github.com/go-fed/activity/streams/impl/forgefed/property_dependants"
+	propertydependedby "github.com/go-fed/activity/streams/impl/forgefed/property_dependedby"
+	propertydependencies "github.com/go-fed/activity/streams/impl/forgefed/property_dependencies"
+	propertydependson "github.com/go-fed/activity/streams/impl/forgefed/property_dependson"
+	propertydescription "github.com/go-fed/activity/streams/impl/forgefed/property_description"
+	propertyearlyitems "github.com/go-fed/activity/streams/impl/forgefed/property_earlyitems"
+	propertyfilesadded "github.com/go-fed/activity/streams/impl/forgefed/property_filesadded"
+	propertyfilesmodified "github.com/go-fed/activity/streams/impl/forgefed/property_filesmodified"
+	propertyfilesremoved "github.com/go-fed/activity/streams/impl/forgefed/property_filesremoved"
+	propertyforks "github.com/go-fed/activity/streams/impl/forgefed/property_forks"
+	propertyhash "github.com/go-fed/activity/streams/impl/forgefed/property_hash"
+	propertyhashafter "github.com/go-fed/activity/streams/impl/forgefed/property_hashafter"
+	propertyhashbefore "github.com/go-fed/activity/streams/impl/forgefed/property_hashbefore"
+	propertyisresolved "github.com/go-fed/activity/streams/impl/forgefed/property_isresolved"
+	propertyref "github.com/go-fed/activity/streams/impl/forgefed/property_ref"
+	propertyresolved "github.com/go-fed/activity/streams/impl/forgefed/property_resolved"
+	propertyresolvedby "github.com/go-fed/activity/streams/impl/forgefed/property_resolvedby"
+	propertyteam "github.com/go-fed/activity/streams/impl/forgefed/property_team"
+	propertyticketstrackedby "github.com/go-fed/activity/streams/impl/forgefed/property_ticketstrackedby"
+	propertytracksticketsfor "github.com/go-fed/activity/streams/impl/forgefed/property_tracksticketsfor"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewForgeFedForgeFedAssignedToProperty creates a new ForgeFedAssignedToProperty
+func NewForgeFedAssignedToProperty() vocab.ForgeFedAssignedToProperty {
+	return propertyassignedto.NewForgeFedAssignedToProperty()
+}
+
+// NewForgeFedForgeFedCommittedProperty creates a new ForgeFedCommittedProperty
+func NewForgeFedCommittedProperty() vocab.ForgeFedCommittedProperty {
+	return propertycommitted.NewForgeFedCommittedProperty()
+}
+
+// NewForgeFedForgeFedCommittedByProperty creates a new ForgeFedCommittedByProperty
+func NewForgeFedCommittedByProperty() vocab.ForgeFedCommittedByProperty {
+	return propertycommittedby.NewForgeFedCommittedByProperty()
+}
+
+// NewForgeFedForgeFedCreatedProperty creates a new ForgeFedCreatedProperty
+func NewForgeFedCreatedProperty() vocab.ForgeFedCreatedProperty {
+	return propertycreated.NewForgeFedCreatedProperty()
+}
+
+// NewForgeFedForgeFedDependantsProperty creates a new ForgeFedDependantsProperty
+func NewForgeFedDependantsProperty() vocab.ForgeFedDependantsProperty {
+	return propertydependants.NewForgeFedDependantsProperty()
+}
+
+// NewForgeFedForgeFedDependedByProperty creates a new ForgeFedDependedByProperty
+func NewForgeFedDependedByProperty() vocab.ForgeFedDependedByProperty {
+	return propertydependedby.NewForgeFedDependedByProperty()
+}
+
+// NewForgeFedForgeFedDependenciesProperty creates a new
+// ForgeFedDependenciesProperty
+func NewForgeFedDependenciesProperty() vocab.ForgeFedDependenciesProperty {
+	return propertydependencies.NewForgeFedDependenciesProperty()
+}
+
+// NewForgeFedForgeFedDependsOnProperty creates a new ForgeFedDependsOnProperty
+func NewForgeFedDependsOnProperty() vocab.ForgeFedDependsOnProperty {
+	return propertydependson.NewForgeFedDependsOnProperty()
+}
+
+// NewForgeFedForgeFedDescriptionProperty creates a new ForgeFedDescriptionProperty
+func NewForgeFedDescriptionProperty() vocab.ForgeFedDescriptionProperty {
+	return propertydescription.NewForgeFedDescriptionProperty()
+}
+
+// NewForgeFedForgeFedEarlyItemsProperty creates a new ForgeFedEarlyItemsProperty
+func NewForgeFedEarlyItemsProperty() vocab.ForgeFedEarlyItemsProperty {
+	return propertyearlyitems.NewForgeFedEarlyItemsProperty()
+}
+
+// NewForgeFedForgeFedFilesAddedProperty creates a new ForgeFedFilesAddedProperty
+func NewForgeFedFilesAddedProperty() vocab.ForgeFedFilesAddedProperty {
+	return propertyfilesadded.NewForgeFedFilesAddedProperty()
+}
+
+// NewForgeFedForgeFedFilesModifiedProperty creates a new
+// ForgeFedFilesModifiedProperty
+func NewForgeFedFilesModifiedProperty() vocab.ForgeFedFilesModifiedProperty {
+	return propertyfilesmodified.NewForgeFedFilesModifiedProperty()
+}
+
+// NewForgeFedForgeFedFilesRemovedProperty creates a new
+// ForgeFedFilesRemovedProperty
+func NewForgeFedFilesRemovedProperty() vocab.ForgeFedFilesRemovedProperty {
+	return propertyfilesremoved.NewForgeFedFilesRemovedProperty()
+}
+
+// NewForgeFedForgeFedForksProperty creates a new ForgeFedForksProperty
+func NewForgeFedForksProperty() vocab.ForgeFedForksProperty {
+	return propertyforks.NewForgeFedForksProperty()
+}
+
+// NewForgeFedForgeFedHashProperty creates a new ForgeFedHashProperty
+func NewForgeFedHashProperty() vocab.ForgeFedHashProperty {
+	return propertyhash.NewForgeFedHashProperty()
+}
+
+// NewForgeFedForgeFedHashAfterProperty creates a new ForgeFedHashAfterProperty
+func NewForgeFedHashAfterProperty() vocab.ForgeFedHashAfterProperty {
+	return propertyhashafter.NewForgeFedHashAfterProperty()
+}
+
+// NewForgeFedForgeFedHashBeforeProperty creates a new ForgeFedHashBeforeProperty
+func NewForgeFedHashBeforeProperty() vocab.ForgeFedHashBeforeProperty {
+	return propertyhashbefore.NewForgeFedHashBeforeProperty()
+}
+
+// NewForgeFedForgeFedIsResolvedProperty creates a new ForgeFedIsResolvedProperty
+func NewForgeFedIsResolvedProperty() vocab.ForgeFedIsResolvedProperty {
+	return propertyisresolved.NewForgeFedIsResolvedProperty()
+}
+
+// NewForgeFedForgeFedRefProperty creates a new ForgeFedRefProperty
+func NewForgeFedRefProperty() vocab.ForgeFedRefProperty {
+	return propertyref.NewForgeFedRefProperty()
+}
+
+// NewForgeFedForgeFedResolvedProperty creates a new ForgeFedResolvedProperty
+func NewForgeFedResolvedProperty() vocab.ForgeFedResolvedProperty {
+	return propertyresolved.NewForgeFedResolvedProperty()
+}
+
+// NewForgeFedForgeFedResolvedByProperty creates a new ForgeFedResolvedByProperty
+func NewForgeFedResolvedByProperty() vocab.ForgeFedResolvedByProperty {
+	return propertyresolvedby.NewForgeFedResolvedByProperty()
+}
+
+// NewForgeFedForgeFedTeamProperty creates a new ForgeFedTeamProperty
+func NewForgeFedTeamProperty() vocab.ForgeFedTeamProperty {
+	return propertyteam.NewForgeFedTeamProperty()
+}
+
+// NewForgeFedForgeFedTicketsTrackedByProperty creates a new
+// ForgeFedTicketsTrackedByProperty
+func NewForgeFedTicketsTrackedByProperty() vocab.ForgeFedTicketsTrackedByProperty {
+	return propertyticketstrackedby.NewForgeFedTicketsTrackedByProperty()
+}
+
+// NewForgeFedForgeFedTracksTicketsForProperty creates a new
+// ForgeFedTracksTicketsForProperty
+func NewForgeFedTracksTicketsForProperty() vocab.ForgeFedTracksTicketsForProperty {
+	return propertytracksticketsfor.NewForgeFedTracksTicketsForProperty()
+}
@@ -0,0 +1,43 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	typecommit "github.com/go-fed/activity/streams/impl/forgefed/type_commit"
+	typepatch "github.com/go-fed/activity/streams/impl/forgefed/type_patch"
+	typepush "github.com/go-fed/activity/streams/impl/forgefed/type_push"
+	typerepository "github.com/go-fed/activity/streams/impl/forgefed/type_repository"
+	typeticket "github.com/go-fed/activity/streams/impl/forgefed/type_ticket"
+	typeticketdependency "github.com/go-fed/activity/streams/impl/forgefed/type_ticketdependency"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewForgeFedCommit creates a new ForgeFedCommit
+func NewForgeFedCommit() vocab.ForgeFedCommit {
+	return typecommit.NewForgeFedCommit()
+}
+
+// NewForgeFedPatch creates a new ForgeFedPatch
+func NewForgeFedPatch() vocab.ForgeFedPatch {
+	return typepatch.NewForgeFedPatch()
+}
+
+// NewForgeFedPush creates a new ForgeFedPush
+func NewForgeFedPush() vocab.ForgeFedPush {
+	return typepush.NewForgeFedPush()
+}
+
+// NewForgeFedRepository creates a new ForgeFedRepository
+func NewForgeFedRepository() vocab.ForgeFedRepository {
+	return typerepository.NewForgeFedRepository()
+}
+
+// NewForgeFedTicket creates a new ForgeFedTicket
+func NewForgeFedTicket() vocab.ForgeFedTicket {
+	return typeticket.NewForgeFedTicket()
+}
+
+// NewForgeFedTicketDependency creates a new ForgeFedTicketDependency
+func NewForgeFedTicketDependency() vocab.ForgeFedTicketDependency {
+	return typeticketdependency.NewForgeFedTicketDependency()
+}
@@ -0,0 +1,15 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	typeemoji "github.com/go-fed/activity/streams/impl/toot/type_emoji"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// TootEmojiIsExtendedBy returns true if the other's type extends from Emoji. Note
+// that it returns false if the types are the same; see the "IsOrExtends"
+// variant instead.
+func TootEmojiIsExtendedBy(other vocab.Type) bool {
+	return typeemoji.EmojiIsExtendedBy(other)
+}
@@ -0,0 +1,13 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	typeemoji "github.com/go-fed/activity/streams/impl/toot/type_emoji"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// TootTootEmojiExtends returns true if Emoji extends from the other's type.
+func TootTootEmojiExtends(other vocab.Type) bool {
+	return typeemoji.TootEmojiExtends(other)
+}
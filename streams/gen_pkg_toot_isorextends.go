@@ -0,0 +1,14 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	typeemoji "github.com/go-fed/activity/streams/impl/toot/type_emoji"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// IsOrExtendsTootEmoji returns true if the other provided type is the Emoji type
+// or extends from the Emoji type.
+func IsOrExtendsTootEmoji(other vocab.Type) bool {
+	return typeemoji.IsOrExtendsEmoji(other)
+}
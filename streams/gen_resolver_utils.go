@@ -43,7 +43,8 @@ func IsUnmatchedErr(err error) bool {
 	return err == ErrPredicateUnmatched || err == ErrUnhandledType || err == ErrNoCallbackMatch
 }
 
-// ToType attempts to resolve the generic JSON map into a Type.
+// ToType attempts to resolve the generic JSON map into a Type, including the
+// types resolved by extensions.
 func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err error) {
 	var r *JSONResolver
 	r, err = NewJSONResolver(func(ctx context.Context, i vocab.ActivityStreamsAccept) error {
@@ -79,6 +80,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsCollectionPage) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.ForgeFedCommit) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsCreate) error {
 		t = i
 		return nil
@@ -91,6 +95,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsDocument) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.TootEmoji) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsEvent) error {
 		t = i
 		return nil
@@ -103,6 +110,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsGroup) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.ActivityStreamsHashtag) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsIgnore) error {
 		t = i
 		return nil
@@ -157,6 +167,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsPage) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.ForgeFedPatch) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsPerson) error {
 		t = i
 		return nil
@@ -166,9 +179,15 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsProfile) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.SchemaPropertyValue) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.W3IDSecurityV1PublicKey) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.ForgeFedPush) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsQuestion) error {
 		t = i
 		return nil
@@ -184,6 +203,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsRemove) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.ForgeFedRepository) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsService) error {
 		t = i
 		return nil
@@ -193,6 +215,12 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsTentativeReject) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.ForgeFedTicket) error {
+		t = i
+		return nil
+	}, func(ctx context.Context, i vocab.ForgeFedTicketDependency) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsTombstone) error {
 		t = i
 		return nil
@@ -211,6 +239,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsView) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.Type) error {
+		t = i
+		return nil
 	})
 	if err != nil {
 		return
@@ -218,3 +249,146 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	err = r.Resolve(c, m)
 	return
 }
+
+// Clone returns a deep copy of the value, which can be changed without changing
+// the original. Values of types not handled by the generated code are copied
+// if they have a "Clone() Type" method, and are nil otherwise.
+func Clone(t vocab.Type) vocab.Type {
+	switch v := t.(type) {
+	case vocab.ActivityStreamsAccept:
+		return v.Clone()
+	case vocab.ActivityStreamsActivity:
+		return v.Clone()
+	case vocab.ActivityStreamsAdd:
+		return v.Clone()
+	case vocab.ActivityStreamsAnnounce:
+		return v.Clone()
+	case vocab.ActivityStreamsApplication:
+		return v.Clone()
+	case vocab.ActivityStreamsArrive:
+		return v.Clone()
+	case vocab.ActivityStreamsArticle:
+		return v.Clone()
+	case vocab.ActivityStreamsAudio:
+		return v.Clone()
+	case vocab.ActivityStreamsBlock:
+		return v.Clone()
+	case vocab.ActivityStreamsCollection:
+		return v.Clone()
+	case vocab.ActivityStreamsCollectionPage:
+		return v.Clone()
+	case vocab.ForgeFedCommit:
+		return v.Clone()
+	case vocab.ActivityStreamsCreate:
+		return v.Clone()
+	case vocab.ActivityStreamsDelete:
+		return v.Clone()
+	case vocab.ActivityStreamsDislike:
+		return v.Clone()
+	case vocab.ActivityStreamsDocument:
+		return v.Clone()
+	case vocab.TootEmoji:
+		return v.Clone()
+	case vocab.ActivityStreamsEvent:
+		return v.Clone()
+	case vocab.ActivityStreamsFlag:
+		return v.Clone()
+	case vocab.ActivityStreamsFollow:
+		return v.Clone()
+	case vocab.ActivityStreamsGroup:
+		return v.Clone()
+	case vocab.ActivityStreamsHashtag:
+		return v.Clone()
+	case vocab.ActivityStreamsIgnore:
+		return v.Clone()
+	case vocab.ActivityStreamsImage:
+		return v.Clone()
+	case vocab.ActivityStreamsIntransitiveActivity:
+		return v.Clone()
+	case vocab.ActivityStreamsInvite:
+		return v.Clone()
+	case vocab.ActivityStreamsJoin:
+		return v.Clone()
+	case vocab.ActivityStreamsLeave:
+		return v.Clone()
+	case vocab.ActivityStreamsLike:
+		return v.Clone()
+	case vocab.ActivityStreamsLink:
+		return v.Clone()
+	case vocab.ActivityStreamsListen:
+		return v.Clone()
+	case vocab.ActivityStreamsMention:
+		return v.Clone()
+	case vocab.ActivityStreamsMove:
+		return v.Clone()
+	case vocab.ActivityStreamsNote:
+		return v.Clone()
+	case vocab.ActivityStreamsObject:
+		return v.Clone()
+	case vocab.ActivityStreamsOffer:
+		return v.Clone()
+	case vocab.ActivityStreamsOrderedCollection:
+		return v.Clone()
+	case vocab.ActivityStreamsOrderedCollectionPage:
+		return v.Clone()
+	case vocab.ActivityStreamsOrganization:
+		return v.Clone()
+	case vocab.ActivityStreamsPage:
+		return v.Clone()
+	case vocab.ForgeFedPatch:
+		return v.Clone()
+	case vocab.ActivityStreamsPerson:
+		return v.Clone()
+	case vocab.ActivityStreamsPlace:
+		return v.Clone()
+	case vocab.ActivityStreamsProfile:
+		return v.Clone()
+	case vocab.SchemaPropertyValue:
+		return v.Clone()
+	case vocab.W3IDSecurityV1PublicKey:
+		return v.Clone()
+	case vocab.ForgeFedPush:
+		return v.Clone()
+	case vocab.ActivityStreamsQuestion:
+		return v.Clone()
+	case vocab.ActivityStreamsRead:
+		return v.Clone()
+	case vocab.ActivityStreamsReject:
+		return v.Clone()
+	case vocab.ActivityStreamsRelationship:
+		return v.Clone()
+	case vocab.ActivityStreamsRemove:
+		return v.Clone()
+	case vocab.ForgeFedRepository:
+		return v.Clone()
+	case vocab.ActivityStreamsService:
+		return v.Clone()
+	case vocab.ActivityStreamsTentativeAccept:
+		return v.Clone()
+	case vocab.ActivityStreamsTentativeReject:
+		return v.Clone()
+	case vocab.ForgeFedTicket:
+		return v.Clone()
+	case vocab.ForgeFedTicketDependency:
+		return v.Clone()
+	case vocab.ActivityStreamsTombstone:
+		return v.Clone()
+	case vocab.ActivityStreamsTravel:
+		return v.Clone()
+	case vocab.ActivityStreamsUndo:
+		return v.Clone()
+	case vocab.ActivityStreamsUpdate:
+		return v.Clone()
+	case vocab.ActivityStreamsVideo:
+		return v.Clone()
+	case vocab.ActivityStreamsView:
+		return v.Clone()
+	}
+	// Types not handled by the generated code, such as those of extensions.
+	if c, ok := t.(interface {
+		Clone() vocab.Type
+	}); ok {
+		return c.Clone()
+	}
+	return nil
+}
@@ -50,6 +50,8 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsCollectionPage) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedCommit) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsCreate) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsDelete) error:
@@ -58,6 +60,8 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsDocument) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.TootEmoji) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsEvent) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsFlag) error:
@@ -66,6 +70,8 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsGroup) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ActivityStreamsHashtag) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsIgnore) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsImage) error:
@@ -102,14 +108,20 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsPage) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedPatch) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsPerson) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsPlace) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsProfile) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.SchemaPropertyValue) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.W3IDSecurityV1PublicKey) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedPush) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsQuestion) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsRead) error:
@@ -120,12 +132,18 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsRemove) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedRepository) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsService) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTentativeAccept) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTentativeReject) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedTicket) error:
+			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ForgeFedTicketDependency) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTombstone) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTravel) error:
@@ -252,6 +270,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Commit" {
+			if fn, ok := i.(func(context.Context, vocab.ForgeFedCommit) error); ok {
+				if v, ok := o.(vocab.ForgeFedCommit); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Create" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsCreate) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsCreate); ok {
@@ -288,6 +315,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "http://joinmastodon.org/ns" && o.GetTypeName() == "Emoji" {
+			if fn, ok := i.(func(context.Context, vocab.TootEmoji) error); ok {
+				if v, ok := o.(vocab.TootEmoji); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Event" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsEvent) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsEvent); ok {
@@ -324,6 +360,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Hashtag" {
+			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsHashtag) error); ok {
+				if v, ok := o.(vocab.ActivityStreamsHashtag); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Ignore" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsIgnore) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsIgnore); ok {
@@ -486,6 +531,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Patch" {
+			if fn, ok := i.(func(context.Context, vocab.ForgeFedPatch) error); ok {
+				if v, ok := o.(vocab.ForgeFedPatch); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Person" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsPerson) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsPerson); ok {
@@ -513,6 +567,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "http://schema.org" && o.GetTypeName() == "PropertyValue" {
+			if fn, ok := i.(func(context.Context, vocab.SchemaPropertyValue) error); ok {
+				if v, ok := o.(vocab.SchemaPropertyValue); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://w3id.org/security/v1" && o.GetTypeName() == "PublicKey" {
 			if fn, ok := i.(func(context.Context, vocab.W3IDSecurityV1PublicKey) error); ok {
 				if v, ok := o.(vocab.W3IDSecurityV1PublicKey); ok {
@@ -522,6 +585,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Push" {
+			if fn, ok := i.(func(context.Context, vocab.ForgeFedPush) error); ok {
+				if v, ok := o.(vocab.ForgeFedPush); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Question" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsQuestion) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsQuestion); ok {
@@ -567,6 +639,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Repository" {
+			if fn, ok := i.(func(context.Context, vocab.ForgeFedRepository) error); ok {
+				if v, ok := o.(vocab.ForgeFedRepository); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Service" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsService) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsService); ok {
@@ -594,6 +675,24 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "Ticket" {
+			if fn, ok := i.(func(context.Context, vocab.ForgeFedTicket) error); ok {
+				if v, ok := o.(vocab.ForgeFedTicket); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
+		} else if o.VocabularyURI() == "https://forgefed.org/ns" && o.GetTypeName() == "TicketDependency" {
+			if fn, ok := i.(func(context.Context, vocab.ForgeFedTicketDependency) error); ok {
+				if v, ok := o.(vocab.ForgeFedTicketDependency); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Tombstone" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsTombstone) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsTombstone); ok {
@@ -0,0 +1,4701 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	"fmt"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"strconv"
+	"strings"
+)
+
+// Violation is a way in which an ActivityStreams value does not follow its
+// specification.
+type Violation struct {
+	// Path is the JSON Pointer (RFC 6901) to the offending value in the
+	// serialized form, such as "/object/totalItems" or "/tag/1/href". The
+	// values of multi-valued properties are indexed. It is empty for the
+	// validated value itself.
+	Path string
+	// Message describes the violation.
+	Message string
+}
+
+// Error returns the path and the message of the violation.
+func (v Violation) Error() string {
+	if len(v.Path) == 0 {
+		return v.Message
+	}
+	return v.Path + ": " + v.Message
+}
+
+// validationRules are the rules of specifications that are not expressed in
+// their ontology, which are applied to the validated value and every value it
+// embeds. The paths of the violations they return are relative to the value.
+var validationRules []func(t vocab.Type) []Violation
+
+// Validate returns the ways in which a value and the values it embeds do not
+// follow their specification, or nil if they do. Values of properties outside
+// of their range, such as a negative integer for an xsd:nonNegativeInteger,
+// and types disjoint with the type of a value are violations. Values referred
+// to by IRI are not validated.
+func Validate(t vocab.Type) []Violation {
+	return validate(t, "", nil)
+}
+
+// validate appends the violations of a value at a path, and of the values it
+// embeds.
+func validate(t vocab.Type, path string, violations []Violation) []Violation {
+	for _, rule := range validationRules {
+		for _, violation := range rule(t) {
+			violation.Path = path + violation.Path
+			violations = append(violations, violation)
+		}
+	}
+	switch v := t.(type) {
+	case vocab.ActivityStreamsAccept:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsActivity:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsAdd:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsAnnounce:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsApplication:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAlsoKnownAsProperty(v.GetActivityStreamsAlsoKnownAs(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateTootDiscoverableProperty(v.GetTootDiscoverable(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateTootFeaturedProperty(v.GetTootFeatured(), path, violations)
+		violations = validateTootFeaturedTagsProperty(v.GetTootFeaturedTags(), path, violations)
+		violations = validateActivityStreamsFollowersProperty(v.GetActivityStreamsFollowers(), path, violations)
+		violations = validateActivityStreamsFollowingProperty(v.GetActivityStreamsFollowing(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInboxProperty(v.GetActivityStreamsInbox(), path, violations)
+		violations = validateActivityStreamsLikedProperty(v.GetActivityStreamsLiked(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsManuallyApprovesFollowersProperty(v.GetActivityStreamsManuallyApprovesFollowers(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsMovedToProperty(v.GetActivityStreamsMovedTo(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOutboxProperty(v.GetActivityStreamsOutbox(), path, violations)
+		violations = validateActivityStreamsPreferredUsernameProperty(v.GetActivityStreamsPreferredUsername(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateW3IDSecurityV1PublicKeyProperty(v.GetW3IDSecurityV1PublicKey(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsStreamsProperty(v.GetActivityStreamsStreams(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsArrive:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsArticle:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsAudio:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateTootBlurhashProperty(v.GetTootBlurhash(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateTootFocalPointProperty(v.GetTootFocalPoint(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsBlock:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsCollection:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsCurrentProperty(v.GetActivityStreamsCurrent(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsFirstProperty(v.GetActivityStreamsFirst(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsItemsProperty(v.GetActivityStreamsItems(), path, violations)
+		violations = validateActivityStreamsLastProperty(v.GetActivityStreamsLast(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateActivityStreamsTotalItemsProperty(v.GetActivityStreamsTotalItems(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsCollectionPage:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsCurrentProperty(v.GetActivityStreamsCurrent(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsFirstProperty(v.GetActivityStreamsFirst(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsItemsProperty(v.GetActivityStreamsItems(), path, violations)
+		violations = validateActivityStreamsLastProperty(v.GetActivityStreamsLast(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsNextProperty(v.GetActivityStreamsNext(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPartOfProperty(v.GetActivityStreamsPartOf(), path, violations)
+		violations = validateActivityStreamsPrevProperty(v.GetActivityStreamsPrev(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateActivityStreamsTotalItemsProperty(v.GetActivityStreamsTotalItems(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ForgeFedCommit:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateForgeFedCommittedProperty(v.GetForgeFedCommitted(), path, violations)
+		violations = validateForgeFedCommittedByProperty(v.GetForgeFedCommittedBy(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateForgeFedCreatedProperty(v.GetForgeFedCreated(), path, violations)
+		violations = validateForgeFedDescriptionProperty(v.GetForgeFedDescription(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateForgeFedFilesAddedProperty(v.GetForgeFedFilesAdded(), path, violations)
+		violations = validateForgeFedFilesModifiedProperty(v.GetForgeFedFilesModified(), path, violations)
+		violations = validateForgeFedFilesRemovedProperty(v.GetForgeFedFilesRemoved(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateForgeFedHashProperty(v.GetForgeFedHash(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsCreate:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsDelete:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsDislike:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsDocument:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateTootBlurhashProperty(v.GetTootBlurhash(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateTootFocalPointProperty(v.GetTootFocalPoint(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.TootEmoji:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsEvent:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsFlag:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsFollow:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsGroup:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAlsoKnownAsProperty(v.GetActivityStreamsAlsoKnownAs(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateTootDiscoverableProperty(v.GetTootDiscoverable(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateTootFeaturedProperty(v.GetTootFeatured(), path, violations)
+		violations = validateTootFeaturedTagsProperty(v.GetTootFeaturedTags(), path, violations)
+		violations = validateActivityStreamsFollowersProperty(v.GetActivityStreamsFollowers(), path, violations)
+		violations = validateActivityStreamsFollowingProperty(v.GetActivityStreamsFollowing(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInboxProperty(v.GetActivityStreamsInbox(), path, violations)
+		violations = validateActivityStreamsLikedProperty(v.GetActivityStreamsLiked(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsManuallyApprovesFollowersProperty(v.GetActivityStreamsManuallyApprovesFollowers(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsMovedToProperty(v.GetActivityStreamsMovedTo(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOutboxProperty(v.GetActivityStreamsOutbox(), path, violations)
+		violations = validateActivityStreamsPreferredUsernameProperty(v.GetActivityStreamsPreferredUsername(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateW3IDSecurityV1PublicKeyProperty(v.GetW3IDSecurityV1PublicKey(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsStreamsProperty(v.GetActivityStreamsStreams(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsHashtag:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Accept", "Activity", "Add", "Announce", "Application", "Arrive", "Article", "Audio", "Block", "Collection", "CollectionPage", "Commit", "Create", "Delete", "Dislike", "Document", "Emoji", "Event", "Flag", "Follow", "Group", "Ignore", "Image", "IntransitiveActivity", "Invite", "Join", "Leave", "Like", "Listen", "Move", "Note", "Object", "Offer", "OrderedCollection", "OrderedCollectionPage", "Organization", "Page", "Patch", "Person", "Place", "Profile", "PropertyValue", "Push", "Question", "Read", "Reject", "Relationship", "Remove", "Repository", "Service", "TentativeAccept", "TentativeReject", "Ticket", "TicketDependency", "Tombstone", "Travel", "Undo", "Update", "Video", "View"}, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsHeightProperty(v.GetActivityStreamsHeight(), path, violations)
+		violations = validateActivityStreamsHrefProperty(v.GetActivityStreamsHref(), path, violations)
+		violations = validateActivityStreamsHreflangProperty(v.GetActivityStreamsHreflang(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsRelProperty(v.GetActivityStreamsRel(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsWidthProperty(v.GetActivityStreamsWidth(), path, violations)
+	case vocab.ActivityStreamsIgnore:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsImage:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateTootBlurhashProperty(v.GetTootBlurhash(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateTootFocalPointProperty(v.GetTootFocalPoint(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsHeightProperty(v.GetActivityStreamsHeight(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+		violations = validateActivityStreamsWidthProperty(v.GetActivityStreamsWidth(), path, violations)
+	case vocab.ActivityStreamsIntransitiveActivity:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsInvite:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsJoin:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsLeave:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsLike:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsLink:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Accept", "Activity", "Add", "Announce", "Application", "Arrive", "Article", "Audio", "Block", "Collection", "CollectionPage", "Commit", "Create", "Delete", "Dislike", "Document", "Emoji", "Event", "Flag", "Follow", "Group", "Ignore", "Image", "IntransitiveActivity", "Invite", "Join", "Leave", "Like", "Listen", "Move", "Note", "Object", "Offer", "OrderedCollection", "OrderedCollectionPage", "Organization", "Page", "Patch", "Person", "Place", "Profile", "PropertyValue", "Push", "Question", "Read", "Reject", "Relationship", "Remove", "Repository", "Service", "TentativeAccept", "TentativeReject", "Ticket", "TicketDependency", "Tombstone", "Travel", "Undo", "Update", "Video", "View"}, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsHeightProperty(v.GetActivityStreamsHeight(), path, violations)
+		violations = validateActivityStreamsHrefProperty(v.GetActivityStreamsHref(), path, violations)
+		violations = validateActivityStreamsHreflangProperty(v.GetActivityStreamsHreflang(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsRelProperty(v.GetActivityStreamsRel(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsWidthProperty(v.GetActivityStreamsWidth(), path, violations)
+	case vocab.ActivityStreamsListen:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsMention:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Accept", "Activity", "Add", "Announce", "Application", "Arrive", "Article", "Audio", "Block", "Collection", "CollectionPage", "Commit", "Create", "Delete", "Dislike", "Document", "Emoji", "Event", "Flag", "Follow", "Group", "Ignore", "Image", "IntransitiveActivity", "Invite", "Join", "Leave", "Like", "Listen", "Move", "Note", "Object", "Offer", "OrderedCollection", "OrderedCollectionPage", "Organization", "Page", "Patch", "Person", "Place", "Profile", "PropertyValue", "Push", "Question", "Read", "Reject", "Relationship", "Remove", "Repository", "Service", "TentativeAccept", "TentativeReject", "Ticket", "TicketDependency", "Tombstone", "Travel", "Undo", "Update", "Video", "View"}, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsHeightProperty(v.GetActivityStreamsHeight(), path, violations)
+		violations = validateActivityStreamsHrefProperty(v.GetActivityStreamsHref(), path, violations)
+		violations = validateActivityStreamsHreflangProperty(v.GetActivityStreamsHreflang(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsRelProperty(v.GetActivityStreamsRel(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsWidthProperty(v.GetActivityStreamsWidth(), path, violations)
+	case vocab.ActivityStreamsMove:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsNote:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsObject:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsOffer:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsOrderedCollection:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsCurrentProperty(v.GetActivityStreamsCurrent(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateForgeFedEarlyItemsProperty(v.GetForgeFedEarlyItems(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsFirstProperty(v.GetActivityStreamsFirst(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLastProperty(v.GetActivityStreamsLast(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOrderedItemsProperty(v.GetActivityStreamsOrderedItems(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateActivityStreamsTotalItemsProperty(v.GetActivityStreamsTotalItems(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsOrderedCollectionPage:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsCurrentProperty(v.GetActivityStreamsCurrent(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateForgeFedEarlyItemsProperty(v.GetForgeFedEarlyItems(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsFirstProperty(v.GetActivityStreamsFirst(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLastProperty(v.GetActivityStreamsLast(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsNextProperty(v.GetActivityStreamsNext(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOrderedItemsProperty(v.GetActivityStreamsOrderedItems(), path, violations)
+		violations = validateActivityStreamsPartOfProperty(v.GetActivityStreamsPartOf(), path, violations)
+		violations = validateActivityStreamsPrevProperty(v.GetActivityStreamsPrev(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartIndexProperty(v.GetActivityStreamsStartIndex(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateActivityStreamsTotalItemsProperty(v.GetActivityStreamsTotalItems(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsOrganization:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAlsoKnownAsProperty(v.GetActivityStreamsAlsoKnownAs(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateTootDiscoverableProperty(v.GetTootDiscoverable(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateTootFeaturedProperty(v.GetTootFeatured(), path, violations)
+		violations = validateTootFeaturedTagsProperty(v.GetTootFeaturedTags(), path, violations)
+		violations = validateActivityStreamsFollowersProperty(v.GetActivityStreamsFollowers(), path, violations)
+		violations = validateActivityStreamsFollowingProperty(v.GetActivityStreamsFollowing(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInboxProperty(v.GetActivityStreamsInbox(), path, violations)
+		violations = validateActivityStreamsLikedProperty(v.GetActivityStreamsLiked(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsManuallyApprovesFollowersProperty(v.GetActivityStreamsManuallyApprovesFollowers(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsMovedToProperty(v.GetActivityStreamsMovedTo(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOutboxProperty(v.GetActivityStreamsOutbox(), path, violations)
+		violations = validateActivityStreamsPreferredUsernameProperty(v.GetActivityStreamsPreferredUsername(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateW3IDSecurityV1PublicKeyProperty(v.GetW3IDSecurityV1PublicKey(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsStreamsProperty(v.GetActivityStreamsStreams(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsPage:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateTootBlurhashProperty(v.GetTootBlurhash(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateTootFocalPointProperty(v.GetTootFocalPoint(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ForgeFedPatch:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsPerson:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAlsoKnownAsProperty(v.GetActivityStreamsAlsoKnownAs(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateTootDiscoverableProperty(v.GetTootDiscoverable(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateTootFeaturedProperty(v.GetTootFeatured(), path, violations)
+		violations = validateTootFeaturedTagsProperty(v.GetTootFeaturedTags(), path, violations)
+		violations = validateActivityStreamsFollowersProperty(v.GetActivityStreamsFollowers(), path, violations)
+		violations = validateActivityStreamsFollowingProperty(v.GetActivityStreamsFollowing(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInboxProperty(v.GetActivityStreamsInbox(), path, violations)
+		violations = validateActivityStreamsLikedProperty(v.GetActivityStreamsLiked(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsManuallyApprovesFollowersProperty(v.GetActivityStreamsManuallyApprovesFollowers(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsMovedToProperty(v.GetActivityStreamsMovedTo(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOutboxProperty(v.GetActivityStreamsOutbox(), path, violations)
+		violations = validateActivityStreamsPreferredUsernameProperty(v.GetActivityStreamsPreferredUsername(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateW3IDSecurityV1PublicKeyProperty(v.GetW3IDSecurityV1PublicKey(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsStreamsProperty(v.GetActivityStreamsStreams(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsPlace:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAccuracyProperty(v.GetActivityStreamsAccuracy(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLatitudeProperty(v.GetActivityStreamsLatitude(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsLongitudeProperty(v.GetActivityStreamsLongitude(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRadiusProperty(v.GetActivityStreamsRadius(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUnitsProperty(v.GetActivityStreamsUnits(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsProfile:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDescribesProperty(v.GetActivityStreamsDescribes(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.SchemaPropertyValue:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+		violations = validateSchemaValueProperty(v.GetSchemaValue(), path, violations)
+	case vocab.W3IDSecurityV1PublicKey:
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateW3IDSecurityV1OwnerProperty(v.GetW3IDSecurityV1Owner(), path, violations)
+		violations = validateW3IDSecurityV1PublicKeyPemProperty(v.GetW3IDSecurityV1PublicKeyPem(), path, violations)
+	case vocab.ForgeFedPush:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateForgeFedHashAfterProperty(v.GetForgeFedHashAfter(), path, violations)
+		violations = validateForgeFedHashBeforeProperty(v.GetForgeFedHashBefore(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateForgeFedRefProperty(v.GetForgeFedRef(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsQuestion:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAnyOfProperty(v.GetActivityStreamsAnyOf(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsClosedProperty(v.GetActivityStreamsClosed(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsOneOfProperty(v.GetActivityStreamsOneOf(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+		violations = validateTootVotersCountProperty(v.GetTootVotersCount(), path, violations)
+	case vocab.ActivityStreamsRead:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsReject:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsRelationship:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRelationshipProperty(v.GetActivityStreamsRelationship(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSubjectProperty(v.GetActivityStreamsSubject(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsRemove:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ForgeFedRepository:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateForgeFedForksProperty(v.GetForgeFedForks(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTeamProperty(v.GetForgeFedTeam(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsService:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAlsoKnownAsProperty(v.GetActivityStreamsAlsoKnownAs(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateTootDiscoverableProperty(v.GetTootDiscoverable(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateTootFeaturedProperty(v.GetTootFeatured(), path, violations)
+		violations = validateTootFeaturedTagsProperty(v.GetTootFeaturedTags(), path, violations)
+		violations = validateActivityStreamsFollowersProperty(v.GetActivityStreamsFollowers(), path, violations)
+		violations = validateActivityStreamsFollowingProperty(v.GetActivityStreamsFollowing(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInboxProperty(v.GetActivityStreamsInbox(), path, violations)
+		violations = validateActivityStreamsLikedProperty(v.GetActivityStreamsLiked(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsManuallyApprovesFollowersProperty(v.GetActivityStreamsManuallyApprovesFollowers(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsMovedToProperty(v.GetActivityStreamsMovedTo(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOutboxProperty(v.GetActivityStreamsOutbox(), path, violations)
+		violations = validateActivityStreamsPreferredUsernameProperty(v.GetActivityStreamsPreferredUsername(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateW3IDSecurityV1PublicKeyProperty(v.GetW3IDSecurityV1PublicKey(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsStreamsProperty(v.GetActivityStreamsStreams(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsTentativeAccept:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsTentativeReject:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ForgeFedTicket:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateForgeFedAssignedToProperty(v.GetForgeFedAssignedTo(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateForgeFedDependantsProperty(v.GetForgeFedDependants(), path, violations)
+		violations = validateForgeFedDependedByProperty(v.GetForgeFedDependedBy(), path, violations)
+		violations = validateForgeFedDependenciesProperty(v.GetForgeFedDependencies(), path, violations)
+		violations = validateForgeFedDependsOnProperty(v.GetForgeFedDependsOn(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateForgeFedIsResolvedProperty(v.GetForgeFedIsResolved(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateForgeFedResolvedProperty(v.GetForgeFedResolved(), path, violations)
+		violations = validateForgeFedResolvedByProperty(v.GetForgeFedResolvedBy(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ForgeFedTicketDependency:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRelationshipProperty(v.GetActivityStreamsRelationship(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSubjectProperty(v.GetActivityStreamsSubject(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsTombstone:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDeletedProperty(v.GetActivityStreamsDeleted(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsFormerTypeProperty(v.GetActivityStreamsFormerType(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsTravel:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsUndo:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsUpdate:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsVideo:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateTootBlurhashProperty(v.GetTootBlurhash(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateTootFocalPointProperty(v.GetTootFocalPoint(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	case vocab.ActivityStreamsView:
+		violations = validateDisjointTypes(v.GetJSONLDType(), path, v.GetTypeName(), []string{"Hashtag", "Link", "Mention"}, violations)
+		violations = validateActivityStreamsActorProperty(v.GetActivityStreamsActor(), path, violations)
+		violations = validateActivityStreamsAltitudeProperty(v.GetActivityStreamsAltitude(), path, violations)
+		violations = validateActivityStreamsAttachmentProperty(v.GetActivityStreamsAttachment(), path, violations)
+		violations = validateActivityStreamsAttributedToProperty(v.GetActivityStreamsAttributedTo(), path, violations)
+		violations = validateActivityStreamsAudienceProperty(v.GetActivityStreamsAudience(), path, violations)
+		violations = validateActivityStreamsBccProperty(v.GetActivityStreamsBcc(), path, violations)
+		violations = validateActivityStreamsBtoProperty(v.GetActivityStreamsBto(), path, violations)
+		violations = validateActivityStreamsCcProperty(v.GetActivityStreamsCc(), path, violations)
+		violations = validateActivityStreamsContentProperty(v.GetActivityStreamsContent(), path, violations)
+		violations = validateActivityStreamsContextProperty(v.GetActivityStreamsContext(), path, violations)
+		violations = validateActivityStreamsDurationProperty(v.GetActivityStreamsDuration(), path, violations)
+		violations = validateActivityStreamsEndTimeProperty(v.GetActivityStreamsEndTime(), path, violations)
+		violations = validateActivityStreamsGeneratorProperty(v.GetActivityStreamsGenerator(), path, violations)
+		violations = validateActivityStreamsIconProperty(v.GetActivityStreamsIcon(), path, violations)
+		violations = validateJSONLDIdProperty(v.GetJSONLDId(), path, violations)
+		violations = validateActivityStreamsImageProperty(v.GetActivityStreamsImage(), path, violations)
+		violations = validateActivityStreamsInReplyToProperty(v.GetActivityStreamsInReplyTo(), path, violations)
+		violations = validateActivityStreamsInstrumentProperty(v.GetActivityStreamsInstrument(), path, violations)
+		violations = validateActivityStreamsLikesProperty(v.GetActivityStreamsLikes(), path, violations)
+		violations = validateActivityStreamsLocationProperty(v.GetActivityStreamsLocation(), path, violations)
+		violations = validateActivityStreamsMediaTypeProperty(v.GetActivityStreamsMediaType(), path, violations)
+		violations = validateActivityStreamsNameProperty(v.GetActivityStreamsName(), path, violations)
+		violations = validateActivityStreamsObjectProperty(v.GetActivityStreamsObject(), path, violations)
+		violations = validateActivityStreamsOriginProperty(v.GetActivityStreamsOrigin(), path, violations)
+		violations = validateActivityStreamsPreviewProperty(v.GetActivityStreamsPreview(), path, violations)
+		violations = validateActivityStreamsPublishedProperty(v.GetActivityStreamsPublished(), path, violations)
+		violations = validateActivityStreamsRepliesProperty(v.GetActivityStreamsReplies(), path, violations)
+		violations = validateActivityStreamsResultProperty(v.GetActivityStreamsResult(), path, violations)
+		violations = validateActivityStreamsSensitiveProperty(v.GetActivityStreamsSensitive(), path, violations)
+		violations = validateActivityStreamsSharesProperty(v.GetActivityStreamsShares(), path, violations)
+		violations = validateActivityStreamsStartTimeProperty(v.GetActivityStreamsStartTime(), path, violations)
+		violations = validateActivityStreamsSummaryProperty(v.GetActivityStreamsSummary(), path, violations)
+		violations = validateActivityStreamsTagProperty(v.GetActivityStreamsTag(), path, violations)
+		violations = validateActivityStreamsTargetProperty(v.GetActivityStreamsTarget(), path, violations)
+		violations = validateForgeFedTicketsTrackedByProperty(v.GetForgeFedTicketsTrackedBy(), path, violations)
+		violations = validateActivityStreamsToProperty(v.GetActivityStreamsTo(), path, violations)
+		violations = validateForgeFedTracksTicketsForProperty(v.GetForgeFedTracksTicketsFor(), path, violations)
+		violations = validateJSONLDTypeProperty(v.GetJSONLDType(), path, violations)
+		violations = validateActivityStreamsUpdatedProperty(v.GetActivityStreamsUpdated(), path, violations)
+		violations = validateActivityStreamsUrlProperty(v.GetActivityStreamsUrl(), path, violations)
+	}
+	return violations
+}
+
+// validateDisjointTypes appends a violation for each type of a value that is
+// disjoint with its own.
+func validateDisjointTypes(p interface {
+	Serialize() (interface{}, error)
+}, path string, typeName string, disjoint []string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	i, err := p.Serialize()
+	if err != nil {
+		return violations
+	}
+	names, ok := i.([]interface{})
+	if !ok {
+		names = []interface{}{i}
+	}
+	for _, name := range names {
+		s, _ := name.(string)
+		for _, d := range disjoint {
+			if typeNameMatches(s, d) {
+				violations = append(violations, Violation{
+					Message: fmt.Sprintf("%s is disjoint with %s", d, typeName),
+					Path:    path + "/type",
+				})
+			}
+		}
+	}
+	return violations
+}
+
+// typeNameMatches determines whether a type of a value, which may be prefixed by
+// the alias or the IRI of its vocabulary, is the type with the name.
+func typeNameMatches(s, name string) bool {
+	return s == name || strings.HasSuffix(s, ":"+name) || strings.HasSuffix(s, "#"+name)
+}
+
+// validateActivityStreamsAccuracyProperty appends the violations of the values of
+// the "accuracy" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsAccuracyProperty(p vocab.ActivityStreamsAccuracyProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of accuracy",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsActorProperty appends the violations of the values of
+// the "actor" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsActorProperty(p vocab.ActivityStreamsActorProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of actor",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsAlsoKnownAsProperty appends the violations of the values
+// of the "alsoKnownAs" property that are not in its range, and of the values
+// it embeds.
+func validateActivityStreamsAlsoKnownAsProperty(p vocab.ActivityStreamsAlsoKnownAsProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of alsoKnownAs",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsAltitudeProperty appends the violations of the values of
+// the "altitude" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsAltitudeProperty(p vocab.ActivityStreamsAltitudeProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of altitude",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsAnyOfProperty appends the violations of the values of
+// the "anyOf" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsAnyOfProperty(p vocab.ActivityStreamsAnyOfProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of anyOf",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateForgeFedAssignedToProperty appends the violations of the values of the
+// "assignedTo" property that are not in its range, and of the values it
+// embeds.
+func validateForgeFedAssignedToProperty(p vocab.ForgeFedAssignedToProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of assignedTo",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsAttachmentProperty appends the violations of the values
+// of the "attachment" property that are not in its range, and of the values
+// it embeds.
+func validateActivityStreamsAttachmentProperty(p vocab.ActivityStreamsAttachmentProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of attachment",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsAttributedToProperty appends the violations of the
+// values of the "attributedTo" property that are not in its range, and of the
+// values it embeds.
+func validateActivityStreamsAttributedToProperty(p vocab.ActivityStreamsAttributedToProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of attributedTo",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsAudienceProperty appends the violations of the values of
+// the "audience" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsAudienceProperty(p vocab.ActivityStreamsAudienceProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of audience",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsBccProperty appends the violations of the values of the
+// "bcc" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsBccProperty(p vocab.ActivityStreamsBccProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of bcc",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateTootBlurhashProperty appends the violations of the values of the
+// "blurhash" property that are not in its range, and of the values it embeds.
+func validateTootBlurhashProperty(p vocab.TootBlurhashProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of blurhash",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsBtoProperty appends the violations of the values of the
+// "bto" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsBtoProperty(p vocab.ActivityStreamsBtoProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of bto",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsCcProperty appends the violations of the values of the
+// "cc" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsCcProperty(p vocab.ActivityStreamsCcProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of cc",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsClosedProperty appends the violations of the values of
+// the "closed" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsClosedProperty(p vocab.ActivityStreamsClosedProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of closed",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateForgeFedCommittedProperty appends the violations of the values of the
+// "committed" property that are not in its range, and of the values it embeds.
+func validateForgeFedCommittedProperty(p vocab.ForgeFedCommittedProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of committed",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateForgeFedCommittedByProperty appends the violations of the values of the
+// "committedBy" property that are not in its range, and of the values it
+// embeds.
+func validateForgeFedCommittedByProperty(p vocab.ForgeFedCommittedByProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of committedBy",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsContentProperty appends the violations of the values of
+// the "content" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsContentProperty(p vocab.ActivityStreamsContentProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of content",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsContextProperty appends the violations of the values of
+// the "context" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsContextProperty(p vocab.ActivityStreamsContextProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of context",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateForgeFedCreatedProperty appends the violations of the values of the
+// "created" property that are not in its range, and of the values it embeds.
+func validateForgeFedCreatedProperty(p vocab.ForgeFedCreatedProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of created",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsCurrentProperty appends the violations of the values of
+// the "current" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsCurrentProperty(p vocab.ActivityStreamsCurrentProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of current",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsDeletedProperty appends the violations of the values of
+// the "deleted" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsDeletedProperty(p vocab.ActivityStreamsDeletedProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of deleted",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateForgeFedDependantsProperty appends the violations of the values of the
+// "dependants" property that are not in its range, and of the values it
+// embeds.
+func validateForgeFedDependantsProperty(p vocab.ForgeFedDependantsProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of dependants",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateForgeFedDependedByProperty appends the violations of the values of the
+// "dependedBy" property that are not in its range, and of the values it
+// embeds.
+func validateForgeFedDependedByProperty(p vocab.ForgeFedDependedByProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of dependedBy",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateForgeFedDependenciesProperty appends the violations of the values of
+// the "dependencies" property that are not in its range, and of the values it
+// embeds.
+func validateForgeFedDependenciesProperty(p vocab.ForgeFedDependenciesProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of dependencies",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateForgeFedDependsOnProperty appends the violations of the values of the
+// "dependsOn" property that are not in its range, and of the values it embeds.
+func validateForgeFedDependsOnProperty(p vocab.ForgeFedDependsOnProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of dependsOn",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsDescribesProperty appends the violations of the values
+// of the "describes" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsDescribesProperty(p vocab.ActivityStreamsDescribesProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of describes",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateForgeFedDescriptionProperty appends the violations of the values of the
+// "description" property that are not in its range, and of the values it
+// embeds.
+func validateForgeFedDescriptionProperty(p vocab.ForgeFedDescriptionProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of description",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateTootDiscoverableProperty appends the violations of the values of the
+// "discoverable" property that are not in its range, and of the values it
+// embeds.
+func validateTootDiscoverableProperty(p vocab.TootDiscoverableProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of discoverable",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsDurationProperty appends the violations of the values of
+// the "duration" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsDurationProperty(p vocab.ActivityStreamsDurationProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of duration",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateForgeFedEarlyItemsProperty appends the violations of the values of the
+// "earlyItems" property that are not in its range, and of the values it
+// embeds.
+func validateForgeFedEarlyItemsProperty(p vocab.ForgeFedEarlyItemsProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of earlyItems",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsEndTimeProperty appends the violations of the values of
+// the "endTime" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsEndTimeProperty(p vocab.ActivityStreamsEndTimeProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of endTime",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateTootFeaturedProperty appends the violations of the values of the
+// "featured" property that are not in its range, and of the values it embeds.
+func validateTootFeaturedProperty(p vocab.TootFeaturedProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of featured",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateTootFeaturedTagsProperty appends the violations of the values of the
+// "featuredTags" property that are not in its range, and of the values it
+// embeds.
+func validateTootFeaturedTagsProperty(p vocab.TootFeaturedTagsProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of featuredTags",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateForgeFedFilesAddedProperty appends the violations of the values of the
+// "filesAdded" property that are not in its range, and of the values it
+// embeds.
+func validateForgeFedFilesAddedProperty(p vocab.ForgeFedFilesAddedProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of filesAdded",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		}
+	}
+	return violations
+}
+
+// validateForgeFedFilesModifiedProperty appends the violations of the values of
+// the "filesModified" property that are not in its range, and of the values
+// it embeds.
+func validateForgeFedFilesModifiedProperty(p vocab.ForgeFedFilesModifiedProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of filesModified",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		}
+	}
+	return violations
+}
+
+// validateForgeFedFilesRemovedProperty appends the violations of the values of
+// the "filesRemoved" property that are not in its range, and of the values it
+// embeds.
+func validateForgeFedFilesRemovedProperty(p vocab.ForgeFedFilesRemovedProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of filesRemoved",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsFirstProperty appends the violations of the values of
+// the "first" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsFirstProperty(p vocab.ActivityStreamsFirstProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of first",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateTootFocalPointProperty appends the violations of the values of the
+// "focalPoint" property that are not in its range, and of the values it
+// embeds.
+func validateTootFocalPointProperty(p vocab.TootFocalPointProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of focalPoint",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsFollowersProperty appends the violations of the values
+// of the "followers" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsFollowersProperty(p vocab.ActivityStreamsFollowersProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of followers",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsFollowingProperty appends the violations of the values
+// of the "following" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsFollowingProperty(p vocab.ActivityStreamsFollowingProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of following",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateForgeFedForksProperty appends the violations of the values of the
+// "forks" property that are not in its range, and of the values it embeds.
+func validateForgeFedForksProperty(p vocab.ForgeFedForksProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of forks",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsFormerTypeProperty appends the violations of the values
+// of the "formerType" property that are not in its range, and of the values
+// it embeds.
+func validateActivityStreamsFormerTypeProperty(p vocab.ActivityStreamsFormerTypeProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of formerType",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsGeneratorProperty appends the violations of the values
+// of the "generator" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsGeneratorProperty(p vocab.ActivityStreamsGeneratorProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of generator",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateForgeFedHashProperty appends the violations of the values of the "hash"
+// property that are not in its range, and of the values it embeds.
+func validateForgeFedHashProperty(p vocab.ForgeFedHashProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of hash",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateForgeFedHashAfterProperty appends the violations of the values of the
+// "hashAfter" property that are not in its range, and of the values it embeds.
+func validateForgeFedHashAfterProperty(p vocab.ForgeFedHashAfterProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of hashAfter",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateForgeFedHashBeforeProperty appends the violations of the values of the
+// "hashBefore" property that are not in its range, and of the values it
+// embeds.
+func validateForgeFedHashBeforeProperty(p vocab.ForgeFedHashBeforeProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of hashBefore",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsHeightProperty appends the violations of the values of
+// the "height" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsHeightProperty(p vocab.ActivityStreamsHeightProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of height",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsHrefProperty appends the violations of the values of the
+// "href" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsHrefProperty(p vocab.ActivityStreamsHrefProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of href",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsHreflangProperty appends the violations of the values of
+// the "hreflang" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsHreflangProperty(p vocab.ActivityStreamsHreflangProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of hreflang",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsIconProperty appends the violations of the values of the
+// "icon" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsIconProperty(p vocab.ActivityStreamsIconProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of icon",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateJSONLDIdProperty appends the violations of the values of the "id"
+// property that are not in its range, and of the values it embeds.
+func validateJSONLDIdProperty(p vocab.JSONLDIdProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of id",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsImageProperty appends the violations of the values of
+// the "image" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsImageProperty(p vocab.ActivityStreamsImageProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of image",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsInReplyToProperty appends the violations of the values
+// of the "inReplyTo" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsInReplyToProperty(p vocab.ActivityStreamsInReplyToProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of inReplyTo",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsInboxProperty appends the violations of the values of
+// the "inbox" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsInboxProperty(p vocab.ActivityStreamsInboxProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of inbox",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsInstrumentProperty appends the violations of the values
+// of the "instrument" property that are not in its range, and of the values
+// it embeds.
+func validateActivityStreamsInstrumentProperty(p vocab.ActivityStreamsInstrumentProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of instrument",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateForgeFedIsResolvedProperty appends the violations of the values of the
+// "isResolved" property that are not in its range, and of the values it
+// embeds.
+func validateForgeFedIsResolvedProperty(p vocab.ForgeFedIsResolvedProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of isResolved",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsItemsProperty appends the violations of the values of
+// the "items" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsItemsProperty(p vocab.ActivityStreamsItemsProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of items",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsLastProperty appends the violations of the values of the
+// "last" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsLastProperty(p vocab.ActivityStreamsLastProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of last",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsLatitudeProperty appends the violations of the values of
+// the "latitude" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsLatitudeProperty(p vocab.ActivityStreamsLatitudeProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of latitude",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsLikedProperty appends the violations of the values of
+// the "liked" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsLikedProperty(p vocab.ActivityStreamsLikedProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of liked",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsLikesProperty appends the violations of the values of
+// the "likes" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsLikesProperty(p vocab.ActivityStreamsLikesProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of likes",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsLocationProperty appends the violations of the values of
+// the "location" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsLocationProperty(p vocab.ActivityStreamsLocationProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of location",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsLongitudeProperty appends the violations of the values
+// of the "longitude" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsLongitudeProperty(p vocab.ActivityStreamsLongitudeProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of longitude",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsManuallyApprovesFollowersProperty appends the violations
+// of the values of the "manuallyApprovesFollowers" property that are not in
+// its range, and of the values it embeds.
+func validateActivityStreamsManuallyApprovesFollowersProperty(p vocab.ActivityStreamsManuallyApprovesFollowersProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of manuallyApprovesFollowers",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsMediaTypeProperty appends the violations of the values
+// of the "mediaType" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsMediaTypeProperty(p vocab.ActivityStreamsMediaTypeProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of mediaType",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsMovedToProperty appends the violations of the values of
+// the "movedTo" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsMovedToProperty(p vocab.ActivityStreamsMovedToProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of movedTo",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsNameProperty appends the violations of the values of the
+// "name" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsNameProperty(p vocab.ActivityStreamsNameProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of name",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsNextProperty appends the violations of the values of the
+// "next" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsNextProperty(p vocab.ActivityStreamsNextProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of next",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsObjectProperty appends the violations of the values of
+// the "object" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsObjectProperty(p vocab.ActivityStreamsObjectProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of object",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsOneOfProperty appends the violations of the values of
+// the "oneOf" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsOneOfProperty(p vocab.ActivityStreamsOneOfProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of oneOf",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsOrderedItemsProperty appends the violations of the
+// values of the "orderedItems" property that are not in its range, and of the
+// values it embeds.
+func validateActivityStreamsOrderedItemsProperty(p vocab.ActivityStreamsOrderedItemsProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of orderedItems",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsOriginProperty appends the violations of the values of
+// the "origin" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsOriginProperty(p vocab.ActivityStreamsOriginProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of origin",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsOutboxProperty appends the violations of the values of
+// the "outbox" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsOutboxProperty(p vocab.ActivityStreamsOutboxProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of outbox",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateW3IDSecurityV1OwnerProperty appends the violations of the values of the
+// "owner" property that are not in its range, and of the values it embeds.
+func validateW3IDSecurityV1OwnerProperty(p vocab.W3IDSecurityV1OwnerProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of owner",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsPartOfProperty appends the violations of the values of
+// the "partOf" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsPartOfProperty(p vocab.ActivityStreamsPartOfProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of partOf",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsPreferredUsernameProperty appends the violations of the
+// values of the "preferredUsername" property that are not in its range, and
+// of the values it embeds.
+func validateActivityStreamsPreferredUsernameProperty(p vocab.ActivityStreamsPreferredUsernameProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of preferredUsername",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsPrevProperty appends the violations of the values of the
+// "prev" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsPrevProperty(p vocab.ActivityStreamsPrevProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of prev",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsPreviewProperty appends the violations of the values of
+// the "preview" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsPreviewProperty(p vocab.ActivityStreamsPreviewProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of preview",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateW3IDSecurityV1PublicKeyProperty appends the violations of the values of
+// the "publicKey" property that are not in its range, and of the values it
+// embeds.
+func validateW3IDSecurityV1PublicKeyProperty(p vocab.W3IDSecurityV1PublicKeyProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of publicKey",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateW3IDSecurityV1PublicKeyPemProperty appends the violations of the values
+// of the "publicKeyPem" property that are not in its range, and of the values
+// it embeds.
+func validateW3IDSecurityV1PublicKeyPemProperty(p vocab.W3IDSecurityV1PublicKeyPemProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of publicKeyPem",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsPublishedProperty appends the violations of the values
+// of the "published" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsPublishedProperty(p vocab.ActivityStreamsPublishedProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of published",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsRadiusProperty appends the violations of the values of
+// the "radius" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsRadiusProperty(p vocab.ActivityStreamsRadiusProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of radius",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateForgeFedRefProperty appends the violations of the values of the "ref"
+// property that are not in its range, and of the values it embeds.
+func validateForgeFedRefProperty(p vocab.ForgeFedRefProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of ref",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsRelProperty appends the violations of the values of the
+// "rel" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsRelProperty(p vocab.ActivityStreamsRelProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of rel",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsRelationshipProperty appends the violations of the
+// values of the "relationship" property that are not in its range, and of the
+// values it embeds.
+func validateActivityStreamsRelationshipProperty(p vocab.ActivityStreamsRelationshipProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of relationship",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsRepliesProperty appends the violations of the values of
+// the "replies" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsRepliesProperty(p vocab.ActivityStreamsRepliesProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of replies",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateForgeFedResolvedProperty appends the violations of the values of the
+// "resolved" property that are not in its range, and of the values it embeds.
+func validateForgeFedResolvedProperty(p vocab.ForgeFedResolvedProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of resolved",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateForgeFedResolvedByProperty appends the violations of the values of the
+// "resolvedBy" property that are not in its range, and of the values it
+// embeds.
+func validateForgeFedResolvedByProperty(p vocab.ForgeFedResolvedByProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of resolvedBy",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsResultProperty appends the violations of the values of
+// the "result" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsResultProperty(p vocab.ActivityStreamsResultProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of result",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsSensitiveProperty appends the violations of the values
+// of the "sensitive" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsSensitiveProperty(p vocab.ActivityStreamsSensitiveProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of sensitive",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsSharesProperty appends the violations of the values of
+// the "shares" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsSharesProperty(p vocab.ActivityStreamsSharesProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of shares",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsStartIndexProperty appends the violations of the values
+// of the "startIndex" property that are not in its range, and of the values
+// it embeds.
+func validateActivityStreamsStartIndexProperty(p vocab.ActivityStreamsStartIndexProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of startIndex",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsStartTimeProperty appends the violations of the values
+// of the "startTime" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsStartTimeProperty(p vocab.ActivityStreamsStartTimeProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of startTime",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsStreamsProperty appends the violations of the values of
+// the "streams" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsStreamsProperty(p vocab.ActivityStreamsStreamsProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of streams",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsSubjectProperty appends the violations of the values of
+// the "subject" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsSubjectProperty(p vocab.ActivityStreamsSubjectProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of subject",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsSummaryProperty appends the violations of the values of
+// the "summary" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsSummaryProperty(p vocab.ActivityStreamsSummaryProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of summary",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsTagProperty appends the violations of the values of the
+// "tag" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsTagProperty(p vocab.ActivityStreamsTagProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of tag",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsTargetProperty appends the violations of the values of
+// the "target" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsTargetProperty(p vocab.ActivityStreamsTargetProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of target",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateForgeFedTeamProperty appends the violations of the values of the "team"
+// property that are not in its range, and of the values it embeds.
+func validateForgeFedTeamProperty(p vocab.ForgeFedTeamProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of team",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateForgeFedTicketsTrackedByProperty appends the violations of the values
+// of the "ticketsTrackedBy" property that are not in its range, and of the
+// values it embeds.
+func validateForgeFedTicketsTrackedByProperty(p vocab.ForgeFedTicketsTrackedByProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of ticketsTrackedBy",
+			Path:    path,
+		})
+	} else if t := p.GetType(); t != nil {
+		violations = validate(t, path, violations)
+	}
+	return violations
+}
+
+// validateActivityStreamsToProperty appends the violations of the values of the
+// "to" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsToProperty(p vocab.ActivityStreamsToProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of to",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsTotalItemsProperty appends the violations of the values
+// of the "totalItems" property that are not in its range, and of the values
+// it embeds.
+func validateActivityStreamsTotalItemsProperty(p vocab.ActivityStreamsTotalItemsProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of totalItems",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateForgeFedTracksTicketsForProperty appends the violations of the values
+// of the "tracksTicketsFor" property that are not in its range, and of the
+// values it embeds.
+func validateForgeFedTracksTicketsForProperty(p vocab.ForgeFedTracksTicketsForProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of tracksTicketsFor",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateJSONLDTypeProperty appends the violations of the values of the "type"
+// property that are not in its range, and of the values it embeds.
+func validateJSONLDTypeProperty(p vocab.JSONLDTypeProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of type",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		}
+	}
+	return violations
+}
+
+// validateActivityStreamsUnitsProperty appends the violations of the values of
+// the "units" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsUnitsProperty(p vocab.ActivityStreamsUnitsProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of units",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsUpdatedProperty appends the violations of the values of
+// the "updated" property that are not in its range, and of the values it
+// embeds.
+func validateActivityStreamsUpdatedProperty(p vocab.ActivityStreamsUpdatedProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of updated",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsUrlProperty appends the violations of the values of the
+// "url" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsUrlProperty(p vocab.ActivityStreamsUrlProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).KindIndex() == -1 {
+			violations = append(violations, Violation{
+				Message: "value is not in the range of url",
+				Path:    path + "/" + strconv.Itoa(i),
+			})
+		} else if t := p.At(i).GetType(); t != nil {
+			violations = validate(t, path+"/"+strconv.Itoa(i), violations)
+		}
+	}
+	return violations
+}
+
+// validateSchemaValueProperty appends the violations of the values of the "value"
+// property that are not in its range, and of the values it embeds.
+func validateSchemaValueProperty(p vocab.SchemaValueProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of value",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateTootVotersCountProperty appends the violations of the values of the
+// "votersCount" property that are not in its range, and of the values it
+// embeds.
+func validateTootVotersCountProperty(p vocab.TootVotersCountProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of votersCount",
+			Path:    path,
+		})
+	}
+	return violations
+}
+
+// validateActivityStreamsWidthProperty appends the violations of the values of
+// the "width" property that are not in its range, and of the values it embeds.
+func validateActivityStreamsWidthProperty(p vocab.ActivityStreamsWidthProperty, path string, violations []Violation) []Violation {
+	if p == nil {
+		return violations
+	}
+	path = path + "/" + p.Name()
+	if p.KindIndex() == -1 {
+		violations = append(violations, Violation{
+			Message: "value is not in the range of width",
+			Path:    path,
+		})
+	}
+	return violations
+}
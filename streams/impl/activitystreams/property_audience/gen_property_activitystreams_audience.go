@@ -6,6 +6,7 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"reflect"
 )
 
 // ActivityStreamsAudiencePropertyIterator is an iterator for a property. It is
@@ -27,14 +28,17 @@ type ActivityStreamsAudiencePropertyIterator struct {
 	activitystreamsBlockMember                 vocab.ActivityStreamsBlock
 	activitystreamsCollectionMember            vocab.ActivityStreamsCollection
 	activitystreamsCollectionPageMember        vocab.ActivityStreamsCollectionPage
+	forgefedCommitMember                       vocab.ForgeFedCommit
 	activitystreamsCreateMember                vocab.ActivityStreamsCreate
 	activitystreamsDeleteMember                vocab.ActivityStreamsDelete
 	activitystreamsDislikeMember               vocab.ActivityStreamsDislike
 	activitystreamsDocumentMember              vocab.ActivityStreamsDocument
+	tootEmojiMember                            vocab.TootEmoji
 	activitystreamsEventMember                 vocab.ActivityStreamsEvent
 	activitystreamsFlagMember                  vocab.ActivityStreamsFlag
 	activitystreamsFollowMember                vocab.ActivityStreamsFollow
 	activitystreamsGroupMember                 vocab.ActivityStreamsGroup
+	activitystreamsHashtagMember               vocab.ActivityStreamsHashtag
 	activitystreamsIgnoreMember                vocab.ActivityStreamsIgnore
 	activitystreamsImageMember                 vocab.ActivityStreamsImage
 	activitystreamsIntransitiveActivityMember  vocab.ActivityStreamsIntransitiveActivity
@@ -51,17 +55,23 @@ type ActivityStreamsAudiencePropertyIterator struct {
 	activitystreamsOrderedCollectionPageMember vocab.ActivityStreamsOrderedCollectionPage
 	activitystreamsOrganizationMember          vocab.ActivityStreamsOrganization
 	activitystreamsPageMember                  vocab.ActivityStreamsPage
+	forgefedPatchMember                        vocab.ForgeFedPatch
 	activitystreamsPersonMember                vocab.ActivityStreamsPerson
 	activitystreamsPlaceMember                 vocab.ActivityStreamsPlace
 	activitystreamsProfileMember               vocab.ActivityStreamsProfile
+	schemaPropertyValueMember                  vocab.SchemaPropertyValue
+	forgefedPushMember                         vocab.ForgeFedPush
 	activitystreamsQuestionMember              vocab.ActivityStreamsQuestion
 	activitystreamsReadMember                  vocab.ActivityStreamsRead
 	activitystreamsRejectMember                vocab.ActivityStreamsReject
 	activitystreamsRelationshipMember          vocab.ActivityStreamsRelationship
 	activitystreamsRemoveMember                vocab.ActivityStreamsRemove
+	forgefedRepositoryMember                   vocab.ForgeFedRepository
 	activitystreamsServiceMember               vocab.ActivityStreamsService
 	activitystreamsTentativeAcceptMember       vocab.ActivityStreamsTentativeAccept
 	activitystreamsTentativeRejectMember       vocab.ActivityStreamsTentativeReject
+	forgefedTicketMember                       vocab.ForgeFedTicket
+	forgefedTicketDependencyMember             vocab.ForgeFedTicketDependency
 	activitystreamsTombstoneMember             vocab.ActivityStreamsTombstone
 	activitystreamsTravelMember                vocab.ActivityStreamsTravel
 	activitystreamsUndoMember                  vocab.ActivityStreamsUndo
@@ -81,6 +91,26 @@ func NewActivityStreamsAudiencePropertyIterator() *ActivityStreamsAudienceProper
 	return &ActivityStreamsAudiencePropertyIterator{alias: ""}
 }
 
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
 // deserializeActivityStreamsAudiencePropertyIterator creates an iterator from an
 // element that has been unmarshalled from a text or binary format.
 func deserializeActivityStreamsAudiencePropertyIterator(i interface{}, aliasMap map[string]string) (*ActivityStreamsAudiencePropertyIterator, error) {
@@ -179,6 +209,12 @@ func deserializeActivityStreamsAudiencePropertyIterator(i interface{}, aliasMap
 				alias:                               alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializeCommitForgeFed()(m, aliasMap); err == nil {
+			this := &ActivityStreamsAudiencePropertyIterator{
+				alias:                alias,
+				forgefedCommitMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsAudiencePropertyIterator{
 				activitystreamsCreateMember: v,
@@ -203,6 +239,12 @@ func deserializeActivityStreamsAudiencePropertyIterator(i interface{}, aliasMap
 				alias:                         alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializeEmojiToot()(m, aliasMap); err == nil {
+			this := &ActivityStreamsAudiencePropertyIterator{
+				alias:           alias,
+				tootEmojiMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsAudiencePropertyIterator{
 				activitystreamsEventMember: v,
@@ -227,6 +269,12 @@ func deserializeActivityStreamsAudiencePropertyIterator(i interface{}, aliasMap
 				alias:                      alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializeHashtagActivityStreams()(m, aliasMap); err == nil {
+			this := &ActivityStreamsAudiencePropertyIterator{
+				activitystreamsHashtagMember: v,
+				alias:                        alias,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsAudiencePropertyIterator{
 				activitystreamsIgnoreMember: v,
@@ -323,6 +371,12 @@ func deserializeActivityStreamsAudiencePropertyIterator(i interface{}, aliasMap
 				alias:                     alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializePatchForgeFed()(m, aliasMap); err == nil {
+			this := &ActivityStreamsAudiencePropertyIterator{
+				alias:               alias,
+				forgefedPatchMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsAudiencePropertyIterator{
 				activitystreamsPersonMember: v,
@@ -341,6 +395,18 @@ func deserializeActivityStreamsAudiencePropertyIterator(i interface{}, aliasMap
 				alias:                        alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializePropertyValueSchema()(m, aliasMap); err == nil {
+			this := &ActivityStreamsAudiencePropertyIterator{
+				alias:                     alias,
+				schemaPropertyValueMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializePushForgeFed()(m, aliasMap); err == nil {
+			this := &ActivityStreamsAudiencePropertyIterator{
+				alias:              alias,
+				forgefedPushMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsAudiencePropertyIterator{
 				activitystreamsQuestionMember: v,
@@ -371,6 +437,12 @@ func deserializeActivityStreamsAudiencePropertyIterator(i interface{}, aliasMap
 				alias:                       alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializeRepositoryForgeFed()(m, aliasMap); err == nil {
+			this := &ActivityStreamsAudiencePropertyIterator{
+				alias:                    alias,
+				forgefedRepositoryMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsAudiencePropertyIterator{
 				activitystreamsServiceMember: v,
@@ -389,6 +461,18 @@ func deserializeActivityStreamsAudiencePropertyIterator(i interface{}, aliasMap
 				alias:                                alias,
 			}
 			return this, nil
+		} else if v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap); err == nil {
+			this := &ActivityStreamsAudiencePropertyIterator{
+				alias:                alias,
+				forgefedTicketMember: v,
+			}
+			return this, nil
+		} else if v, err := mgr.DeserializeTicketDependencyForgeFed()(m, aliasMap); err == nil {
+			this := &ActivityStreamsAudiencePropertyIterator{
+				alias:                          alias,
+				forgefedTicketDependencyMember: v,
+			}
+			return this, nil
 		} else if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
 			this := &ActivityStreamsAudiencePropertyIterator{
 				activitystreamsTombstoneMember: v,
@@ -434,6 +518,311 @@ func deserializeActivityStreamsAudiencePropertyIterator(i interface{}, aliasMap
 	return this, nil
 }
 
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
+// Equals determines whether this property has the same value as another,
+// comparing any types it holds deeply.
+func (this ActivityStreamsAudiencePropertyIterator) Equals(o vocab.ActivityStreamsAudiencePropertyIterator) bool {
+	if this.IsActivityStreamsObject() && o.IsActivityStreamsObject() {
+		return this.GetActivityStreamsObject().Equals(o.GetActivityStreamsObject())
+	} else if this.IsActivityStreamsObject() || o.IsActivityStreamsObject() {
+		return false
+	} else if this.IsActivityStreamsLink() && o.IsActivityStreamsLink() {
+		return this.GetActivityStreamsLink().Equals(o.GetActivityStreamsLink())
+	} else if this.IsActivityStreamsLink() || o.IsActivityStreamsLink() {
+		return false
+	} else if this.IsActivityStreamsAccept() && o.IsActivityStreamsAccept() {
+		return this.GetActivityStreamsAccept().Equals(o.GetActivityStreamsAccept())
+	} else if this.IsActivityStreamsAccept() || o.IsActivityStreamsAccept() {
+		return false
+	} else if this.IsActivityStreamsActivity() && o.IsActivityStreamsActivity() {
+		return this.GetActivityStreamsActivity().Equals(o.GetActivityStreamsActivity())
+	} else if this.IsActivityStreamsActivity() || o.IsActivityStreamsActivity() {
+		return false
+	} else if this.IsActivityStreamsAdd() && o.IsActivityStreamsAdd() {
+		return this.GetActivityStreamsAdd().Equals(o.GetActivityStreamsAdd())
+	} else if this.IsActivityStreamsAdd() || o.IsActivityStreamsAdd() {
+		return false
+	} else if this.IsActivityStreamsAnnounce() && o.IsActivityStreamsAnnounce() {
+		return this.GetActivityStreamsAnnounce().Equals(o.GetActivityStreamsAnnounce())
+	} else if this.IsActivityStreamsAnnounce() || o.IsActivityStreamsAnnounce() {
+		return false
+	} else if this.IsActivityStreamsApplication() && o.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication().Equals(o.GetActivityStreamsApplication())
+	} else if this.IsActivityStreamsApplication() || o.IsActivityStreamsApplication() {
+		return false
+	} else if this.IsActivityStreamsArrive() && o.IsActivityStreamsArrive() {
+		return this.GetActivityStreamsArrive().Equals(o.GetActivityStreamsArrive())
+	} else if this.IsActivityStreamsArrive() || o.IsActivityStreamsArrive() {
+		return false
+	} else if this.IsActivityStreamsArticle() && o.IsActivityStreamsArticle() {
+		return this.GetActivityStreamsArticle().Equals(o.GetActivityStreamsArticle())
+	} else if this.IsActivityStreamsArticle() || o.IsActivityStreamsArticle() {
+		return false
+	} else if this.IsActivityStreamsAudio() && o.IsActivityStreamsAudio() {
+		return this.GetActivityStreamsAudio().Equals(o.GetActivityStreamsAudio())
+	} else if this.IsActivityStreamsAudio() || o.IsActivityStreamsAudio() {
+		return false
+	} else if this.IsActivityStreamsBlock() && o.IsActivityStreamsBlock() {
+		return this.GetActivityStreamsBlock().Equals(o.GetActivityStreamsBlock())
+	} else if this.IsActivityStreamsBlock() || o.IsActivityStreamsBlock() {
+		return false
+	} else if this.IsActivityStreamsCollection() && o.IsActivityStreamsCollection() {
+		return this.GetActivityStreamsCollection().Equals(o.GetActivityStreamsCollection())
+	} else if this.IsActivityStreamsCollection() || o.IsActivityStreamsCollection() {
+		return false
+	} else if this.IsActivityStreamsCollectionPage() && o.IsActivityStreamsCollectionPage() {
+		return this.GetActivityStreamsCollectionPage().Equals(o.GetActivityStreamsCollectionPage())
+	} else if this.IsActivityStreamsCollectionPage() || o.IsActivityStreamsCollectionPage() {
+		return false
+	} else if this.IsForgeFedCommit() && o.IsForgeFedCommit() {
+		return this.GetForgeFedCommit().Equals(o.GetForgeFedCommit())
+	} else if this.IsForgeFedCommit() || o.IsForgeFedCommit() {
+		return false
+	} else if this.IsActivityStreamsCreate() && o.IsActivityStreamsCreate() {
+		return this.GetActivityStreamsCreate().Equals(o.GetActivityStreamsCreate())
+	} else if this.IsActivityStreamsCreate() || o.IsActivityStreamsCreate() {
+		return false
+	} else if this.IsActivityStreamsDelete() && o.IsActivityStreamsDelete() {
+		return this.GetActivityStreamsDelete().Equals(o.GetActivityStreamsDelete())
+	} else if this.IsActivityStreamsDelete() || o.IsActivityStreamsDelete() {
+		return false
+	} else if this.IsActivityStreamsDislike() && o.IsActivityStreamsDislike() {
+		return this.GetActivityStreamsDislike().Equals(o.GetActivityStreamsDislike())
+	} else if this.IsActivityStreamsDislike() || o.IsActivityStreamsDislike() {
+		return false
+	} else if this.IsActivityStreamsDocument() && o.IsActivityStreamsDocument() {
+		return this.GetActivityStreamsDocument().Equals(o.GetActivityStreamsDocument())
+	} else if this.IsActivityStreamsDocument() || o.IsActivityStreamsDocument() {
+		return false
+	} else if this.IsTootEmoji() && o.IsTootEmoji() {
+		return this.GetTootEmoji().Equals(o.GetTootEmoji())
+	} else if this.IsTootEmoji() || o.IsTootEmoji() {
+		return false
+	} else if this.IsActivityStreamsEvent() && o.IsActivityStreamsEvent() {
+		return this.GetActivityStreamsEvent().Equals(o.GetActivityStreamsEvent())
+	} else if this.IsActivityStreamsEvent() || o.IsActivityStreamsEvent() {
+		return false
+	} else if this.IsActivityStreamsFlag() && o.IsActivityStreamsFlag() {
+		return this.GetActivityStreamsFlag().Equals(o.GetActivityStreamsFlag())
+	} else if this.IsActivityStreamsFlag() || o.IsActivityStreamsFlag() {
+		return false
+	} else if this.IsActivityStreamsFollow() && o.IsActivityStreamsFollow() {
+		return this.GetActivityStreamsFollow().Equals(o.GetActivityStreamsFollow())
+	} else if this.IsActivityStreamsFollow() || o.IsActivityStreamsFollow() {
+		return false
+	} else if this.IsActivityStreamsGroup() && o.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup().Equals(o.GetActivityStreamsGroup())
+	} else if this.IsActivityStreamsGroup() || o.IsActivityStreamsGroup() {
+		return false
+	} else if this.IsActivityStreamsHashtag() && o.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag().Equals(o.GetActivityStreamsHashtag())
+	} else if this.IsActivityStreamsHashtag() || o.IsActivityStreamsHashtag() {
+		return false
+	} else if this.IsActivityStreamsIgnore() && o.IsActivityStreamsIgnore() {
+		return this.GetActivityStreamsIgnore().Equals(o.GetActivityStreamsIgnore())
+	} else if this.IsActivityStreamsIgnore() || o.IsActivityStreamsIgnore() {
+		return false
+	} else if this.IsActivityStreamsImage() && o.IsActivityStreamsImage() {
+		return this.GetActivityStreamsImage().Equals(o.GetActivityStreamsImage())
+	} else if this.IsActivityStreamsImage() || o.IsActivityStreamsImage() {
+		return false
+	} else if this.IsActivityStreamsIntransitiveActivity() && o.IsActivityStreamsIntransitiveActivity() {
+		return this.GetActivityStreamsIntransitiveActivity().Equals(o.GetActivityStreamsIntransitiveActivity())
+	} else if this.IsActivityStreamsIntransitiveActivity() || o.IsActivityStreamsIntransitiveActivity() {
+		return false
+	} else if this.IsActivityStreamsInvite() && o.IsActivityStreamsInvite() {
+		return this.GetActivityStreamsInvite().Equals(o.GetActivityStreamsInvite())
+	} else if this.IsActivityStreamsInvite() || o.IsActivityStreamsInvite() {
+		return false
+	} else if this.IsActivityStreamsJoin() && o.IsActivityStreamsJoin() {
+		return this.GetActivityStreamsJoin().Equals(o.GetActivityStreamsJoin())
+	} else if this.IsActivityStreamsJoin() || o.IsActivityStreamsJoin() {
+		return false
+	} else if this.IsActivityStreamsLeave() && o.IsActivityStreamsLeave() {
+		return this.GetActivityStreamsLeave().Equals(o.GetActivityStreamsLeave())
+	} else if this.IsActivityStreamsLeave() || o.IsActivityStreamsLeave() {
+		return false
+	} else if this.IsActivityStreamsLike() && o.IsActivityStreamsLike() {
+		return this.GetActivityStreamsLike().Equals(o.GetActivityStreamsLike())
+	} else if this.IsActivityStreamsLike() || o.IsActivityStreamsLike() {
+		return false
+	} else if this.IsActivityStreamsListen() && o.IsActivityStreamsListen() {
+		return this.GetActivityStreamsListen().Equals(o.GetActivityStreamsListen())
+	} else if this.IsActivityStreamsListen() || o.IsActivityStreamsListen() {
+		return false
+	} else if this.IsActivityStreamsMention() && o.IsActivityStreamsMention() {
+		return this.GetActivityStreamsMention().Equals(o.GetActivityStreamsMention())
+	} else if this.IsActivityStreamsMention() || o.IsActivityStreamsMention() {
+		return false
+	} else if this.IsActivityStreamsMove() && o.IsActivityStreamsMove() {
+		return this.GetActivityStreamsMove().Equals(o.GetActivityStreamsMove())
+	} else if this.IsActivityStreamsMove() || o.IsActivityStreamsMove() {
+		return false
+	} else if this.IsActivityStreamsNote() && o.IsActivityStreamsNote() {
+		return this.GetActivityStreamsNote().Equals(o.GetActivityStreamsNote())
+	} else if this.IsActivityStreamsNote() || o.IsActivityStreamsNote() {
+		return false
+	} else if this.IsActivityStreamsOffer() && o.IsActivityStreamsOffer() {
+		return this.GetActivityStreamsOffer().Equals(o.GetActivityStreamsOffer())
+	} else if this.IsActivityStreamsOffer() || o.IsActivityStreamsOffer() {
+		return false
+	} else if this.IsActivityStreamsOrderedCollection() && o.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection().Equals(o.GetActivityStreamsOrderedCollection())
+	} else if this.IsActivityStreamsOrderedCollection() || o.IsActivityStreamsOrderedCollection() {
+		return false
+	} else if this.IsActivityStreamsOrderedCollectionPage() && o.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage().Equals(o.GetActivityStreamsOrderedCollectionPage())
+	} else if this.IsActivityStreamsOrderedCollectionPage() || o.IsActivityStreamsOrderedCollectionPage() {
+		return false
+	} else if this.IsActivityStreamsOrganization() && o.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization().Equals(o.GetActivityStreamsOrganization())
+	} else if this.IsActivityStreamsOrganization() || o.IsActivityStreamsOrganization() {
+		return false
+	} else if this.IsActivityStreamsPage() && o.IsActivityStreamsPage() {
+		return this.GetActivityStreamsPage().Equals(o.GetActivityStreamsPage())
+	} else if this.IsActivityStreamsPage() || o.IsActivityStreamsPage() {
+		return false
+	} else if this.IsForgeFedPatch() && o.IsForgeFedPatch() {
+		return this.GetForgeFedPatch().Equals(o.GetForgeFedPatch())
+	} else if this.IsForgeFedPatch() || o.IsForgeFedPatch() {
+		return false
+	} else if this.IsActivityStreamsPerson() && o.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson().Equals(o.GetActivityStreamsPerson())
+	} else if this.IsActivityStreamsPerson() || o.IsActivityStreamsPerson() {
+		return false
+	} else if this.IsActivityStreamsPlace() && o.IsActivityStreamsPlace() {
+		return this.GetActivityStreamsPlace().Equals(o.GetActivityStreamsPlace())
+	} else if this.IsActivityStreamsPlace() || o.IsActivityStreamsPlace() {
+		return false
+	} else if this.IsActivityStreamsProfile() && o.IsActivityStreamsProfile() {
+		return this.GetActivityStreamsProfile().Equals(o.GetActivityStreamsProfile())
+	} else if this.IsActivityStreamsProfile() || o.IsActivityStreamsProfile() {
+		return false
+	} else if this.IsSchemaPropertyValue() && o.IsSchemaPropertyValue() {
+		return this.GetSchemaPropertyValue().Equals(o.GetSchemaPropertyValue())
+	} else if this.IsSchemaPropertyValue() || o.IsSchemaPropertyValue() {
+		return false
+	} else if this.IsForgeFedPush() && o.IsForgeFedPush() {
+		return this.GetForgeFedPush().Equals(o.GetForgeFedPush())
+	} else if this.IsForgeFedPush() || o.IsForgeFedPush() {
+		return false
+	} else if this.IsActivityStreamsQuestion() && o.IsActivityStreamsQuestion() {
+		return this.GetActivityStreamsQuestion().Equals(o.GetActivityStreamsQuestion())
+	} else if this.IsActivityStreamsQuestion() || o.IsActivityStreamsQuestion() {
+		return false
+	} else if this.IsActivityStreamsRead() && o.IsActivityStreamsRead() {
+		return this.GetActivityStreamsRead().Equals(o.GetActivityStreamsRead())
+	} else if this.IsActivityStreamsRead() || o.IsActivityStreamsRead() {
+		return false
+	} else if this.IsActivityStreamsReject() && o.IsActivityStreamsReject() {
+		return this.GetActivityStreamsReject().Equals(o.GetActivityStreamsReject())
+	} else if this.IsActivityStreamsReject() || o.IsActivityStreamsReject() {
+		return false
+	} else if this.IsActivityStreamsRelationship() && o.IsActivityStreamsRelationship() {
+		return this.GetActivityStreamsRelationship().Equals(o.GetActivityStreamsRelationship())
+	} else if this.IsActivityStreamsRelationship() || o.IsActivityStreamsRelationship() {
+		return false
+	} else if this.IsActivityStreamsRemove() && o.IsActivityStreamsRemove() {
+		return this.GetActivityStreamsRemove().Equals(o.GetActivityStreamsRemove())
+	} else if this.IsActivityStreamsRemove() || o.IsActivityStreamsRemove() {
+		return false
+	} else if this.IsForgeFedRepository() && o.IsForgeFedRepository() {
+		return this.GetForgeFedRepository().Equals(o.GetForgeFedRepository())
+	} else if this.IsForgeFedRepository() || o.IsForgeFedRepository() {
+		return false
+	} else if this.IsActivityStreamsService() && o.IsActivityStreamsService() {
+		return this.GetActivityStreamsService().Equals(o.GetActivityStreamsService())
+	} else if this.IsActivityStreamsService() || o.IsActivityStreamsService() {
+		return false
+	} else if this.IsActivityStreamsTentativeAccept() && o.IsActivityStreamsTentativeAccept() {
+		return this.GetActivityStreamsTentativeAccept().Equals(o.GetActivityStreamsTentativeAccept())
+	} else if this.IsActivityStreamsTentativeAccept() || o.IsActivityStreamsTentativeAccept() {
+		return false
+	} else if this.IsActivityStreamsTentativeReject() && o.IsActivityStreamsTentativeReject() {
+		return this.GetActivityStreamsTentativeReject().Equals(o.GetActivityStreamsTentativeReject())
+	} else if this.IsActivityStreamsTentativeReject() || o.IsActivityStreamsTentativeReject() {
+		return false
+	} else if this.IsForgeFedTicket() && o.IsForgeFedTicket() {
+		return this.GetForgeFedTicket().Equals(o.GetForgeFedTicket())
+	} else if this.IsForgeFedTicket() || o.IsForgeFedTicket() {
+		return false
+	} else if this.IsForgeFedTicketDependency() && o.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency().Equals(o.GetForgeFedTicketDependency())
+	} else if this.IsForgeFedTicketDependency() || o.IsForgeFedTicketDependency() {
+		return false
+	} else if this.IsActivityStreamsTombstone() && o.IsActivityStreamsTombstone() {
+		return this.GetActivityStreamsTombstone().Equals(o.GetActivityStreamsTombstone())
+	} else if this.IsActivityStreamsTombstone() || o.IsActivityStreamsTombstone() {
+		return false
+	} else if this.IsActivityStreamsTravel() && o.IsActivityStreamsTravel() {
+		return this.GetActivityStreamsTravel().Equals(o.GetActivityStreamsTravel())
+	} else if this.IsActivityStreamsTravel() || o.IsActivityStreamsTravel() {
+		return false
+	} else if this.IsActivityStreamsUndo() && o.IsActivityStreamsUndo() {
+		return this.GetActivityStreamsUndo().Equals(o.GetActivityStreamsUndo())
+	} else if this.IsActivityStreamsUndo() || o.IsActivityStreamsUndo() {
+		return false
+	} else if this.IsActivityStreamsUpdate() && o.IsActivityStreamsUpdate() {
+		return this.GetActivityStreamsUpdate().Equals(o.GetActivityStreamsUpdate())
+	} else if this.IsActivityStreamsUpdate() || o.IsActivityStreamsUpdate() {
+		return false
+	} else if this.IsActivityStreamsVideo() && o.IsActivityStreamsVideo() {
+		return this.GetActivityStreamsVideo().Equals(o.GetActivityStreamsVideo())
+	} else if this.IsActivityStreamsVideo() || o.IsActivityStreamsVideo() {
+		return false
+	} else if this.IsActivityStreamsView() && o.IsActivityStreamsView() {
+		return this.GetActivityStreamsView().Equals(o.GetActivityStreamsView())
+	} else if this.IsActivityStreamsView() || o.IsActivityStreamsView() {
+		return false
+	} else if this.IsIRI() && o.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	} else if this.IsIRI() || o.IsIRI() {
+		return false
+	}
+	// Both are unknown values or neither is set.
+	if other, ok := o.(*ActivityStreamsAudiencePropertyIterator); ok {
+		return equalsUnknown(this.unknown, other.unknown)
+	}
+	return this.unknown == nil
+}
+
 // GetActivityStreamsAccept returns the value of this property. When
 // IsActivityStreamsAccept returns false, GetActivityStreamsAccept will return
 // an arbitrary value.
@@ -567,6 +956,13 @@ func (this ActivityStreamsAudiencePropertyIterator) GetActivityStreamsGroup() vo
 	return this.activitystreamsGroupMember
 }
 
+// GetActivityStreamsHashtag returns the value of this property. When
+// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag will
+// return an arbitrary value.
+func (this ActivityStreamsAudiencePropertyIterator) GetActivityStreamsHashtag() vocab.ActivityStreamsHashtag {
+	return this.activitystreamsHashtagMember
+}
+
 // GetActivityStreamsIgnore returns the value of this property. When
 // IsActivityStreamsIgnore returns false, GetActivityStreamsIgnore will return
 // an arbitrary value.
@@ -812,12 +1208,63 @@ func (this ActivityStreamsAudiencePropertyIterator) GetActivityStreamsView() voc
 	return this.activitystreamsViewMember
 }
 
+// GetForgeFedCommit returns the value of this property. When IsForgeFedCommit
+// returns false, GetForgeFedCommit will return an arbitrary value.
+func (this ActivityStreamsAudiencePropertyIterator) GetForgeFedCommit() vocab.ForgeFedCommit {
+	return this.forgefedCommitMember
+}
+
+// GetForgeFedPatch returns the value of this property. When IsForgeFedPatch
+// returns false, GetForgeFedPatch will return an arbitrary value.
+func (this ActivityStreamsAudiencePropertyIterator) GetForgeFedPatch() vocab.ForgeFedPatch {
+	return this.forgefedPatchMember
+}
+
+// GetForgeFedPush returns the value of this property. When IsForgeFedPush returns
+// false, GetForgeFedPush will return an arbitrary value.
+func (this ActivityStreamsAudiencePropertyIterator) GetForgeFedPush() vocab.ForgeFedPush {
+	return this.forgefedPushMember
+}
+
+// GetForgeFedRepository returns the value of this property. When
+// IsForgeFedRepository returns false, GetForgeFedRepository will return an
+// arbitrary value.
+func (this ActivityStreamsAudiencePropertyIterator) GetForgeFedRepository() vocab.ForgeFedRepository {
+	return this.forgefedRepositoryMember
+}
+
+// GetForgeFedTicket returns the value of this property. When IsForgeFedTicket
+// returns false, GetForgeFedTicket will return an arbitrary value.
+func (this ActivityStreamsAudiencePropertyIterator) GetForgeFedTicket() vocab.ForgeFedTicket {
+	return this.forgefedTicketMember
+}
+
+// GetForgeFedTicketDependency returns the value of this property. When
+// IsForgeFedTicketDependency returns false, GetForgeFedTicketDependency will
+// return an arbitrary value.
+func (this ActivityStreamsAudiencePropertyIterator) GetForgeFedTicketDependency() vocab.ForgeFedTicketDependency {
+	return this.forgefedTicketDependencyMember
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
 func (this ActivityStreamsAudiencePropertyIterator) GetIRI() *url.URL {
 	return this.iri
 }
 
+// GetSchemaPropertyValue returns the value of this property. When
+// IsSchemaPropertyValue returns false, GetSchemaPropertyValue will return an
+// arbitrary value.
+func (this ActivityStreamsAudiencePropertyIterator) GetSchemaPropertyValue() vocab.SchemaPropertyValue {
+	return this.schemaPropertyValueMember
+}
+
+// GetTootEmoji returns the value of this property. When IsTootEmoji returns
+// false, GetTootEmoji will return an arbitrary value.
+func (this ActivityStreamsAudiencePropertyIterator) GetTootEmoji() vocab.TootEmoji {
+	return this.tootEmojiMember
+}
+
 // GetType returns the value in this property as a Type. Returns nil if the value
 // is not an ActivityStreams type, such as an IRI or another value.
 func (this ActivityStreamsAudiencePropertyIterator) GetType() vocab.Type {
@@ -860,6 +1307,9 @@ func (this ActivityStreamsAudiencePropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsCollectionPage() {
 		return this.GetActivityStreamsCollectionPage()
 	}
+	if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit()
+	}
 	if this.IsActivityStreamsCreate() {
 		return this.GetActivityStreamsCreate()
 	}
@@ -872,6 +1322,9 @@ func (this ActivityStreamsAudiencePropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument()
 	}
+	if this.IsTootEmoji() {
+		return this.GetTootEmoji()
+	}
 	if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent()
 	}
@@ -884,6 +1337,9 @@ func (this ActivityStreamsAudiencePropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup()
 	}
+	if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag()
+	}
 	if this.IsActivityStreamsIgnore() {
 		return this.GetActivityStreamsIgnore()
 	}
@@ -932,6 +1388,9 @@ func (this ActivityStreamsAudiencePropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsPage() {
 		return this.GetActivityStreamsPage()
 	}
+	if this.IsForgeFedPatch() {
+		return this.GetForgeFedPatch()
+	}
 	if this.IsActivityStreamsPerson() {
 		return this.GetActivityStreamsPerson()
 	}
@@ -941,6 +1400,12 @@ func (this ActivityStreamsAudiencePropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile()
 	}
+	if this.IsSchemaPropertyValue() {
+		return this.GetSchemaPropertyValue()
+	}
+	if this.IsForgeFedPush() {
+		return this.GetForgeFedPush()
+	}
 	if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion()
 	}
@@ -956,6 +1421,9 @@ func (this ActivityStreamsAudiencePropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsRemove() {
 		return this.GetActivityStreamsRemove()
 	}
+	if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository()
+	}
 	if this.IsActivityStreamsService() {
 		return this.GetActivityStreamsService()
 	}
@@ -965,6 +1433,12 @@ func (this ActivityStreamsAudiencePropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsTentativeReject() {
 		return this.GetActivityStreamsTentativeReject()
 	}
+	if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket()
+	}
+	if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency()
+	}
 	if this.IsActivityStreamsTombstone() {
 		return this.GetActivityStreamsTombstone()
 	}
@@ -1002,14 +1476,17 @@ func (this ActivityStreamsAudiencePropertyIterator) HasAny() bool {
 		this.IsActivityStreamsBlock() ||
 		this.IsActivityStreamsCollection() ||
 		this.IsActivityStreamsCollectionPage() ||
+		this.IsForgeFedCommit() ||
 		this.IsActivityStreamsCreate() ||
 		this.IsActivityStreamsDelete() ||
 		this.IsActivityStreamsDislike() ||
 		this.IsActivityStreamsDocument() ||
+		this.IsTootEmoji() ||
 		this.IsActivityStreamsEvent() ||
 		this.IsActivityStreamsFlag() ||
 		this.IsActivityStreamsFollow() ||
 		this.IsActivityStreamsGroup() ||
+		this.IsActivityStreamsHashtag() ||
 		this.IsActivityStreamsIgnore() ||
 		this.IsActivityStreamsImage() ||
 		this.IsActivityStreamsIntransitiveActivity() ||
@@ -1026,17 +1503,23 @@ func (this ActivityStreamsAudiencePropertyIterator) HasAny() bool {
 		this.IsActivityStreamsOrderedCollectionPage() ||
 		this.IsActivityStreamsOrganization() ||
 		this.IsActivityStreamsPage() ||
+		this.IsForgeFedPatch() ||
 		this.IsActivityStreamsPerson() ||
 		this.IsActivityStreamsPlace() ||
 		this.IsActivityStreamsProfile() ||
+		this.IsSchemaPropertyValue() ||
+		this.IsForgeFedPush() ||
 		this.IsActivityStreamsQuestion() ||
 		this.IsActivityStreamsRead() ||
 		this.IsActivityStreamsReject() ||
 		this.IsActivityStreamsRelationship() ||
 		this.IsActivityStreamsRemove() ||
+		this.IsForgeFedRepository() ||
 		this.IsActivityStreamsService() ||
 		this.IsActivityStreamsTentativeAccept() ||
 		this.IsActivityStreamsTentativeReject() ||
+		this.IsForgeFedTicket() ||
+		this.IsForgeFedTicketDependency() ||
 		this.IsActivityStreamsTombstone() ||
 		this.IsActivityStreamsTravel() ||
 		this.IsActivityStreamsUndo() ||
@@ -1179,6 +1662,13 @@ func (this ActivityStreamsAudiencePropertyIterator) IsActivityStreamsGroup() boo
 	return this.activitystreamsGroupMember != nil
 }
 
+// IsActivityStreamsHashtag returns true if this property has a type of "Hashtag".
+// When true, use the GetActivityStreamsHashtag and SetActivityStreamsHashtag
+// methods to access and set this property.
+func (this ActivityStreamsAudiencePropertyIterator) IsActivityStreamsHashtag() bool {
+	return this.activitystreamsHashtagMember != nil
+}
+
 // IsActivityStreamsIgnore returns true if this property has a type of "Ignore".
 // When true, use the GetActivityStreamsIgnore and SetActivityStreamsIgnore
 // methods to access and set this property.
@@ -1429,12 +1919,67 @@ func (this ActivityStreamsAudiencePropertyIterator) IsActivityStreamsView() bool
 	return this.activitystreamsViewMember != nil
 }
 
+// IsForgeFedCommit returns true if this property has a type of "Commit". When
+// true, use the GetForgeFedCommit and SetForgeFedCommit methods to access and
+// set this property.
+func (this ActivityStreamsAudiencePropertyIterator) IsForgeFedCommit() bool {
+	return this.forgefedCommitMember != nil
+}
+
+// IsForgeFedPatch returns true if this property has a type of "Patch". When true,
+// use the GetForgeFedPatch and SetForgeFedPatch methods to access and set
+// this property.
+func (this ActivityStreamsAudiencePropertyIterator) IsForgeFedPatch() bool {
+	return this.forgefedPatchMember != nil
+}
+
+// IsForgeFedPush returns true if this property has a type of "Push". When true,
+// use the GetForgeFedPush and SetForgeFedPush methods to access and set this
+// property.
+func (this ActivityStreamsAudiencePropertyIterator) IsForgeFedPush() bool {
+	return this.forgefedPushMember != nil
+}
+
+// IsForgeFedRepository returns true if this property has a type of "Repository".
+// When true, use the GetForgeFedRepository and SetForgeFedRepository methods
+// to access and set this property.
+func (this ActivityStreamsAudiencePropertyIterator) IsForgeFedRepository() bool {
+	return this.forgefedRepositoryMember != nil
+}
+
+// IsForgeFedTicket returns true if this property has a type of "Ticket". When
+// true, use the GetForgeFedTicket and SetForgeFedTicket methods to access and
+// set this property.
+func (this ActivityStreamsAudiencePropertyIterator) IsForgeFedTicket() bool {
+	return this.forgefedTicketMember != nil
+}
+
+// IsForgeFedTicketDependency returns true if this property has a type of
+// "TicketDependency". When true, use the GetForgeFedTicketDependency and
+// SetForgeFedTicketDependency methods to access and set this property.
+func (this ActivityStreamsAudiencePropertyIterator) IsForgeFedTicketDependency() bool {
+	return this.forgefedTicketDependencyMember != nil
+}
+
 // IsIRI returns true if this property is an IRI. When true, use GetIRI and SetIRI
 // to access and set this property
 func (this ActivityStreamsAudiencePropertyIterator) IsIRI() bool {
 	return this.iri != nil
 }
 
+// IsSchemaPropertyValue returns true if this property has a type of
+// "PropertyValue". When true, use the GetSchemaPropertyValue and
+// SetSchemaPropertyValue methods to access and set this property.
+func (this ActivityStreamsAudiencePropertyIterator) IsSchemaPropertyValue() bool {
+	return this.schemaPropertyValueMember != nil
+}
+
+// IsTootEmoji returns true if this property has a type of "Emoji". When true, use
+// the GetTootEmoji and SetTootEmoji methods to access and set this property.
+func (this ActivityStreamsAudiencePropertyIterator) IsTootEmoji() bool {
+	return this.tootEmojiMember != nil
+}
+
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
@@ -1467,6 +2012,8 @@ func (this ActivityStreamsAudiencePropertyIterator) JSONLDContext() map[string]s
 		child = this.GetActivityStreamsCollection().JSONLDContext()
 	} else if this.IsActivityStreamsCollectionPage() {
 		child = this.GetActivityStreamsCollectionPage().JSONLDContext()
+	} else if this.IsForgeFedCommit() {
+		child = this.GetForgeFedCommit().JSONLDContext()
 	} else if this.IsActivityStreamsCreate() {
 		child = this.GetActivityStreamsCreate().JSONLDContext()
 	} else if this.IsActivityStreamsDelete() {
@@ -1475,6 +2022,8 @@ func (this ActivityStreamsAudiencePropertyIterator) JSONLDContext() map[string]s
 		child = this.GetActivityStreamsDislike().JSONLDContext()
 	} else if this.IsActivityStreamsDocument() {
 		child = this.GetActivityStreamsDocument().JSONLDContext()
+	} else if this.IsTootEmoji() {
+		child = this.GetTootEmoji().JSONLDContext()
 	} else if this.IsActivityStreamsEvent() {
 		child = this.GetActivityStreamsEvent().JSONLDContext()
 	} else if this.IsActivityStreamsFlag() {
@@ -1483,6 +2032,8 @@ func (this ActivityStreamsAudiencePropertyIterator) JSONLDContext() map[string]s
 		child = this.GetActivityStreamsFollow().JSONLDContext()
 	} else if this.IsActivityStreamsGroup() {
 		child = this.GetActivityStreamsGroup().JSONLDContext()
+	} else if this.IsActivityStreamsHashtag() {
+		child = this.GetActivityStreamsHashtag().JSONLDContext()
 	} else if this.IsActivityStreamsIgnore() {
 		child = this.GetActivityStreamsIgnore().JSONLDContext()
 	} else if this.IsActivityStreamsImage() {
@@ -1515,12 +2066,18 @@ func (this ActivityStreamsAudiencePropertyIterator) JSONLDContext() map[string]s
 		child = this.GetActivityStreamsOrganization().JSONLDContext()
 	} else if this.IsActivityStreamsPage() {
 		child = this.GetActivityStreamsPage().JSONLDContext()
+	} else if this.IsForgeFedPatch() {
+		child = this.GetForgeFedPatch().JSONLDContext()
 	} else if this.IsActivityStreamsPerson() {
 		child = this.GetActivityStreamsPerson().JSONLDContext()
 	} else if this.IsActivityStreamsPlace() {
 		child = this.GetActivityStreamsPlace().JSONLDContext()
 	} else if this.IsActivityStreamsProfile() {
 		child = this.GetActivityStreamsProfile().JSONLDContext()
+	} else if this.IsSchemaPropertyValue() {
+		child = this.GetSchemaPropertyValue().JSONLDContext()
+	} else if this.IsForgeFedPush() {
+		child = this.GetForgeFedPush().JSONLDContext()
 	} else if this.IsActivityStreamsQuestion() {
 		child = this.GetActivityStreamsQuestion().JSONLDContext()
 	} else if this.IsActivityStreamsRead() {
@@ -1531,12 +2088,18 @@ func (this ActivityStreamsAudiencePropertyIterator) JSONLDContext() map[string]s
 		child = this.GetActivityStreamsRelationship().JSONLDContext()
 	} else if this.IsActivityStreamsRemove() {
 		child = this.GetActivityStreamsRemove().JSONLDContext()
+	} else if this.IsForgeFedRepository() {
+		child = this.GetForgeFedRepository().JSONLDContext()
 	} else if this.IsActivityStreamsService() {
 		child = this.GetActivityStreamsService().JSONLDContext()
 	} else if this.IsActivityStreamsTentativeAccept() {
 		child = this.GetActivityStreamsTentativeAccept().JSONLDContext()
 	} else if this.IsActivityStreamsTentativeReject() {
 		child = this.GetActivityStreamsTentativeReject().JSONLDContext()
+	} else if this.IsForgeFedTicket() {
+		child = this.GetForgeFedTicket().JSONLDContext()
+	} else if this.IsForgeFedTicketDependency() {
+		child = this.GetForgeFedTicketDependency().JSONLDContext()
 	} else if this.IsActivityStreamsTombstone() {
 		child = this.GetActivityStreamsTombstone().JSONLDContext()
 	} else if this.IsActivityStreamsTravel() {
@@ -1604,129 +2167,156 @@ func (this ActivityStreamsAudiencePropertyIterator) KindIndex() int {
 	if this.IsActivityStreamsCollectionPage() {
 		return 12
 	}
-	if this.IsActivityStreamsCreate() {
+	if this.IsForgeFedCommit() {
 		return 13
 	}
-	if this.IsActivityStreamsDelete() {
+	if this.IsActivityStreamsCreate() {
 		return 14
 	}
-	if this.IsActivityStreamsDislike() {
+	if this.IsActivityStreamsDelete() {
 		return 15
 	}
-	if this.IsActivityStreamsDocument() {
+	if this.IsActivityStreamsDislike() {
 		return 16
 	}
-	if this.IsActivityStreamsEvent() {
+	if this.IsActivityStreamsDocument() {
 		return 17
 	}
-	if this.IsActivityStreamsFlag() {
+	if this.IsTootEmoji() {
 		return 18
 	}
-	if this.IsActivityStreamsFollow() {
+	if this.IsActivityStreamsEvent() {
 		return 19
 	}
-	if this.IsActivityStreamsGroup() {
+	if this.IsActivityStreamsFlag() {
 		return 20
 	}
-	if this.IsActivityStreamsIgnore() {
+	if this.IsActivityStreamsFollow() {
 		return 21
 	}
-	if this.IsActivityStreamsImage() {
+	if this.IsActivityStreamsGroup() {
 		return 22
 	}
-	if this.IsActivityStreamsIntransitiveActivity() {
+	if this.IsActivityStreamsHashtag() {
 		return 23
 	}
-	if this.IsActivityStreamsInvite() {
+	if this.IsActivityStreamsIgnore() {
 		return 24
 	}
-	if this.IsActivityStreamsJoin() {
+	if this.IsActivityStreamsImage() {
 		return 25
 	}
-	if this.IsActivityStreamsLeave() {
+	if this.IsActivityStreamsIntransitiveActivity() {
 		return 26
 	}
-	if this.IsActivityStreamsLike() {
+	if this.IsActivityStreamsInvite() {
 		return 27
 	}
-	if this.IsActivityStreamsListen() {
+	if this.IsActivityStreamsJoin() {
 		return 28
 	}
-	if this.IsActivityStreamsMention() {
+	if this.IsActivityStreamsLeave() {
 		return 29
 	}
-	if this.IsActivityStreamsMove() {
+	if this.IsActivityStreamsLike() {
 		return 30
 	}
-	if this.IsActivityStreamsNote() {
+	if this.IsActivityStreamsListen() {
 		return 31
 	}
-	if this.IsActivityStreamsOffer() {
+	if this.IsActivityStreamsMention() {
 		return 32
 	}
-	if this.IsActivityStreamsOrderedCollection() {
+	if this.IsActivityStreamsMove() {
 		return 33
 	}
-	if this.IsActivityStreamsOrderedCollectionPage() {
+	if this.IsActivityStreamsNote() {
 		return 34
 	}
-	if this.IsActivityStreamsOrganization() {
+	if this.IsActivityStreamsOffer() {
 		return 35
 	}
-	if this.IsActivityStreamsPage() {
+	if this.IsActivityStreamsOrderedCollection() {
 		return 36
 	}
-	if this.IsActivityStreamsPerson() {
+	if this.IsActivityStreamsOrderedCollectionPage() {
 		return 37
 	}
-	if this.IsActivityStreamsPlace() {
+	if this.IsActivityStreamsOrganization() {
 		return 38
 	}
-	if this.IsActivityStreamsProfile() {
+	if this.IsActivityStreamsPage() {
 		return 39
 	}
-	if this.IsActivityStreamsQuestion() {
+	if this.IsForgeFedPatch() {
 		return 40
 	}
-	if this.IsActivityStreamsRead() {
+	if this.IsActivityStreamsPerson() {
 		return 41
 	}
-	if this.IsActivityStreamsReject() {
+	if this.IsActivityStreamsPlace() {
 		return 42
 	}
-	if this.IsActivityStreamsRelationship() {
+	if this.IsActivityStreamsProfile() {
 		return 43
 	}
-	if this.IsActivityStreamsRemove() {
+	if this.IsSchemaPropertyValue() {
 		return 44
 	}
-	if this.IsActivityStreamsService() {
+	if this.IsForgeFedPush() {
 		return 45
 	}
-	if this.IsActivityStreamsTentativeAccept() {
+	if this.IsActivityStreamsQuestion() {
 		return 46
 	}
-	if this.IsActivityStreamsTentativeReject() {
+	if this.IsActivityStreamsRead() {
 		return 47
 	}
-	if this.IsActivityStreamsTombstone() {
+	if this.IsActivityStreamsReject() {
 		return 48
 	}
-	if this.IsActivityStreamsTravel() {
+	if this.IsActivityStreamsRelationship() {
 		return 49
 	}
-	if this.IsActivityStreamsUndo() {
+	if this.IsActivityStreamsRemove() {
 		return 50
 	}
-	if this.IsActivityStreamsUpdate() {
+	if this.IsForgeFedRepository() {
 		return 51
 	}
-	if this.IsActivityStreamsVideo() {
+	if this.IsActivityStreamsService() {
 		return 52
 	}
-	if this.IsActivityStreamsView() {
+	if this.IsActivityStreamsTentativeAccept() {
 		return 53
 	}
+	if this.IsActivityStreamsTentativeReject() {
+		return 54
+	}
+	if this.IsForgeFedTicket() {
+		return 55
+	}
+	if this.IsForgeFedTicketDependency() {
+		return 56
+	}
+	if this.IsActivityStreamsTombstone() {
+		return 57
+	}
+	if this.IsActivityStreamsTravel() {
+		return 58
+	}
+	if this.IsActivityStreamsUndo() {
+		return 59
+	}
+	if this.IsActivityStreamsUpdate() {
+		return 60
+	}
+	if this.IsActivityStreamsVideo() {
+		return 61
+	}
+	if this.IsActivityStreamsView() {
+		return 62
+	}
 	if this.IsIRI() {
 		return -2
 	}
@@ -1770,6 +2360,8 @@ func (this ActivityStreamsAudiencePropertyIterator) LessThan(o vocab.ActivityStr
 		return this.GetActivityStreamsCollection().LessThan(o.GetActivityStreamsCollection())
 	} else if this.IsActivityStreamsCollectionPage() {
 		return this.GetActivityStreamsCollectionPage().LessThan(o.GetActivityStreamsCollectionPage())
+	} else if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit().LessThan(o.GetForgeFedCommit())
 	} else if this.IsActivityStreamsCreate() {
 		return this.GetActivityStreamsCreate().LessThan(o.GetActivityStreamsCreate())
 	} else if this.IsActivityStreamsDelete() {
@@ -1778,6 +2370,8 @@ func (this ActivityStreamsAudiencePropertyIterator) LessThan(o vocab.ActivityStr
 		return this.GetActivityStreamsDislike().LessThan(o.GetActivityStreamsDislike())
 	} else if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument().LessThan(o.GetActivityStreamsDocument())
+	} else if this.IsTootEmoji() {
+		return this.GetTootEmoji().LessThan(o.GetTootEmoji())
 	} else if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent().LessThan(o.GetActivityStreamsEvent())
 	} else if this.IsActivityStreamsFlag() {
@@ -1786,6 +2380,8 @@ func (this ActivityStreamsAudiencePropertyIterator) LessThan(o vocab.ActivityStr
 		return this.GetActivityStreamsFollow().LessThan(o.GetActivityStreamsFollow())
 	} else if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup().LessThan(o.GetActivityStreamsGroup())
+	} else if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag().LessThan(o.GetActivityStreamsHashtag())
 	} else if this.IsActivityStreamsIgnore() {
 		return this.GetActivityStreamsIgnore().LessThan(o.GetActivityStreamsIgnore())
 	} else if this.IsActivityStreamsImage() {
@@ -1818,12 +2414,18 @@ func (this ActivityStreamsAudiencePropertyIterator) LessThan(o vocab.ActivityStr
 		return this.GetActivityStreamsOrganization().LessThan(o.GetActivityStreamsOrganization())
 	} else if this.IsActivityStreamsPage() {
 		return this.GetActivityStreamsPage().LessThan(o.GetActivityStreamsPage())
+	} else if this.IsForgeFedPatch() {
+		return this.GetForgeFedPatch().LessThan(o.GetForgeFedPatch())
 	} else if this.IsActivityStreamsPerson() {
 		return this.GetActivityStreamsPerson().LessThan(o.GetActivityStreamsPerson())
 	} else if this.IsActivityStreamsPlace() {
 		return this.GetActivityStreamsPlace().LessThan(o.GetActivityStreamsPlace())
 	} else if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile().LessThan(o.GetActivityStreamsProfile())
+	} else if this.IsSchemaPropertyValue() {
+		return this.GetSchemaPropertyValue().LessThan(o.GetSchemaPropertyValue())
+	} else if this.IsForgeFedPush() {
+		return this.GetForgeFedPush().LessThan(o.GetForgeFedPush())
 	} else if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion().LessThan(o.GetActivityStreamsQuestion())
 	} else if this.IsActivityStreamsRead() {
@@ -1834,12 +2436,18 @@ func (this ActivityStreamsAudiencePropertyIterator) LessThan(o vocab.ActivityStr
 		return this.GetActivityStreamsRelationship().LessThan(o.GetActivityStreamsRelationship())
 	} else if this.IsActivityStreamsRemove() {
 		return this.GetActivityStreamsRemove().LessThan(o.GetActivityStreamsRemove())
+	} else if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository().LessThan(o.GetForgeFedRepository())
 	} else if this.IsActivityStreamsService() {
 		return this.GetActivityStreamsService().LessThan(o.GetActivityStreamsService())
 	} else if this.IsActivityStreamsTentativeAccept() {
 		return this.GetActivityStreamsTentativeAccept().LessThan(o.GetActivityStreamsTentativeAccept())
 	} else if this.IsActivityStreamsTentativeReject() {
 		return this.GetActivityStreamsTentativeReject().LessThan(o.GetActivityStreamsTentativeReject())
+	} else if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket().LessThan(o.GetForgeFedTicket())
+	} else if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency().LessThan(o.GetForgeFedTicketDependency())
 	} else if this.IsActivityStreamsTombstone() {
 		return this.GetActivityStreamsTombstone().LessThan(o.GetActivityStreamsTombstone())
 	} else if this.IsActivityStreamsTravel() {
@@ -2018,6 +2626,13 @@ func (this *ActivityStreamsAudiencePropertyIterator) SetActivityStreamsGroup(v v
 	this.activitystreamsGroupMember = v
 }
 
+// SetActivityStreamsHashtag sets the value of this property. Calling
+// IsActivityStreamsHashtag afterwards returns true.
+func (this *ActivityStreamsAudiencePropertyIterator) SetActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.clear()
+	this.activitystreamsHashtagMember = v
+}
+
 // SetActivityStreamsIgnore sets the value of this property. Calling
 // IsActivityStreamsIgnore afterwards returns true.
 func (this *ActivityStreamsAudiencePropertyIterator) SetActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
@@ -2263,12 +2878,68 @@ func (this *ActivityStreamsAudiencePropertyIterator) SetActivityStreamsView(v vo
 	this.activitystreamsViewMember = v
 }
 
+// SetForgeFedCommit sets the value of this property. Calling IsForgeFedCommit
+// afterwards returns true.
+func (this *ActivityStreamsAudiencePropertyIterator) SetForgeFedCommit(v vocab.ForgeFedCommit) {
+	this.clear()
+	this.forgefedCommitMember = v
+}
+
+// SetForgeFedPatch sets the value of this property. Calling IsForgeFedPatch
+// afterwards returns true.
+func (this *ActivityStreamsAudiencePropertyIterator) SetForgeFedPatch(v vocab.ForgeFedPatch) {
+	this.clear()
+	this.forgefedPatchMember = v
+}
+
+// SetForgeFedPush sets the value of this property. Calling IsForgeFedPush
+// afterwards returns true.
+func (this *ActivityStreamsAudiencePropertyIterator) SetForgeFedPush(v vocab.ForgeFedPush) {
+	this.clear()
+	this.forgefedPushMember = v
+}
+
+// SetForgeFedRepository sets the value of this property. Calling
+// IsForgeFedRepository afterwards returns true.
+func (this *ActivityStreamsAudiencePropertyIterator) SetForgeFedRepository(v vocab.ForgeFedRepository) {
+	this.clear()
+	this.forgefedRepositoryMember = v
+}
+
+// SetForgeFedTicket sets the value of this property. Calling IsForgeFedTicket
+// afterwards returns true.
+func (this *ActivityStreamsAudiencePropertyIterator) SetForgeFedTicket(v vocab.ForgeFedTicket) {
+	this.clear()
+	this.forgefedTicketMember = v
+}
+
+// SetForgeFedTicketDependency sets the value of this property. Calling
+// IsForgeFedTicketDependency afterwards returns true.
+func (this *ActivityStreamsAudiencePropertyIterator) SetForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
+	this.clear()
+	this.forgefedTicketDependencyMember = v
+}
+
 // SetIRI sets the value of this property. Calling IsIRI afterwards returns true.
 func (this *ActivityStreamsAudiencePropertyIterator) SetIRI(v *url.URL) {
 	this.clear()
 	this.iri = v
 }
 
+// SetSchemaPropertyValue sets the value of this property. Calling
+// IsSchemaPropertyValue afterwards returns true.
+func (this *ActivityStreamsAudiencePropertyIterator) SetSchemaPropertyValue(v vocab.SchemaPropertyValue) {
+	this.clear()
+	this.schemaPropertyValueMember = v
+}
+
+// SetTootEmoji sets the value of this property. Calling IsTootEmoji afterwards
+// returns true.
+func (this *ActivityStreamsAudiencePropertyIterator) SetTootEmoji(v vocab.TootEmoji) {
+	this.clear()
+	this.tootEmojiMember = v
+}
+
 // SetType attempts to set the property for the arbitrary type. Returns an error
 // if it is not a valid type to set on this property.
 func (this *ActivityStreamsAudiencePropertyIterator) SetType(t vocab.Type) error {
@@ -2324,6 +2995,10 @@ func (this *ActivityStreamsAudiencePropertyIterator) SetType(t vocab.Type) error
 		this.SetActivityStreamsCollectionPage(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ForgeFedCommit); ok {
+		this.SetForgeFedCommit(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsCreate); ok {
 		this.SetActivityStreamsCreate(v)
 		return nil
@@ -2340,6 +3015,10 @@ func (this *ActivityStreamsAudiencePropertyIterator) SetType(t vocab.Type) error
 		this.SetActivityStreamsDocument(v)
 		return nil
 	}
+	if v, ok := t.(vocab.TootEmoji); ok {
+		this.SetTootEmoji(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsEvent); ok {
 		this.SetActivityStreamsEvent(v)
 		return nil
@@ -2356,6 +3035,10 @@ func (this *ActivityStreamsAudiencePropertyIterator) SetType(t vocab.Type) error
 		this.SetActivityStreamsGroup(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ActivityStreamsHashtag); ok {
+		this.SetActivityStreamsHashtag(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsIgnore); ok {
 		this.SetActivityStreamsIgnore(v)
 		return nil
@@ -2420,6 +3103,10 @@ func (this *ActivityStreamsAudiencePropertyIterator) SetType(t vocab.Type) error
 		this.SetActivityStreamsPage(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ForgeFedPatch); ok {
+		this.SetForgeFedPatch(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsPerson); ok {
 		this.SetActivityStreamsPerson(v)
 		return nil
@@ -2432,6 +3119,14 @@ func (this *ActivityStreamsAudiencePropertyIterator) SetType(t vocab.Type) error
 		this.SetActivityStreamsProfile(v)
 		return nil
 	}
+	if v, ok := t.(vocab.SchemaPropertyValue); ok {
+		this.SetSchemaPropertyValue(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedPush); ok {
+		this.SetForgeFedPush(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsQuestion); ok {
 		this.SetActivityStreamsQuestion(v)
 		return nil
@@ -2452,6 +3147,10 @@ func (this *ActivityStreamsAudiencePropertyIterator) SetType(t vocab.Type) error
 		this.SetActivityStreamsRemove(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ForgeFedRepository); ok {
+		this.SetForgeFedRepository(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsService); ok {
 		this.SetActivityStreamsService(v)
 		return nil
@@ -2464,6 +3163,14 @@ func (this *ActivityStreamsAudiencePropertyIterator) SetType(t vocab.Type) error
 		this.SetActivityStreamsTentativeReject(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ForgeFedTicket); ok {
+		this.SetForgeFedTicket(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ForgeFedTicketDependency); ok {
+		this.SetForgeFedTicketDependency(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsTombstone); ok {
 		this.SetActivityStreamsTombstone(v)
 		return nil
@@ -2508,14 +3215,17 @@ func (this *ActivityStreamsAudiencePropertyIterator) clear() {
 	this.activitystreamsBlockMember = nil
 	this.activitystreamsCollectionMember = nil
 	this.activitystreamsCollectionPageMember = nil
+	this.forgefedCommitMember = nil
 	this.activitystreamsCreateMember = nil
 	this.activitystreamsDeleteMember = nil
 	this.activitystreamsDislikeMember = nil
 	this.activitystreamsDocumentMember = nil
+	this.tootEmojiMember = nil
 	this.activitystreamsEventMember = nil
 	this.activitystreamsFlagMember = nil
 	this.activitystreamsFollowMember = nil
 	this.activitystreamsGroupMember = nil
+	this.activitystreamsHashtagMember = nil
 	this.activitystreamsIgnoreMember = nil
 	this.activitystreamsImageMember = nil
 	this.activitystreamsIntransitiveActivityMember = nil
@@ -2532,17 +3242,23 @@ func (this *ActivityStreamsAudiencePropertyIterator) clear() {
 	this.activitystreamsOrderedCollectionPageMember = nil
 	this.activitystreamsOrganizationMember = nil
 	this.activitystreamsPageMember = nil
+	this.forgefedPatchMember = nil
 	this.activitystreamsPersonMember = nil
 	this.activitystreamsPlaceMember = nil
 	this.activitystreamsProfileMember = nil
+	this.schemaPropertyValueMember = nil
+	this.forgefedPushMember = nil
 	this.activitystreamsQuestionMember = nil
 	this.activitystreamsReadMember = nil
 	this.activitystreamsRejectMember = nil
 	this.activitystreamsRelationshipMember = nil
 	this.activitystreamsRemoveMember = nil
+	this.forgefedRepositoryMember = nil
 	this.activitystreamsServiceMember = nil
 	this.activitystreamsTentativeAcceptMember = nil
 	this.activitystreamsTentativeRejectMember = nil
+	this.forgefedTicketMember = nil
+	this.forgefedTicketDependencyMember = nil
 	this.activitystreamsTombstoneMember = nil
 	this.activitystreamsTravelMember = nil
 	this.activitystreamsUndoMember = nil
@@ -2553,6 +3269,207 @@ func (this *ActivityStreamsAudiencePropertyIterator) clear() {
 	this.iri = nil
 }
 
+// clone returns a deep copy of this iterator belonging to the parent property.
+func (this ActivityStreamsAudiencePropertyIterator) clone(parent vocab.ActivityStreamsAudienceProperty) *ActivityStreamsAudiencePropertyIterator {
+	clone := this
+	if this.activitystreamsObjectMember != nil {
+		clone.activitystreamsObjectMember = this.activitystreamsObjectMember.Clone()
+	}
+	if this.activitystreamsLinkMember != nil {
+		clone.activitystreamsLinkMember = this.activitystreamsLinkMember.Clone()
+	}
+	if this.activitystreamsAcceptMember != nil {
+		clone.activitystreamsAcceptMember = this.activitystreamsAcceptMember.Clone()
+	}
+	if this.activitystreamsActivityMember != nil {
+		clone.activitystreamsActivityMember = this.activitystreamsActivityMember.Clone()
+	}
+	if this.activitystreamsAddMember != nil {
+		clone.activitystreamsAddMember = this.activitystreamsAddMember.Clone()
+	}
+	if this.activitystreamsAnnounceMember != nil {
+		clone.activitystreamsAnnounceMember = this.activitystreamsAnnounceMember.Clone()
+	}
+	if this.activitystreamsApplicationMember != nil {
+		clone.activitystreamsApplicationMember = this.activitystreamsApplicationMember.Clone()
+	}
+	if this.activitystreamsArriveMember != nil {
+		clone.activitystreamsArriveMember = this.activitystreamsArriveMember.Clone()
+	}
+	if this.activitystreamsArticleMember != nil {
+		clone.activitystreamsArticleMember = this.activitystreamsArticleMember.Clone()
+	}
+	if this.activitystreamsAudioMember != nil {
+		clone.activitystreamsAudioMember = this.activitystreamsAudioMember.Clone()
+	}
+	if this.activitystreamsBlockMember != nil {
+		clone.activitystreamsBlockMember = this.activitystreamsBlockMember.Clone()
+	}
+	if this.activitystreamsCollectionMember != nil {
+		clone.activitystreamsCollectionMember = this.activitystreamsCollectionMember.Clone()
+	}
+	if this.activitystreamsCollectionPageMember != nil {
+		clone.activitystreamsCollectionPageMember = this.activitystreamsCollectionPageMember.Clone()
+	}
+	if this.forgefedCommitMember != nil {
+		clone.forgefedCommitMember = this.forgefedCommitMember.Clone()
+	}
+	if this.activitystreamsCreateMember != nil {
+		clone.activitystreamsCreateMember = this.activitystreamsCreateMember.Clone()
+	}
+	if this.activitystreamsDeleteMember != nil {
+		clone.activitystreamsDeleteMember = this.activitystreamsDeleteMember.Clone()
+	}
+	if this.activitystreamsDislikeMember != nil {
+		clone.activitystreamsDislikeMember = this.activitystreamsDislikeMember.Clone()
+	}
+	if this.activitystreamsDocumentMember != nil {
+		clone.activitystreamsDocumentMember = this.activitystreamsDocumentMember.Clone()
+	}
+	if this.tootEmojiMember != nil {
+		clone.tootEmojiMember = this.tootEmojiMember.Clone()
+	}
+	if this.activitystreamsEventMember != nil {
+		clone.activitystreamsEventMember = this.activitystreamsEventMember.Clone()
+	}
+	if this.activitystreamsFlagMember != nil {
+		clone.activitystreamsFlagMember = this.activitystreamsFlagMember.Clone()
+	}
+	if this.activitystreamsFollowMember != nil {
+		clone.activitystreamsFollowMember = this.activitystreamsFollowMember.Clone()
+	}
+	if this.activitystreamsGroupMember != nil {
+		clone.activitystreamsGroupMember = this.activitystreamsGroupMember.Clone()
+	}
+	if this.activitystreamsHashtagMember != nil {
+		clone.activitystreamsHashtagMember = this.activitystreamsHashtagMember.Clone()
+	}
+	if this.activitystreamsIgnoreMember != nil {
+		clone.activitystreamsIgnoreMember = this.activitystreamsIgnoreMember.Clone()
+	}
+	if this.activitystreamsImageMember != nil {
+		clone.activitystreamsImageMember = this.activitystreamsImageMember.Clone()
+	}
+	if this.activitystreamsIntransitiveActivityMember != nil {
+		clone.activitystreamsIntransitiveActivityMember = this.activitystreamsIntransitiveActivityMember.Clone()
+	}
+	if this.activitystreamsInviteMember != nil {
+		clone.activitystreamsInviteMember = this.activitystreamsInviteMember.Clone()
+	}
+	if this.activitystreamsJoinMember != nil {
+		clone.activitystreamsJoinMember = this.activitystreamsJoinMember.Clone()
+	}
+	if this.activitystreamsLeaveMember != nil {
+		clone.activitystreamsLeaveMember = this.activitystreamsLeaveMember.Clone()
+	}
+	if this.activitystreamsLikeMember != nil {
+		clone.activitystreamsLikeMember = this.activitystreamsLikeMember.Clone()
+	}
+	if this.activitystreamsListenMember != nil {
+		clone.activitystreamsListenMember = this.activitystreamsListenMember.Clone()
+	}
+	if this.activitystreamsMentionMember != nil {
+		clone.activitystreamsMentionMember = this.activitystreamsMentionMember.Clone()
+	}
+	if this.activitystreamsMoveMember != nil {
+		clone.activitystreamsMoveMember = this.activitystreamsMoveMember.Clone()
+	}
+	if this.activitystreamsNoteMember != nil {
+		clone.activitystreamsNoteMember = this.activitystreamsNoteMember.Clone()
+	}
+	if this.activitystreamsOfferMember != nil {
+		clone.activitystreamsOfferMember = this.activitystreamsOfferMember.Clone()
+	}
+	if this.activitystreamsOrderedCollectionMember != nil {
+		clone.activitystreamsOrderedCollectionMember = this.activitystreamsOrderedCollectionMember.Clone()
+	}
+	if this.activitystreamsOrderedCollectionPageMember != nil {
+		clone.activitystreamsOrderedCollectionPageMember = this.activitystreamsOrderedCollectionPageMember.Clone()
+	}
+	if this.activitystreamsOrganizationMember != nil {
+		clone.activitystreamsOrganizationMember = this.activitystreamsOrganizationMember.Clone()
+	}
+	if this.activitystreamsPageMember != nil {
+		clone.activitystreamsPageMember = this.activitystreamsPageMember.Clone()
+	}
+	if this.forgefedPatchMember != nil {
+		clone.forgefedPatchMember = this.forgefedPatchMember.Clone()
+	}
+	if this.activitystreamsPersonMember != nil {
+		clone.activitystreamsPersonMember = this.activitystreamsPersonMember.Clone()
+	}
+	if this.activitystreamsPlaceMember != nil {
+		clone.activitystreamsPlaceMember = this.activitystreamsPlaceMember.Clone()
+	}
+	if this.activitystreamsProfileMember != nil {
+		clone.activitystreamsProfileMember = this.activitystreamsProfileMember.Clone()
+	}
+	if this.schemaPropertyValueMember != nil {
+		clone.schemaPropertyValueMember = this.schemaPropertyValueMember.Clone()
+	}
+	if this.forgefedPushMember != nil {
+		clone.forgefedPushMember = this.forgefedPushMember.Clone()
+	}
+	if this.activitystreamsQuestionMember != nil {
+		clone.activitystreamsQuestionMember = this.activitystreamsQuestionMember.Clone()
+	}
+	if this.activitystreamsReadMember != nil {
+		clone.activitystreamsReadMember = this.activitystreamsReadMember.Clone()
+	}
+	if this.activitystreamsRejectMember != nil {
+		clone.activitystreamsRejectMember = this.activitystreamsRejectMember.Clone()
+	}
+	if this.activitystreamsRelationshipMember != nil {
+		clone.activitystreamsRelationshipMember = this.activitystreamsRelationshipMember.Clone()
+	}
+	if this.activitystreamsRemoveMember != nil {
+		clone.activitystreamsRemoveMember = this.activitystreamsRemoveMember.Clone()
+	}
+	if this.forgefedRepositoryMember != nil {
+		clone.forgefedRepositoryMember = this.forgefedRepositoryMember.Clone()
+	}
+	if this.activitystreamsServiceMember != nil {
+		clone.activitystreamsServiceMember = this.activitystreamsServiceMember.Clone()
+	}
+	if this.activitystreamsTentativeAcceptMember != nil {
+		clone.activitystreamsTentativeAcceptMember = this.activitystreamsTentativeAcceptMember.Clone()
+	}
+	if this.activitystreamsTentativeRejectMember != nil {
+		clone.activitystreamsTentativeRejectMember = this.activitystreamsTentativeRejectMember.Clone()
+	}
+	if this.forgefedTicketMember != nil {
+		clone.forgefedTicketMember = this.forgefedTicketMember.Clone()
+	}
+	if this.forgefedTicketDependencyMember != nil {
+		clone.forgefedTicketDependencyMember = this.forgefedTicketDependencyMember.Clone()
+	}
+	if this.activitystreamsTombstoneMember != nil {
+		clone.activitystreamsTombstoneMember = this.activitystreamsTombstoneMember.Clone()
+	}
+	if this.activitystreamsTravelMember != nil {
+		clone.activitystreamsTravelMember = this.activitystreamsTravelMember.Clone()
+	}
+	if this.activitystreamsUndoMember != nil {
+		clone.activitystreamsUndoMember = this.activitystreamsUndoMember.Clone()
+	}
+	if this.activitystreamsUpdateMember != nil {
+		clone.activitystreamsUpdateMember = this.activitystreamsUpdateMember.Clone()
+	}
+	if this.activitystreamsVideoMember != nil {
+		clone.activitystreamsVideoMember = this.activitystreamsVideoMember.Clone()
+	}
+	if this.activitystreamsViewMember != nil {
+		clone.activitystreamsViewMember = this.activitystreamsViewMember.Clone()
+	}
+	if this.iri != nil {
+		iri := *this.iri
+		clone.iri = &iri
+	}
+	clone.unknown = cloneUnknown(this.unknown)
+	clone.parent = parent
+	return &clone
+}
+
 // serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -2584,6 +3501,8 @@ func (this ActivityStreamsAudiencePropertyIterator) serialize() (interface{}, er
 		return this.GetActivityStreamsCollection().Serialize()
 	} else if this.IsActivityStreamsCollectionPage() {
 		return this.GetActivityStreamsCollectionPage().Serialize()
+	} else if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit().Serialize()
 	} else if this.IsActivityStreamsCreate() {
 		return this.GetActivityStreamsCreate().Serialize()
 	} else if this.IsActivityStreamsDelete() {
@@ -2592,6 +3511,8 @@ func (this ActivityStreamsAudiencePropertyIterator) serialize() (interface{}, er
 		return this.GetActivityStreamsDislike().Serialize()
 	} else if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument().Serialize()
+	} else if this.IsTootEmoji() {
+		return this.GetTootEmoji().Serialize()
 	} else if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent().Serialize()
 	} else if this.IsActivityStreamsFlag() {
@@ -2600,6 +3521,8 @@ func (this ActivityStreamsAudiencePropertyIterator) serialize() (interface{}, er
 		return this.GetActivityStreamsFollow().Serialize()
 	} else if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup().Serialize()
+	} else if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag().Serialize()
 	} else if this.IsActivityStreamsIgnore() {
 		return this.GetActivityStreamsIgnore().Serialize()
 	} else if this.IsActivityStreamsImage() {
@@ -2632,12 +3555,18 @@ func (this ActivityStreamsAudiencePropertyIterator) serialize() (interface{}, er
 		return this.GetActivityStreamsOrganization().Serialize()
 	} else if this.IsActivityStreamsPage() {
 		return this.GetActivityStreamsPage().Serialize()
+	} else if this.IsForgeFedPatch() {
+		return this.GetForgeFedPatch().Serialize()
 	} else if this.IsActivityStreamsPerson() {
 		return this.GetActivityStreamsPerson().Serialize()
 	} else if this.IsActivityStreamsPlace() {
 		return this.GetActivityStreamsPlace().Serialize()
 	} else if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile().Serialize()
+	} else if this.IsSchemaPropertyValue() {
+		return this.GetSchemaPropertyValue().Serialize()
+	} else if this.IsForgeFedPush() {
+		return this.GetForgeFedPush().Serialize()
 	} else if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion().Serialize()
 	} else if this.IsActivityStreamsRead() {
@@ -2648,12 +3577,18 @@ func (this ActivityStreamsAudiencePropertyIterator) serialize() (interface{}, er
 		return this.GetActivityStreamsRelationship().Serialize()
 	} else if this.IsActivityStreamsRemove() {
 		return this.GetActivityStreamsRemove().Serialize()
+	} else if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository().Serialize()
 	} else if this.IsActivityStreamsService() {
 		return this.GetActivityStreamsService().Serialize()
 	} else if this.IsActivityStreamsTentativeAccept() {
 		return this.GetActivityStreamsTentativeAccept().Serialize()
 	} else if this.IsActivityStreamsTentativeReject() {
 		return this.GetActivityStreamsTentativeReject().Serialize()
+	} else if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket().Serialize()
+	} else if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency().Serialize()
 	} else if this.IsActivityStreamsTombstone() {
 		return this.GetActivityStreamsTombstone().Serialize()
 	} else if this.IsActivityStreamsTravel() {
@@ -2944,6 +3879,18 @@ func (this *ActivityStreamsAudienceProperty) AppendActivityStreamsGroup(v vocab.
 	})
 }
 
+// AppendActivityStreamsHashtag appends a Hashtag value to the back of a list of
+// the property "audience". Invalidates iterators that are traversing using
+// Prev.
+func (this *ActivityStreamsAudienceProperty) AppendActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.properties = append(this.properties, &ActivityStreamsAudiencePropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+		myIdx:                        this.Len(),
+		parent:                       this,
+	})
+}
+
 // AppendActivityStreamsIgnore appends a Ignore value to the back of a list of the
 // property "audience". Invalidates iterators that are traversing using Prev.
 func (this *ActivityStreamsAudienceProperty) AppendActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
@@ -3341,6 +4288,74 @@ func (this *ActivityStreamsAudienceProperty) AppendActivityStreamsView(v vocab.A
 	})
 }
 
+// AppendForgeFedCommit appends a Commit value to the back of a list of the
+// property "audience". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsAudienceProperty) AppendForgeFedCommit(v vocab.ForgeFedCommit) {
+	this.properties = append(this.properties, &ActivityStreamsAudiencePropertyIterator{
+		alias:                this.alias,
+		forgefedCommitMember: v,
+		myIdx:                this.Len(),
+		parent:               this,
+	})
+}
+
+// AppendForgeFedPatch appends a Patch value to the back of a list of the property
+// "audience". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsAudienceProperty) AppendForgeFedPatch(v vocab.ForgeFedPatch) {
+	this.properties = append(this.properties, &ActivityStreamsAudiencePropertyIterator{
+		alias:               this.alias,
+		forgefedPatchMember: v,
+		myIdx:               this.Len(),
+		parent:              this,
+	})
+}
+
+// AppendForgeFedPush appends a Push value to the back of a list of the property
+// "audience". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsAudienceProperty) AppendForgeFedPush(v vocab.ForgeFedPush) {
+	this.properties = append(this.properties, &ActivityStreamsAudiencePropertyIterator{
+		alias:              this.alias,
+		forgefedPushMember: v,
+		myIdx:              this.Len(),
+		parent:             this,
+	})
+}
+
+// AppendForgeFedRepository appends a Repository value to the back of a list of
+// the property "audience". Invalidates iterators that are traversing using
+// Prev.
+func (this *ActivityStreamsAudienceProperty) AppendForgeFedRepository(v vocab.ForgeFedRepository) {
+	this.properties = append(this.properties, &ActivityStreamsAudiencePropertyIterator{
+		alias:                    this.alias,
+		forgefedRepositoryMember: v,
+		myIdx:                    this.Len(),
+		parent:                   this,
+	})
+}
+
+// AppendForgeFedTicket appends a Ticket value to the back of a list of the
+// property "audience". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsAudienceProperty) AppendForgeFedTicket(v vocab.ForgeFedTicket) {
+	this.properties = append(this.properties, &ActivityStreamsAudiencePropertyIterator{
+		alias:                this.alias,
+		forgefedTicketMember: v,
+		myIdx:                this.Len(),
+		parent:               this,
+	})
+}
+
+// AppendForgeFedTicketDependency appends a TicketDependency value to the back of
+// a list of the property "audience". Invalidates iterators that are
+// traversing using Prev.
+func (this *ActivityStreamsAudienceProperty) AppendForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
+	this.properties = append(this.properties, &ActivityStreamsAudiencePropertyIterator{
+		alias:                          this.alias,
+		forgefedTicketDependencyMember: v,
+		myIdx:                          this.Len(),
+		parent:                         this,
+	})
+}
+
 // AppendIRI appends an IRI value to the back of a list of the property "audience"
 func (this *ActivityStreamsAudienceProperty) AppendIRI(v *url.URL) {
 	this.properties = append(this.properties, &ActivityStreamsAudiencePropertyIterator{
@@ -3351,6 +4366,29 @@ func (this *ActivityStreamsAudienceProperty) AppendIRI(v *url.URL) {
 	})
 }
 
+// AppendSchemaPropertyValue appends a PropertyValue value to the back of a list
+// of the property "audience". Invalidates iterators that are traversing using
+// Prev.
+func (this *ActivityStreamsAudienceProperty) AppendSchemaPropertyValue(v vocab.SchemaPropertyValue) {
+	this.properties = append(this.properties, &ActivityStreamsAudiencePropertyIterator{
+		alias:                     this.alias,
+		myIdx:                     this.Len(),
+		parent:                    this,
+		schemaPropertyValueMember: v,
+	})
+}
+
+// AppendTootEmoji appends a Emoji value to the back of a list of the property
+// "audience". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsAudienceProperty) AppendTootEmoji(v vocab.TootEmoji) {
+	this.properties = append(this.properties, &ActivityStreamsAudiencePropertyIterator{
+		alias:           this.alias,
+		myIdx:           this.Len(),
+		parent:          this,
+		tootEmojiMember: v,
+	})
+}
+
 // PrependType prepends an arbitrary type value to the front of a list of the
 // property "audience". Invalidates iterators that are traversing using Prev.
 // Returns an error if the type is not a valid one to set for this property.
@@ -3384,6 +4422,19 @@ func (this ActivityStreamsAudienceProperty) Begin() vocab.ActivityStreamsAudienc
 	}
 }
 
+// Clone returns a deep copy of this property and its values, which can be changed
+// without changing this one.
+func (this ActivityStreamsAudienceProperty) Clone() vocab.ActivityStreamsAudienceProperty {
+	clone := &ActivityStreamsAudienceProperty{
+		alias:      this.alias,
+		properties: make([]*ActivityStreamsAudiencePropertyIterator, 0, len(this.properties)),
+	}
+	for _, elem := range this.properties {
+		clone.properties = append(clone.properties, elem.clone(clone))
+	}
+	return clone
+}
+
 // Empty returns returns true if there are no elements.
 func (this ActivityStreamsAudienceProperty) Empty() bool {
 	return this.Len() == 0
@@ -3396,6 +4447,20 @@ func (this ActivityStreamsAudienceProperty) End() vocab.ActivityStreamsAudienceP
 	return nil
 }
 
+// Equals determines whether this property has the same values as another in the
+// same order, comparing any types they hold deeply.
+func (this ActivityStreamsAudienceProperty) Equals(o vocab.ActivityStreamsAudienceProperty) bool {
+	if this.Len() != o.Len() {
+		return false
+	}
+	for i, elem := range this.properties {
+		if !elem.Equals(o.At(i)) {
+			return false
+		}
+	}
+	return true
+}
+
 // InsertActivityStreamsAccept inserts a Accept value at the specified index for a
 // property "audience". Existing elements at that index and higher are shifted
 // back once. Invalidates all iterators.
@@ -3719,6 +4784,23 @@ func (this *ActivityStreamsAudienceProperty) InsertActivityStreamsGroup(idx int,
 	}
 }
 
+// InsertActivityStreamsHashtag inserts a Hashtag value at the specified index for
+// a property "audience". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) InsertActivityStreamsHashtag(idx int, v vocab.ActivityStreamsHashtag) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAudiencePropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // InsertActivityStreamsIgnore inserts a Ignore value at the specified index for a
 // property "audience". Existing elements at that index and higher are shifted
 // back once. Invalidates all iterators.
@@ -4304,27 +5386,163 @@ func (this *ActivityStreamsAudienceProperty) InsertActivityStreamsView(idx int,
 	this.properties = append(this.properties, nil)
 	copy(this.properties[idx+1:], this.properties[idx:])
 	this.properties[idx] = &ActivityStreamsAudiencePropertyIterator{
-		activitystreamsViewMember: v,
+		activitystreamsViewMember: v,
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedCommit inserts a Commit value at the specified index for a
+// property "audience". Existing elements at that index and higher are shifted
+// back once. Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) InsertForgeFedCommit(idx int, v vocab.ForgeFedCommit) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:                this.alias,
+		forgefedCommitMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedPatch inserts a Patch value at the specified index for a property
+// "audience". Existing elements at that index and higher are shifted back
+// once. Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) InsertForgeFedPatch(idx int, v vocab.ForgeFedPatch) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:               this.alias,
+		forgefedPatchMember: v,
+		myIdx:               idx,
+		parent:              this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedPush inserts a Push value at the specified index for a property
+// "audience". Existing elements at that index and higher are shifted back
+// once. Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) InsertForgeFedPush(idx int, v vocab.ForgeFedPush) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:              this.alias,
+		forgefedPushMember: v,
+		myIdx:              idx,
+		parent:             this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedRepository inserts a Repository value at the specified index for
+// a property "audience". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) InsertForgeFedRepository(idx int, v vocab.ForgeFedRepository) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:                    this.alias,
+		forgefedRepositoryMember: v,
+		myIdx:                    idx,
+		parent:                   this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedTicket inserts a Ticket value at the specified index for a
+// property "audience". Existing elements at that index and higher are shifted
+// back once. Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) InsertForgeFedTicket(idx int, v vocab.ForgeFedTicket) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:                this.alias,
+		forgefedTicketMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertForgeFedTicketDependency inserts a TicketDependency value at the
+// specified index for a property "audience". Existing elements at that index
+// and higher are shifted back once. Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) InsertForgeFedTicketDependency(idx int, v vocab.ForgeFedTicketDependency) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:                          this.alias,
+		forgefedTicketDependencyMember: v,
+		myIdx:                          idx,
+		parent:                         this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// Insert inserts an IRI value at the specified index for a property "audience".
+// Existing elements at that index and higher are shifted back once.
+// Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) InsertIRI(idx int, v *url.URL) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  idx,
+		parent: this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// InsertSchemaPropertyValue inserts a PropertyValue value at the specified index
+// for a property "audience". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) InsertSchemaPropertyValue(idx int, v vocab.SchemaPropertyValue) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAudiencePropertyIterator{
 		alias:                     this.alias,
 		myIdx:                     idx,
 		parent:                    this,
+		schemaPropertyValueMember: v,
 	}
 	for i := idx; i < this.Len(); i++ {
 		(this.properties)[i].myIdx = i
 	}
 }
 
-// Insert inserts an IRI value at the specified index for a property "audience".
-// Existing elements at that index and higher are shifted back once.
-// Invalidates all iterators.
-func (this *ActivityStreamsAudienceProperty) InsertIRI(idx int, v *url.URL) {
+// InsertTootEmoji inserts a Emoji value at the specified index for a property
+// "audience". Existing elements at that index and higher are shifted back
+// once. Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) InsertTootEmoji(idx int, v vocab.TootEmoji) {
 	this.properties = append(this.properties, nil)
 	copy(this.properties[idx+1:], this.properties[idx:])
 	this.properties[idx] = &ActivityStreamsAudiencePropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
+		alias:           this.alias,
+		myIdx:           idx,
+		parent:          this,
+		tootEmojiMember: v,
 	}
 	for i := idx; i < this.Len(); i++ {
 		(this.properties)[i].myIdx = i
@@ -4445,166 +5663,202 @@ func (this ActivityStreamsAudienceProperty) Less(i, j int) bool {
 			rhs := this.properties[j].GetActivityStreamsCollectionPage()
 			return lhs.LessThan(rhs)
 		} else if idx1 == 13 {
+			lhs := this.properties[i].GetForgeFedCommit()
+			rhs := this.properties[j].GetForgeFedCommit()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 14 {
 			lhs := this.properties[i].GetActivityStreamsCreate()
 			rhs := this.properties[j].GetActivityStreamsCreate()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 14 {
+		} else if idx1 == 15 {
 			lhs := this.properties[i].GetActivityStreamsDelete()
 			rhs := this.properties[j].GetActivityStreamsDelete()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 15 {
+		} else if idx1 == 16 {
 			lhs := this.properties[i].GetActivityStreamsDislike()
 			rhs := this.properties[j].GetActivityStreamsDislike()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 16 {
+		} else if idx1 == 17 {
 			lhs := this.properties[i].GetActivityStreamsDocument()
 			rhs := this.properties[j].GetActivityStreamsDocument()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 17 {
+		} else if idx1 == 18 {
+			lhs := this.properties[i].GetTootEmoji()
+			rhs := this.properties[j].GetTootEmoji()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 19 {
 			lhs := this.properties[i].GetActivityStreamsEvent()
 			rhs := this.properties[j].GetActivityStreamsEvent()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 18 {
+		} else if idx1 == 20 {
 			lhs := this.properties[i].GetActivityStreamsFlag()
 			rhs := this.properties[j].GetActivityStreamsFlag()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 19 {
+		} else if idx1 == 21 {
 			lhs := this.properties[i].GetActivityStreamsFollow()
 			rhs := this.properties[j].GetActivityStreamsFollow()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 20 {
+		} else if idx1 == 22 {
 			lhs := this.properties[i].GetActivityStreamsGroup()
 			rhs := this.properties[j].GetActivityStreamsGroup()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 21 {
+		} else if idx1 == 23 {
+			lhs := this.properties[i].GetActivityStreamsHashtag()
+			rhs := this.properties[j].GetActivityStreamsHashtag()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 24 {
 			lhs := this.properties[i].GetActivityStreamsIgnore()
 			rhs := this.properties[j].GetActivityStreamsIgnore()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 22 {
+		} else if idx1 == 25 {
 			lhs := this.properties[i].GetActivityStreamsImage()
 			rhs := this.properties[j].GetActivityStreamsImage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 23 {
+		} else if idx1 == 26 {
 			lhs := this.properties[i].GetActivityStreamsIntransitiveActivity()
 			rhs := this.properties[j].GetActivityStreamsIntransitiveActivity()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 24 {
+		} else if idx1 == 27 {
 			lhs := this.properties[i].GetActivityStreamsInvite()
 			rhs := this.properties[j].GetActivityStreamsInvite()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 25 {
+		} else if idx1 == 28 {
 			lhs := this.properties[i].GetActivityStreamsJoin()
 			rhs := this.properties[j].GetActivityStreamsJoin()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 26 {
+		} else if idx1 == 29 {
 			lhs := this.properties[i].GetActivityStreamsLeave()
 			rhs := this.properties[j].GetActivityStreamsLeave()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 27 {
+		} else if idx1 == 30 {
 			lhs := this.properties[i].GetActivityStreamsLike()
 			rhs := this.properties[j].GetActivityStreamsLike()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 28 {
+		} else if idx1 == 31 {
 			lhs := this.properties[i].GetActivityStreamsListen()
 			rhs := this.properties[j].GetActivityStreamsListen()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 29 {
+		} else if idx1 == 32 {
 			lhs := this.properties[i].GetActivityStreamsMention()
 			rhs := this.properties[j].GetActivityStreamsMention()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 30 {
+		} else if idx1 == 33 {
 			lhs := this.properties[i].GetActivityStreamsMove()
 			rhs := this.properties[j].GetActivityStreamsMove()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 31 {
+		} else if idx1 == 34 {
 			lhs := this.properties[i].GetActivityStreamsNote()
 			rhs := this.properties[j].GetActivityStreamsNote()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 32 {
+		} else if idx1 == 35 {
 			lhs := this.properties[i].GetActivityStreamsOffer()
 			rhs := this.properties[j].GetActivityStreamsOffer()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 33 {
+		} else if idx1 == 36 {
 			lhs := this.properties[i].GetActivityStreamsOrderedCollection()
 			rhs := this.properties[j].GetActivityStreamsOrderedCollection()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 34 {
+		} else if idx1 == 37 {
 			lhs := this.properties[i].GetActivityStreamsOrderedCollectionPage()
 			rhs := this.properties[j].GetActivityStreamsOrderedCollectionPage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 35 {
+		} else if idx1 == 38 {
 			lhs := this.properties[i].GetActivityStreamsOrganization()
 			rhs := this.properties[j].GetActivityStreamsOrganization()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 36 {
+		} else if idx1 == 39 {
 			lhs := this.properties[i].GetActivityStreamsPage()
 			rhs := this.properties[j].GetActivityStreamsPage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 37 {
+		} else if idx1 == 40 {
+			lhs := this.properties[i].GetForgeFedPatch()
+			rhs := this.properties[j].GetForgeFedPatch()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 41 {
 			lhs := this.properties[i].GetActivityStreamsPerson()
 			rhs := this.properties[j].GetActivityStreamsPerson()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 38 {
+		} else if idx1 == 42 {
 			lhs := this.properties[i].GetActivityStreamsPlace()
 			rhs := this.properties[j].GetActivityStreamsPlace()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 39 {
+		} else if idx1 == 43 {
 			lhs := this.properties[i].GetActivityStreamsProfile()
 			rhs := this.properties[j].GetActivityStreamsProfile()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 40 {
+		} else if idx1 == 44 {
+			lhs := this.properties[i].GetSchemaPropertyValue()
+			rhs := this.properties[j].GetSchemaPropertyValue()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 45 {
+			lhs := this.properties[i].GetForgeFedPush()
+			rhs := this.properties[j].GetForgeFedPush()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 46 {
 			lhs := this.properties[i].GetActivityStreamsQuestion()
 			rhs := this.properties[j].GetActivityStreamsQuestion()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 41 {
+		} else if idx1 == 47 {
 			lhs := this.properties[i].GetActivityStreamsRead()
 			rhs := this.properties[j].GetActivityStreamsRead()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 42 {
+		} else if idx1 == 48 {
 			lhs := this.properties[i].GetActivityStreamsReject()
 			rhs := this.properties[j].GetActivityStreamsReject()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 43 {
+		} else if idx1 == 49 {
 			lhs := this.properties[i].GetActivityStreamsRelationship()
 			rhs := this.properties[j].GetActivityStreamsRelationship()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 44 {
+		} else if idx1 == 50 {
 			lhs := this.properties[i].GetActivityStreamsRemove()
 			rhs := this.properties[j].GetActivityStreamsRemove()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 45 {
+		} else if idx1 == 51 {
+			lhs := this.properties[i].GetForgeFedRepository()
+			rhs := this.properties[j].GetForgeFedRepository()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 52 {
 			lhs := this.properties[i].GetActivityStreamsService()
 			rhs := this.properties[j].GetActivityStreamsService()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 46 {
+		} else if idx1 == 53 {
 			lhs := this.properties[i].GetActivityStreamsTentativeAccept()
 			rhs := this.properties[j].GetActivityStreamsTentativeAccept()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 47 {
+		} else if idx1 == 54 {
 			lhs := this.properties[i].GetActivityStreamsTentativeReject()
 			rhs := this.properties[j].GetActivityStreamsTentativeReject()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 48 {
+		} else if idx1 == 55 {
+			lhs := this.properties[i].GetForgeFedTicket()
+			rhs := this.properties[j].GetForgeFedTicket()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 56 {
+			lhs := this.properties[i].GetForgeFedTicketDependency()
+			rhs := this.properties[j].GetForgeFedTicketDependency()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 57 {
 			lhs := this.properties[i].GetActivityStreamsTombstone()
 			rhs := this.properties[j].GetActivityStreamsTombstone()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 49 {
+		} else if idx1 == 58 {
 			lhs := this.properties[i].GetActivityStreamsTravel()
 			rhs := this.properties[j].GetActivityStreamsTravel()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 50 {
+		} else if idx1 == 59 {
 			lhs := this.properties[i].GetActivityStreamsUndo()
 			rhs := this.properties[j].GetActivityStreamsUndo()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 51 {
+		} else if idx1 == 60 {
 			lhs := this.properties[i].GetActivityStreamsUpdate()
 			rhs := this.properties[j].GetActivityStreamsUpdate()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 52 {
+		} else if idx1 == 61 {
 			lhs := this.properties[i].GetActivityStreamsVideo()
 			rhs := this.properties[j].GetActivityStreamsVideo()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 53 {
+		} else if idx1 == 62 {
 			lhs := this.properties[i].GetActivityStreamsView()
 			rhs := this.properties[j].GetActivityStreamsView()
 			return lhs.LessThan(rhs)
@@ -4913,6 +6167,20 @@ func (this *ActivityStreamsAudienceProperty) PrependActivityStreamsGroup(v vocab
 	}
 }
 
+// PrependActivityStreamsHashtag prepends a Hashtag value to the front of a list
+// of the property "audience". Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) PrependActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.properties = append([]*ActivityStreamsAudiencePropertyIterator{{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+		myIdx:                        0,
+		parent:                       this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // PrependActivityStreamsIgnore prepends a Ignore value to the front of a list of
 // the property "audience". Invalidates all iterators.
 func (this *ActivityStreamsAudienceProperty) PrependActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
@@ -5405,6 +6673,90 @@ func (this *ActivityStreamsAudienceProperty) PrependActivityStreamsView(v vocab.
 	}
 }
 
+// PrependForgeFedCommit prepends a Commit value to the front of a list of the
+// property "audience". Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) PrependForgeFedCommit(v vocab.ForgeFedCommit) {
+	this.properties = append([]*ActivityStreamsAudiencePropertyIterator{{
+		alias:                this.alias,
+		forgefedCommitMember: v,
+		myIdx:                0,
+		parent:               this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedPatch prepends a Patch value to the front of a list of the
+// property "audience". Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) PrependForgeFedPatch(v vocab.ForgeFedPatch) {
+	this.properties = append([]*ActivityStreamsAudiencePropertyIterator{{
+		alias:               this.alias,
+		forgefedPatchMember: v,
+		myIdx:               0,
+		parent:              this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedPush prepends a Push value to the front of a list of the
+// property "audience". Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) PrependForgeFedPush(v vocab.ForgeFedPush) {
+	this.properties = append([]*ActivityStreamsAudiencePropertyIterator{{
+		alias:              this.alias,
+		forgefedPushMember: v,
+		myIdx:              0,
+		parent:             this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedRepository prepends a Repository value to the front of a list of
+// the property "audience". Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) PrependForgeFedRepository(v vocab.ForgeFedRepository) {
+	this.properties = append([]*ActivityStreamsAudiencePropertyIterator{{
+		alias:                    this.alias,
+		forgefedRepositoryMember: v,
+		myIdx:                    0,
+		parent:                   this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedTicket prepends a Ticket value to the front of a list of the
+// property "audience". Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) PrependForgeFedTicket(v vocab.ForgeFedTicket) {
+	this.properties = append([]*ActivityStreamsAudiencePropertyIterator{{
+		alias:                this.alias,
+		forgefedTicketMember: v,
+		myIdx:                0,
+		parent:               this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependForgeFedTicketDependency prepends a TicketDependency value to the front
+// of a list of the property "audience". Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) PrependForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
+	this.properties = append([]*ActivityStreamsAudiencePropertyIterator{{
+		alias:                          this.alias,
+		forgefedTicketDependencyMember: v,
+		myIdx:                          0,
+		parent:                         this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // PrependIRI prepends an IRI value to the front of a list of the property
 // "audience".
 func (this *ActivityStreamsAudienceProperty) PrependIRI(v *url.URL) {
@@ -5419,6 +6771,34 @@ func (this *ActivityStreamsAudienceProperty) PrependIRI(v *url.URL) {
 	}
 }
 
+// PrependSchemaPropertyValue prepends a PropertyValue value to the front of a
+// list of the property "audience". Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) PrependSchemaPropertyValue(v vocab.SchemaPropertyValue) {
+	this.properties = append([]*ActivityStreamsAudiencePropertyIterator{{
+		alias:                     this.alias,
+		myIdx:                     0,
+		parent:                    this,
+		schemaPropertyValueMember: v,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependTootEmoji prepends a Emoji value to the front of a list of the property
+// "audience". Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) PrependTootEmoji(v vocab.TootEmoji) {
+	this.properties = append([]*ActivityStreamsAudiencePropertyIterator{{
+		alias:           this.alias,
+		myIdx:           0,
+		parent:          this,
+		tootEmojiMember: v,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // PrependType prepends an arbitrary type value to the front of a list of the
 // property "audience". Invalidates all iterators. Returns an error if the
 // type is not a valid one to set for this property.
@@ -5718,6 +7098,19 @@ func (this *ActivityStreamsAudienceProperty) SetActivityStreamsGroup(idx int, v
 	}
 }
 
+// SetActivityStreamsHashtag sets a Hashtag value to be at the specified index for
+// the property "audience". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ActivityStreamsAudienceProperty) SetActivityStreamsHashtag(idx int, v vocab.ActivityStreamsHashtag) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAudiencePropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+}
+
 // SetActivityStreamsIgnore sets a Ignore value to be at the specified index for
 // the property "audience". Panics if the index is out of bounds. Invalidates
 // all iterators.
@@ -6173,6 +7566,83 @@ func (this *ActivityStreamsAudienceProperty) SetActivityStreamsView(idx int, v v
 	}
 }
 
+// SetForgeFedCommit sets a Commit value to be at the specified index for the
+// property "audience". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ActivityStreamsAudienceProperty) SetForgeFedCommit(idx int, v vocab.ForgeFedCommit) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:                this.alias,
+		forgefedCommitMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+}
+
+// SetForgeFedPatch sets a Patch value to be at the specified index for the
+// property "audience". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ActivityStreamsAudienceProperty) SetForgeFedPatch(idx int, v vocab.ForgeFedPatch) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:               this.alias,
+		forgefedPatchMember: v,
+		myIdx:               idx,
+		parent:              this,
+	}
+}
+
+// SetForgeFedPush sets a Push value to be at the specified index for the property
+// "audience". Panics if the index is out of bounds. Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) SetForgeFedPush(idx int, v vocab.ForgeFedPush) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:              this.alias,
+		forgefedPushMember: v,
+		myIdx:              idx,
+		parent:             this,
+	}
+}
+
+// SetForgeFedRepository sets a Repository value to be at the specified index for
+// the property "audience". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ActivityStreamsAudienceProperty) SetForgeFedRepository(idx int, v vocab.ForgeFedRepository) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:                    this.alias,
+		forgefedRepositoryMember: v,
+		myIdx:                    idx,
+		parent:                   this,
+	}
+}
+
+// SetForgeFedTicket sets a Ticket value to be at the specified index for the
+// property "audience". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ActivityStreamsAudienceProperty) SetForgeFedTicket(idx int, v vocab.ForgeFedTicket) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:                this.alias,
+		forgefedTicketMember: v,
+		myIdx:                idx,
+		parent:               this,
+	}
+}
+
+// SetForgeFedTicketDependency sets a TicketDependency value to be at the
+// specified index for the property "audience". Panics if the index is out of
+// bounds. Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) SetForgeFedTicketDependency(idx int, v vocab.ForgeFedTicketDependency) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:                          this.alias,
+		forgefedTicketDependencyMember: v,
+		myIdx:                          idx,
+		parent:                         this,
+	}
+}
+
 // SetIRI sets an IRI value to be at the specified index for the property
 // "audience". Panics if the index is out of bounds.
 func (this *ActivityStreamsAudienceProperty) SetIRI(idx int, v *url.URL) {
@@ -6185,6 +7655,31 @@ func (this *ActivityStreamsAudienceProperty) SetIRI(idx int, v *url.URL) {
 	}
 }
 
+// SetSchemaPropertyValue sets a PropertyValue value to be at the specified index
+// for the property "audience". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) SetSchemaPropertyValue(idx int, v vocab.SchemaPropertyValue) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:                     this.alias,
+		myIdx:                     idx,
+		parent:                    this,
+		schemaPropertyValueMember: v,
+	}
+}
+
+// SetTootEmoji sets a Emoji value to be at the specified index for the property
+// "audience". Panics if the index is out of bounds. Invalidates all iterators.
+func (this *ActivityStreamsAudienceProperty) SetTootEmoji(idx int, v vocab.TootEmoji) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAudiencePropertyIterator{
+		alias:           this.alias,
+		myIdx:           idx,
+		parent:          this,
+		tootEmojiMember: v,
+	}
+}
+
 // SetType sets an arbitrary type value to the specified index of the property
 // "audience". Invalidates all iterators. Returns an error if the type is not
 // a valid one to set for this property. Panics if the index is out of bounds.
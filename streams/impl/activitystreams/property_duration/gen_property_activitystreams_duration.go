@@ -7,6 +7,7 @@ import (
 	duration "github.com/go-fed/activity/streams/values/duration"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"reflect"
 	"time"
 )
 
@@ -69,6 +70,64 @@ func NewActivityStreamsDurationProperty() *ActivityStreamsDurationProperty {
 	return &ActivityStreamsDurationProperty{alias: ""}
 }
 
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
 // Clear ensures no value of this property is set. Calling IsXMLSchemaDuration
 // afterwards will return false.
 func (this *ActivityStreamsDurationProperty) Clear() {
@@ -77,6 +136,36 @@ func (this *ActivityStreamsDurationProperty) Clear() {
 	this.hasDurationMember = false
 }
 
+// Clone returns a deep copy of this property, which can be changed without
+// changing this one.
+func (this ActivityStreamsDurationProperty) Clone() vocab.ActivityStreamsDurationProperty {
+	clone := this
+	if this.iri != nil {
+		iri := *this.iri
+		clone.iri = &iri
+	}
+	clone.unknown = cloneUnknown(this.unknown)
+	return &clone
+}
+
+// Equals determines whether this property has the same value as another,
+// comparing any types it holds deeply.
+func (this ActivityStreamsDurationProperty) Equals(o vocab.ActivityStreamsDurationProperty) bool {
+	if this.IsXMLSchemaDuration() && o.IsXMLSchemaDuration() {
+		return !duration.LessDuration(this.Get(), o.Get()) && !duration.LessDuration(o.Get(), this.Get())
+	} else if this.IsXMLSchemaDuration() || o.IsXMLSchemaDuration() {
+		return false
+	} else if this.IsIRI() && o.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	} else if this.IsIRI() || o.IsIRI() {
+		return false
+	}
+	// Both are unknown values or neither is set.
+	lhs, _ := this.Serialize()
+	rhs, _ := o.Serialize()
+	return equalsUnknown(lhs, rhs)
+}
+
 // Get returns the value of this property. When IsXMLSchemaDuration returns false,
 // Get will return any arbitrary value.
 func (this ActivityStreamsDurationProperty) Get() time.Duration {
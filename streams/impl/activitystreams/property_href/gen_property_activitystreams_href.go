@@ -7,6 +7,7 @@ import (
 	anyuri "github.com/go-fed/activity/streams/values/anyURI"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"reflect"
 )
 
 // ActivityStreamsHrefProperty is the functional property "href". It is permitted
@@ -53,6 +54,64 @@ func NewActivityStreamsHrefProperty() *ActivityStreamsHrefProperty {
 	return &ActivityStreamsHrefProperty{alias: ""}
 }
 
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
 // Clear ensures no value of this property is set. Calling IsXMLSchemaAnyURI
 // afterwards will return false.
 func (this *ActivityStreamsHrefProperty) Clear() {
@@ -60,6 +119,32 @@ func (this *ActivityStreamsHrefProperty) Clear() {
 	this.xmlschemaAnyURIMember = nil
 }
 
+// Clone returns a deep copy of this property, which can be changed without
+// changing this one.
+func (this ActivityStreamsHrefProperty) Clone() vocab.ActivityStreamsHrefProperty {
+	clone := this
+	if this.xmlschemaAnyURIMember != nil {
+		u := *this.xmlschemaAnyURIMember
+		clone.xmlschemaAnyURIMember = &u
+	}
+	clone.unknown = cloneUnknown(this.unknown)
+	return &clone
+}
+
+// Equals determines whether this property has the same value as another,
+// comparing any types it holds deeply.
+func (this ActivityStreamsHrefProperty) Equals(o vocab.ActivityStreamsHrefProperty) bool {
+	if this.IsXMLSchemaAnyURI() && o.IsXMLSchemaAnyURI() {
+		return !anyuri.LessAnyURI(this.Get(), o.Get()) && !anyuri.LessAnyURI(o.Get(), this.Get())
+	} else if this.IsXMLSchemaAnyURI() || o.IsXMLSchemaAnyURI() {
+		return false
+	}
+	// Both are unknown values or neither is set.
+	lhs, _ := this.Serialize()
+	rhs, _ := o.Serialize()
+	return equalsUnknown(lhs, rhs)
+}
+
 // Get returns the value of this property. When IsXMLSchemaAnyURI returns false,
 // Get will return any arbitrary value.
 func (this ActivityStreamsHrefProperty) Get() *url.URL {
@@ -7,6 +7,7 @@ import (
 	bcp47 "github.com/go-fed/activity/streams/values/bcp47"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"reflect"
 )
 
 // ActivityStreamsHreflangProperty is the functional property "hreflang". It is
@@ -68,6 +69,64 @@ func NewActivityStreamsHreflangProperty() *ActivityStreamsHreflangProperty {
 	return &ActivityStreamsHreflangProperty{alias: ""}
 }
 
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
 // Clear ensures no value of this property is set. Calling IsRFCBcp47 afterwards
 // will return false.
 func (this *ActivityStreamsHreflangProperty) Clear() {
@@ -76,6 +135,36 @@ func (this *ActivityStreamsHreflangProperty) Clear() {
 	this.hasBcp47Member = false
 }
 
+// Clone returns a deep copy of this property, which can be changed without
+// changing this one.
+func (this ActivityStreamsHreflangProperty) Clone() vocab.ActivityStreamsHreflangProperty {
+	clone := this
+	if this.iri != nil {
+		iri := *this.iri
+		clone.iri = &iri
+	}
+	clone.unknown = cloneUnknown(this.unknown)
+	return &clone
+}
+
+// Equals determines whether this property has the same value as another,
+// comparing any types it holds deeply.
+func (this ActivityStreamsHreflangProperty) Equals(o vocab.ActivityStreamsHreflangProperty) bool {
+	if this.IsRFCBcp47() && o.IsRFCBcp47() {
+		return !bcp47.LessBcp47(this.Get(), o.Get()) && !bcp47.LessBcp47(o.Get(), this.Get())
+	} else if this.IsRFCBcp47() || o.IsRFCBcp47() {
+		return false
+	} else if this.IsIRI() && o.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	} else if this.IsIRI() || o.IsIRI() {
+		return false
+	}
+	// Both are unknown values or neither is set.
+	lhs, _ := this.Serialize()
+	rhs, _ := o.Serialize()
+	return equalsUnknown(lhs, rhs)
+}
+
 // Get returns the value of this property. When IsRFCBcp47 returns false, Get will
 // return any arbitrary value.
 func (this ActivityStreamsHreflangProperty) Get() string {
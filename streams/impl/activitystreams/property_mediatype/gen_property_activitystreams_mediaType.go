@@ -7,6 +7,7 @@ import (
 	rfc2045 "github.com/go-fed/activity/streams/values/rfc2045"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"reflect"
 )
 
 // ActivityStreamsMediaTypeProperty is the functional property "mediaType". It is
@@ -68,6 +69,64 @@ func NewActivityStreamsMediaTypeProperty() *ActivityStreamsMediaTypeProperty {
 	return &ActivityStreamsMediaTypeProperty{alias: ""}
 }
 
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
 // Clear ensures no value of this property is set. Calling IsRFCRfc2045 afterwards
 // will return false.
 func (this *ActivityStreamsMediaTypeProperty) Clear() {
@@ -76,6 +135,36 @@ func (this *ActivityStreamsMediaTypeProperty) Clear() {
 	this.hasRfc2045Member = false
 }
 
+// Clone returns a deep copy of this property, which can be changed without
+// changing this one.
+func (this ActivityStreamsMediaTypeProperty) Clone() vocab.ActivityStreamsMediaTypeProperty {
+	clone := this
+	if this.iri != nil {
+		iri := *this.iri
+		clone.iri = &iri
+	}
+	clone.unknown = cloneUnknown(this.unknown)
+	return &clone
+}
+
+// Equals determines whether this property has the same value as another,
+// comparing any types it holds deeply.
+func (this ActivityStreamsMediaTypeProperty) Equals(o vocab.ActivityStreamsMediaTypeProperty) bool {
+	if this.IsRFCRfc2045() && o.IsRFCRfc2045() {
+		return !rfc2045.LessRfc2045(this.Get(), o.Get()) && !rfc2045.LessRfc2045(o.Get(), this.Get())
+	} else if this.IsRFCRfc2045() || o.IsRFCRfc2045() {
+		return false
+	} else if this.IsIRI() && o.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	} else if this.IsIRI() || o.IsIRI() {
+		return false
+	}
+	// Both are unknown values or neither is set.
+	lhs, _ := this.Serialize()
+	rhs, _ := o.Serialize()
+	return equalsUnknown(lhs, rhs)
+}
+
 // Get returns the value of this property. When IsRFCRfc2045 returns false, Get
 // will return any arbitrary value.
 func (this ActivityStreamsMediaTypeProperty) Get() string {
@@ -0,0 +1,266 @@
+// Code generated by astool. DO NOT EDIT.
+
+package propertymovedto
+
+import (
+	"fmt"
+	anyuri "github.com/go-fed/activity/streams/values/anyURI"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"reflect"
+)
+
+// ActivityStreamsMovedToProperty is the functional property "movedTo". It is
+// permitted to be a single nilable value type.
+type ActivityStreamsMovedToProperty struct {
+	xmlschemaAnyURIMember *url.URL
+	unknown               interface{}
+	alias                 string
+}
+
+// DeserializeMovedToProperty creates a "movedTo" property from an interface
+// representation that has been unmarshalled from a text or binary format.
+func DeserializeMovedToProperty(m map[string]interface{}, aliasMap map[string]string) (*ActivityStreamsMovedToProperty, error) {
+	alias := ""
+	if a, ok := aliasMap["https://www.w3.org/ns/activitystreams"]; ok {
+		alias = a
+	}
+	propName := "movedTo"
+	if len(alias) > 0 {
+		// Use alias both to find the property, and set within the property.
+		propName = fmt.Sprintf("%s:%s", alias, "movedTo")
+	}
+	i, ok := m[propName]
+
+	if ok {
+		if v, err := anyuri.DeserializeAnyURI(i); err == nil {
+			this := &ActivityStreamsMovedToProperty{
+				alias:                 alias,
+				xmlschemaAnyURIMember: v,
+			}
+			return this, nil
+		}
+		this := &ActivityStreamsMovedToProperty{
+			alias:   alias,
+			unknown: i,
+		}
+		return this, nil
+	}
+	return nil, nil
+}
+
+// NewActivityStreamsMovedToProperty creates a new movedTo property.
+func NewActivityStreamsMovedToProperty() *ActivityStreamsMovedToProperty {
+	return &ActivityStreamsMovedToProperty{alias: ""}
+}
+
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
+// Clear ensures no value of this property is set. Calling IsXMLSchemaAnyURI
+// afterwards will return false.
+func (this *ActivityStreamsMovedToProperty) Clear() {
+	this.unknown = nil
+	this.xmlschemaAnyURIMember = nil
+}
+
+// Clone returns a deep copy of this property, which can be changed without
+// changing this one.
+func (this ActivityStreamsMovedToProperty) Clone() vocab.ActivityStreamsMovedToProperty {
+	clone := this
+	if this.xmlschemaAnyURIMember != nil {
+		u := *this.xmlschemaAnyURIMember
+		clone.xmlschemaAnyURIMember = &u
+	}
+	clone.unknown = cloneUnknown(this.unknown)
+	return &clone
+}
+
+// Equals determines whether this property has the same value as another,
+// comparing any types it holds deeply.
+func (this ActivityStreamsMovedToProperty) Equals(o vocab.ActivityStreamsMovedToProperty) bool {
+	if this.IsXMLSchemaAnyURI() && o.IsXMLSchemaAnyURI() {
+		return !anyuri.LessAnyURI(this.Get(), o.Get()) && !anyuri.LessAnyURI(o.Get(), this.Get())
+	} else if this.IsXMLSchemaAnyURI() || o.IsXMLSchemaAnyURI() {
+		return false
+	}
+	// Both are unknown values or neither is set.
+	lhs, _ := this.Serialize()
+	rhs, _ := o.Serialize()
+	return equalsUnknown(lhs, rhs)
+}
+
+// Get returns the value of this property. When IsXMLSchemaAnyURI returns false,
+// Get will return any arbitrary value.
+func (this ActivityStreamsMovedToProperty) Get() *url.URL {
+	return this.xmlschemaAnyURIMember
+}
+
+// GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
+// return any arbitrary value.
+func (this ActivityStreamsMovedToProperty) GetIRI() *url.URL {
+	return this.xmlschemaAnyURIMember
+}
+
+// HasAny returns true if the value or IRI is set.
+func (this ActivityStreamsMovedToProperty) HasAny() bool {
+	return this.IsXMLSchemaAnyURI()
+}
+
+// IsIRI returns true if this property is an IRI.
+func (this ActivityStreamsMovedToProperty) IsIRI() bool {
+	return this.xmlschemaAnyURIMember != nil
+}
+
+// IsXMLSchemaAnyURI returns true if this property is set and not an IRI.
+func (this ActivityStreamsMovedToProperty) IsXMLSchemaAnyURI() bool {
+	return this.xmlschemaAnyURIMember != nil
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this ActivityStreamsMovedToProperty) JSONLDContext() map[string]string {
+	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
+	var child map[string]string
+
+	/*
+	   Since the literal maps in this function are determined at
+	   code-generation time, this loop should not overwrite an existing key with a
+	   new value.
+	*/
+	for k, v := range child {
+		m[k] = v
+	}
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API detail only for folks looking to replace the go-fed
+// implementation. Applications should not use this method.
+func (this ActivityStreamsMovedToProperty) KindIndex() int {
+	if this.IsXMLSchemaAnyURI() {
+		return 0
+	}
+	if this.IsIRI() {
+		return -2
+	}
+	return -1
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this ActivityStreamsMovedToProperty) LessThan(o vocab.ActivityStreamsMovedToProperty) bool {
+	if this.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return true
+	} else if o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return false
+	}
+	// LessThan comparison for the single value or unknown value.
+	if !this.IsXMLSchemaAnyURI() && !o.IsXMLSchemaAnyURI() {
+		// Both are unknowns.
+		return false
+	} else if this.IsXMLSchemaAnyURI() && !o.IsXMLSchemaAnyURI() {
+		// Values are always greater than unknown values.
+		return false
+	} else if !this.IsXMLSchemaAnyURI() && o.IsXMLSchemaAnyURI() {
+		// Unknowns are always less than known values.
+		return true
+	} else {
+		// Actual comparison.
+		return anyuri.LessAnyURI(this.Get(), o.Get())
+	}
+}
+
+// Name returns the name of this property: "movedTo".
+func (this ActivityStreamsMovedToProperty) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":" + "movedTo"
+	} else {
+		return "movedTo"
+	}
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this ActivityStreamsMovedToProperty) Serialize() (interface{}, error) {
+	if this.IsXMLSchemaAnyURI() {
+		return anyuri.SerializeAnyURI(this.Get())
+	}
+	return this.unknown, nil
+}
+
+// Set sets the value of this property. Calling IsXMLSchemaAnyURI afterwards will
+// return true.
+func (this *ActivityStreamsMovedToProperty) Set(v *url.URL) {
+	this.Clear()
+	this.xmlschemaAnyURIMember = v
+}
+
+// SetIRI sets the value of this property. Calling IsIRI afterwards will return
+// true.
+func (this *ActivityStreamsMovedToProperty) SetIRI(v *url.URL) {
+	this.Clear()
+	this.Set(v)
+}
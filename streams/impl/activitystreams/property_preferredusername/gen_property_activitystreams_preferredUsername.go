@@ -8,6 +8,7 @@ import (
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"reflect"
 )
 
 // ActivityStreamsPreferredUsernameProperty is the functional property
@@ -85,6 +86,64 @@ func NewActivityStreamsPreferredUsernameProperty() *ActivityStreamsPreferredUser
 	return &ActivityStreamsPreferredUsernameProperty{alias: ""}
 }
 
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
 // Clear ensures no value and no language map for this property is set. Calling
 // HasAny or any of the 'Is' methods afterwards will return false.
 func (this *ActivityStreamsPreferredUsernameProperty) Clear() {
@@ -95,6 +154,46 @@ func (this *ActivityStreamsPreferredUsernameProperty) Clear() {
 	this.rdfLangStringMember = nil
 }
 
+// Clone returns a deep copy of this property, which can be changed without
+// changing this one.
+func (this ActivityStreamsPreferredUsernameProperty) Clone() vocab.ActivityStreamsPreferredUsernameProperty {
+	clone := this
+	if this.rdfLangStringMember != nil {
+		clone.rdfLangStringMember = make(map[string]string, len(this.rdfLangStringMember))
+		for k, v := range this.rdfLangStringMember {
+			clone.rdfLangStringMember[k] = v
+		}
+	}
+	if this.iri != nil {
+		iri := *this.iri
+		clone.iri = &iri
+	}
+	clone.unknown = cloneUnknown(this.unknown)
+	return &clone
+}
+
+// Equals determines whether this property has the same value as another,
+// comparing any types it holds deeply.
+func (this ActivityStreamsPreferredUsernameProperty) Equals(o vocab.ActivityStreamsPreferredUsernameProperty) bool {
+	if this.IsXMLSchemaString() && o.IsXMLSchemaString() {
+		return !string1.LessString(this.GetXMLSchemaString(), o.GetXMLSchemaString()) && !string1.LessString(o.GetXMLSchemaString(), this.GetXMLSchemaString())
+	} else if this.IsXMLSchemaString() || o.IsXMLSchemaString() {
+		return false
+	} else if this.IsRDFLangString() && o.IsRDFLangString() {
+		return !langstring.LessLangString(this.GetRDFLangString(), o.GetRDFLangString()) && !langstring.LessLangString(o.GetRDFLangString(), this.GetRDFLangString())
+	} else if this.IsRDFLangString() || o.IsRDFLangString() {
+		return false
+	} else if this.IsIRI() && o.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	} else if this.IsIRI() || o.IsIRI() {
+		return false
+	}
+	// Both are unknown values or neither is set.
+	lhs, _ := this.Serialize()
+	rhs, _ := o.Serialize()
+	return equalsUnknown(lhs, rhs)
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
 func (this ActivityStreamsPreferredUsernameProperty) GetIRI() *url.URL {
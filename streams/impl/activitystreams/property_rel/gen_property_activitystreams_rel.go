@@ -7,6 +7,7 @@ import (
 	rfc5988 "github.com/go-fed/activity/streams/values/rfc5988"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"reflect"
 )
 
 // ActivityStreamsRelPropertyIterator is an iterator for a property. It is
@@ -26,6 +27,26 @@ func NewActivityStreamsRelPropertyIterator() *ActivityStreamsRelPropertyIterator
 	return &ActivityStreamsRelPropertyIterator{alias: ""}
 }
 
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
 // deserializeActivityStreamsRelPropertyIterator creates an iterator from an
 // element that has been unmarshalled from a text or binary format.
 func deserializeActivityStreamsRelPropertyIterator(i interface{}, aliasMap map[string]string) (*ActivityStreamsRelPropertyIterator, error) {
@@ -60,6 +81,63 @@ func deserializeActivityStreamsRelPropertyIterator(i interface{}, aliasMap map[s
 	return this, nil
 }
 
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
+// Equals determines whether this property has the same value as another,
+// comparing any types it holds deeply.
+func (this ActivityStreamsRelPropertyIterator) Equals(o vocab.ActivityStreamsRelPropertyIterator) bool {
+	if this.IsRFCRfc5988() && o.IsRFCRfc5988() {
+		return !rfc5988.LessRfc5988(this.Get(), o.Get()) && !rfc5988.LessRfc5988(o.Get(), this.Get())
+	} else if this.IsRFCRfc5988() || o.IsRFCRfc5988() {
+		return false
+	} else if this.IsIRI() && o.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	} else if this.IsIRI() || o.IsIRI() {
+		return false
+	}
+	// Both are unknown values or neither is set.
+	if other, ok := o.(*ActivityStreamsRelPropertyIterator); ok {
+		return equalsUnknown(this.unknown, other.unknown)
+	}
+	return this.unknown == nil
+}
+
 // Get returns the value of this property. When IsRFCRfc5988 returns false, Get
 // will return any arbitrary value.
 func (this ActivityStreamsRelPropertyIterator) Get() string {
@@ -199,6 +277,18 @@ func (this *ActivityStreamsRelPropertyIterator) clear() {
 	this.hasRfc5988Member = false
 }
 
+// clone returns a deep copy of this iterator belonging to the parent property.
+func (this ActivityStreamsRelPropertyIterator) clone(parent vocab.ActivityStreamsRelProperty) *ActivityStreamsRelPropertyIterator {
+	clone := this
+	if this.iri != nil {
+		iri := *this.iri
+		clone.iri = &iri
+	}
+	clone.unknown = cloneUnknown(this.unknown)
+	clone.parent = parent
+	return &clone
+}
+
 // serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -306,6 +396,19 @@ func (this ActivityStreamsRelProperty) Begin() vocab.ActivityStreamsRelPropertyI
 	}
 }
 
+// Clone returns a deep copy of this property and its values, which can be changed
+// without changing this one.
+func (this ActivityStreamsRelProperty) Clone() vocab.ActivityStreamsRelProperty {
+	clone := &ActivityStreamsRelProperty{
+		alias:      this.alias,
+		properties: make([]*ActivityStreamsRelPropertyIterator, 0, len(this.properties)),
+	}
+	for _, elem := range this.properties {
+		clone.properties = append(clone.properties, elem.clone(clone))
+	}
+	return clone
+}
+
 // Empty returns returns true if there are no elements.
 func (this ActivityStreamsRelProperty) Empty() bool {
 	return this.Len() == 0
@@ -318,6 +421,20 @@ func (this ActivityStreamsRelProperty) End() vocab.ActivityStreamsRelPropertyIte
 	return nil
 }
 
+// Equals determines whether this property has the same values as another in the
+// same order, comparing any types they hold deeply.
+func (this ActivityStreamsRelProperty) Equals(o vocab.ActivityStreamsRelProperty) bool {
+	if this.Len() != o.Len() {
+		return false
+	}
+	for i, elem := range this.properties {
+		if !elem.Equals(o.At(i)) {
+			return false
+		}
+	}
+	return true
+}
+
 // Insert inserts an IRI value at the specified index for a property "rel".
 // Existing elements at that index and higher are shifted back once.
 // Invalidates all iterators.
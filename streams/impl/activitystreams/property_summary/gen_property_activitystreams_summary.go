@@ -8,6 +8,7 @@ import (
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"reflect"
 )
 
 // ActivityStreamsSummaryPropertyIterator is an iterator for a property. It is
@@ -32,6 +33,26 @@ func NewActivityStreamsSummaryPropertyIterator() *ActivityStreamsSummaryProperty
 	return &ActivityStreamsSummaryPropertyIterator{alias: ""}
 }
 
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
 // deserializeActivityStreamsSummaryPropertyIterator creates an iterator from an
 // element that has been unmarshalled from a text or binary format.
 func deserializeActivityStreamsSummaryPropertyIterator(i interface{}, aliasMap map[string]string) (*ActivityStreamsSummaryPropertyIterator, error) {
@@ -72,6 +93,67 @@ func deserializeActivityStreamsSummaryPropertyIterator(i interface{}, aliasMap m
 	return this, nil
 }
 
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
+// Equals determines whether this property has the same value as another,
+// comparing any types it holds deeply.
+func (this ActivityStreamsSummaryPropertyIterator) Equals(o vocab.ActivityStreamsSummaryPropertyIterator) bool {
+	if this.IsXMLSchemaString() && o.IsXMLSchemaString() {
+		return !string1.LessString(this.GetXMLSchemaString(), o.GetXMLSchemaString()) && !string1.LessString(o.GetXMLSchemaString(), this.GetXMLSchemaString())
+	} else if this.IsXMLSchemaString() || o.IsXMLSchemaString() {
+		return false
+	} else if this.IsRDFLangString() && o.IsRDFLangString() {
+		return !langstring.LessLangString(this.GetRDFLangString(), o.GetRDFLangString()) && !langstring.LessLangString(o.GetRDFLangString(), this.GetRDFLangString())
+	} else if this.IsRDFLangString() || o.IsRDFLangString() {
+		return false
+	} else if this.IsIRI() && o.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	} else if this.IsIRI() || o.IsIRI() {
+		return false
+	}
+	// Both are unknown values or neither is set.
+	if other, ok := o.(*ActivityStreamsSummaryPropertyIterator); ok {
+		return equalsUnknown(this.unknown, other.unknown)
+	}
+	return this.unknown == nil
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
 func (this ActivityStreamsSummaryPropertyIterator) GetIRI() *url.URL {
@@ -273,6 +355,24 @@ func (this *ActivityStreamsSummaryPropertyIterator) clear() {
 	this.rdfLangStringMember = nil
 }
 
+// clone returns a deep copy of this iterator belonging to the parent property.
+func (this ActivityStreamsSummaryPropertyIterator) clone(parent vocab.ActivityStreamsSummaryProperty) *ActivityStreamsSummaryPropertyIterator {
+	clone := this
+	if this.rdfLangStringMember != nil {
+		clone.rdfLangStringMember = make(map[string]string, len(this.rdfLangStringMember))
+		for k, v := range this.rdfLangStringMember {
+			clone.rdfLangStringMember[k] = v
+		}
+	}
+	if this.iri != nil {
+		iri := *this.iri
+		clone.iri = &iri
+	}
+	clone.unknown = cloneUnknown(this.unknown)
+	clone.parent = parent
+	return &clone
+}
+
 // serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -396,6 +496,19 @@ func (this ActivityStreamsSummaryProperty) Begin() vocab.ActivityStreamsSummaryP
 	}
 }
 
+// Clone returns a deep copy of this property and its values, which can be changed
+// without changing this one.
+func (this ActivityStreamsSummaryProperty) Clone() vocab.ActivityStreamsSummaryProperty {
+	clone := &ActivityStreamsSummaryProperty{
+		alias:      this.alias,
+		properties: make([]*ActivityStreamsSummaryPropertyIterator, 0, len(this.properties)),
+	}
+	for _, elem := range this.properties {
+		clone.properties = append(clone.properties, elem.clone(clone))
+	}
+	return clone
+}
+
 // Empty returns returns true if there are no elements.
 func (this ActivityStreamsSummaryProperty) Empty() bool {
 	return this.Len() == 0
@@ -408,6 +521,20 @@ func (this ActivityStreamsSummaryProperty) End() vocab.ActivityStreamsSummaryPro
 	return nil
 }
 
+// Equals determines whether this property has the same values as another in the
+// same order, comparing any types they hold deeply.
+func (this ActivityStreamsSummaryProperty) Equals(o vocab.ActivityStreamsSummaryProperty) bool {
+	if this.Len() != o.Len() {
+		return false
+	}
+	for i, elem := range this.properties {
+		if !elem.Equals(o.At(i)) {
+			return false
+		}
+	}
+	return true
+}
+
 // Insert inserts an IRI value at the specified index for a property "summary".
 // Existing elements at that index and higher are shifted back once.
 // Invalidates all iterators.
@@ -5,6 +5,7 @@ package typeaccept
 import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
+	"reflect"
 	"strings"
 )
 
@@ -76,12 +77,15 @@ type ActivityStreamsAccept struct {
 	ActivityStreamsPublished    vocab.ActivityStreamsPublishedProperty
 	ActivityStreamsReplies      vocab.ActivityStreamsRepliesProperty
 	ActivityStreamsResult       vocab.ActivityStreamsResultProperty
+	ActivityStreamsSensitive    vocab.ActivityStreamsSensitiveProperty
 	ActivityStreamsShares       vocab.ActivityStreamsSharesProperty
 	ActivityStreamsStartTime    vocab.ActivityStreamsStartTimeProperty
 	ActivityStreamsSummary      vocab.ActivityStreamsSummaryProperty
 	ActivityStreamsTag          vocab.ActivityStreamsTagProperty
 	ActivityStreamsTarget       vocab.ActivityStreamsTargetProperty
+	ForgeFedTicketsTrackedBy    vocab.ForgeFedTicketsTrackedByProperty
 	ActivityStreamsTo           vocab.ActivityStreamsToProperty
+	ForgeFedTracksTicketsFor    vocab.ForgeFedTracksTicketsForProperty
 	JSONLDType                  vocab.JSONLDTypeProperty
 	ActivityStreamsUpdated      vocab.ActivityStreamsUpdatedProperty
 	ActivityStreamsUrl          vocab.ActivityStreamsUrlProperty
@@ -92,7 +96,7 @@ type ActivityStreamsAccept struct {
 // AcceptIsDisjointWith returns true if the other provided type is disjoint with
 // the Accept type.
 func AcceptIsDisjointWith(other vocab.Type) bool {
-	disjointWith := []string{"Link", "Mention"}
+	disjointWith := []string{"Hashtag", "Link", "Mention"}
 	for _, disjoint := range disjointWith {
 		if disjoint == other.GetTypeName() {
 			return true
@@ -303,6 +307,11 @@ func DeserializeAccept(m map[string]interface{}, aliasMap map[string]string) (*A
 	} else if p != nil {
 		this.ActivityStreamsResult = p
 	}
+	if p, err := mgr.DeserializeSensitivePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsSensitive = p
+	}
 	if p, err := mgr.DeserializeSharesPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -328,11 +337,21 @@ func DeserializeAccept(m map[string]interface{}, aliasMap map[string]string) (*A
 	} else if p != nil {
 		this.ActivityStreamsTarget = p
 	}
+	if p, err := mgr.DeserializeTicketsTrackedByPropertyForgeFed()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ForgeFedTicketsTrackedBy = p
+	}
 	if p, err := mgr.DeserializeToPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
 		this.ActivityStreamsTo = p
 	}
+	if p, err := mgr.DeserializeTracksTicketsForPropertyForgeFed()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ForgeFedTracksTicketsFor = p
+	}
 	if p, err := mgr.DeserializeTypePropertyJSONLD()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -413,6 +432,8 @@ func DeserializeAccept(m map[string]interface{}, aliasMap map[string]string) (*A
 			continue
 		} else if k == "result" {
 			continue
+		} else if k == "sensitive" {
+			continue
 		} else if k == "shares" {
 			continue
 		} else if k == "startTime" {
@@ -425,8 +446,12 @@ func DeserializeAccept(m map[string]interface{}, aliasMap map[string]string) (*A
 			continue
 		} else if k == "target" {
 			continue
+		} else if k == "ticketsTrackedBy" {
+			continue
 		} else if k == "to" {
 			continue
+		} else if k == "tracksTicketsFor" {
+			continue
 		} else if k == "type" {
 			continue
 		} else if k == "updated" {
@@ -462,6 +487,478 @@ func NewActivityStreamsAccept() *ActivityStreamsAccept {
 	}
 }
 
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
+// Clone returns a deep copy of this Accept and its properties, which can be
+// changed without changing this one.
+func (this ActivityStreamsAccept) Clone() vocab.ActivityStreamsAccept {
+	clone := this
+	if this.ActivityStreamsActor != nil {
+		clone.ActivityStreamsActor = this.ActivityStreamsActor.Clone()
+	}
+	if this.ActivityStreamsAltitude != nil {
+		clone.ActivityStreamsAltitude = this.ActivityStreamsAltitude.Clone()
+	}
+	if this.ActivityStreamsAttachment != nil {
+		clone.ActivityStreamsAttachment = this.ActivityStreamsAttachment.Clone()
+	}
+	if this.ActivityStreamsAttributedTo != nil {
+		clone.ActivityStreamsAttributedTo = this.ActivityStreamsAttributedTo.Clone()
+	}
+	if this.ActivityStreamsAudience != nil {
+		clone.ActivityStreamsAudience = this.ActivityStreamsAudience.Clone()
+	}
+	if this.ActivityStreamsBcc != nil {
+		clone.ActivityStreamsBcc = this.ActivityStreamsBcc.Clone()
+	}
+	if this.ActivityStreamsBto != nil {
+		clone.ActivityStreamsBto = this.ActivityStreamsBto.Clone()
+	}
+	if this.ActivityStreamsCc != nil {
+		clone.ActivityStreamsCc = this.ActivityStreamsCc.Clone()
+	}
+	if this.ActivityStreamsContent != nil {
+		clone.ActivityStreamsContent = this.ActivityStreamsContent.Clone()
+	}
+	if this.ActivityStreamsContext != nil {
+		clone.ActivityStreamsContext = this.ActivityStreamsContext.Clone()
+	}
+	if this.ActivityStreamsDuration != nil {
+		clone.ActivityStreamsDuration = this.ActivityStreamsDuration.Clone()
+	}
+	if this.ActivityStreamsEndTime != nil {
+		clone.ActivityStreamsEndTime = this.ActivityStreamsEndTime.Clone()
+	}
+	if this.ActivityStreamsGenerator != nil {
+		clone.ActivityStreamsGenerator = this.ActivityStreamsGenerator.Clone()
+	}
+	if this.ActivityStreamsIcon != nil {
+		clone.ActivityStreamsIcon = this.ActivityStreamsIcon.Clone()
+	}
+	if this.JSONLDId != nil {
+		clone.JSONLDId = this.JSONLDId.Clone()
+	}
+	if this.ActivityStreamsImage != nil {
+		clone.ActivityStreamsImage = this.ActivityStreamsImage.Clone()
+	}
+	if this.ActivityStreamsInReplyTo != nil {
+		clone.ActivityStreamsInReplyTo = this.ActivityStreamsInReplyTo.Clone()
+	}
+	if this.ActivityStreamsInstrument != nil {
+		clone.ActivityStreamsInstrument = this.ActivityStreamsInstrument.Clone()
+	}
+	if this.ActivityStreamsLikes != nil {
+		clone.ActivityStreamsLikes = this.ActivityStreamsLikes.Clone()
+	}
+	if this.ActivityStreamsLocation != nil {
+		clone.ActivityStreamsLocation = this.ActivityStreamsLocation.Clone()
+	}
+	if this.ActivityStreamsMediaType != nil {
+		clone.ActivityStreamsMediaType = this.ActivityStreamsMediaType.Clone()
+	}
+	if this.ActivityStreamsName != nil {
+		clone.ActivityStreamsName = this.ActivityStreamsName.Clone()
+	}
+	if this.ActivityStreamsObject != nil {
+		clone.ActivityStreamsObject = this.ActivityStreamsObject.Clone()
+	}
+	if this.ActivityStreamsOrigin != nil {
+		clone.ActivityStreamsOrigin = this.ActivityStreamsOrigin.Clone()
+	}
+	if this.ActivityStreamsPreview != nil {
+		clone.ActivityStreamsPreview = this.ActivityStreamsPreview.Clone()
+	}
+	if this.ActivityStreamsPublished != nil {
+		clone.ActivityStreamsPublished = this.ActivityStreamsPublished.Clone()
+	}
+	if this.ActivityStreamsReplies != nil {
+		clone.ActivityStreamsReplies = this.ActivityStreamsReplies.Clone()
+	}
+	if this.ActivityStreamsResult != nil {
+		clone.ActivityStreamsResult = this.ActivityStreamsResult.Clone()
+	}
+	if this.ActivityStreamsSensitive != nil {
+		clone.ActivityStreamsSensitive = this.ActivityStreamsSensitive.Clone()
+	}
+	if this.ActivityStreamsShares != nil {
+		clone.ActivityStreamsShares = this.ActivityStreamsShares.Clone()
+	}
+	if this.ActivityStreamsStartTime != nil {
+		clone.ActivityStreamsStartTime = this.ActivityStreamsStartTime.Clone()
+	}
+	if this.ActivityStreamsSummary != nil {
+		clone.ActivityStreamsSummary = this.ActivityStreamsSummary.Clone()
+	}
+	if this.ActivityStreamsTag != nil {
+		clone.ActivityStreamsTag = this.ActivityStreamsTag.Clone()
+	}
+	if this.ActivityStreamsTarget != nil {
+		clone.ActivityStreamsTarget = this.ActivityStreamsTarget.Clone()
+	}
+	if this.ForgeFedTicketsTrackedBy != nil {
+		clone.ForgeFedTicketsTrackedBy = this.ForgeFedTicketsTrackedBy.Clone()
+	}
+	if this.ActivityStreamsTo != nil {
+		clone.ActivityStreamsTo = this.ActivityStreamsTo.Clone()
+	}
+	if this.ForgeFedTracksTicketsFor != nil {
+		clone.ForgeFedTracksTicketsFor = this.ForgeFedTracksTicketsFor.Clone()
+	}
+	if this.JSONLDType != nil {
+		clone.JSONLDType = this.JSONLDType.Clone()
+	}
+	if this.ActivityStreamsUpdated != nil {
+		clone.ActivityStreamsUpdated = this.ActivityStreamsUpdated.Clone()
+	}
+	if this.ActivityStreamsUrl != nil {
+		clone.ActivityStreamsUrl = this.ActivityStreamsUrl.Clone()
+	}
+	clone.unknown = cloneUnknown(this.unknown).(map[string]interface{})
+	return &clone
+}
+
+// Equals determines whether this Accept has the same properties as another,
+// comparing them deeply. The @context of deserialized values is ignored.
+func (this ActivityStreamsAccept) Equals(o vocab.ActivityStreamsAccept) bool {
+	if lhs, rhs := this.ActivityStreamsActor, o.GetActivityStreamsActor(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsAltitude, o.GetActivityStreamsAltitude(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsAttachment, o.GetActivityStreamsAttachment(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsAttributedTo, o.GetActivityStreamsAttributedTo(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsAudience, o.GetActivityStreamsAudience(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsBcc, o.GetActivityStreamsBcc(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsBto, o.GetActivityStreamsBto(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsCc, o.GetActivityStreamsCc(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsContent, o.GetActivityStreamsContent(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsContext, o.GetActivityStreamsContext(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsDuration, o.GetActivityStreamsDuration(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsEndTime, o.GetActivityStreamsEndTime(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsGenerator, o.GetActivityStreamsGenerator(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsIcon, o.GetActivityStreamsIcon(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsImage, o.GetActivityStreamsImage(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsInReplyTo, o.GetActivityStreamsInReplyTo(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsInstrument, o.GetActivityStreamsInstrument(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsLikes, o.GetActivityStreamsLikes(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsLocation, o.GetActivityStreamsLocation(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsMediaType, o.GetActivityStreamsMediaType(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsName, o.GetActivityStreamsName(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsObject, o.GetActivityStreamsObject(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsOrigin, o.GetActivityStreamsOrigin(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsPreview, o.GetActivityStreamsPreview(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsPublished, o.GetActivityStreamsPublished(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsReplies, o.GetActivityStreamsReplies(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsResult, o.GetActivityStreamsResult(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsSensitive, o.GetActivityStreamsSensitive(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsShares, o.GetActivityStreamsShares(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsStartTime, o.GetActivityStreamsStartTime(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsSummary, o.GetActivityStreamsSummary(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsTag, o.GetActivityStreamsTag(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsTarget, o.GetActivityStreamsTarget(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ForgeFedTicketsTrackedBy, o.GetForgeFedTicketsTrackedBy(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsTo, o.GetActivityStreamsTo(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ForgeFedTracksTicketsFor, o.GetForgeFedTracksTicketsFor(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsUpdated, o.GetActivityStreamsUpdated(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsUrl, o.GetActivityStreamsUrl(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	return equalsUnknown(this.unknown, o.GetUnknownProperties())
+}
+
 // GetActivityStreamsActor returns the "actor" property if it exists, and nil
 // otherwise.
 func (this ActivityStreamsAccept) GetActivityStreamsActor() vocab.ActivityStreamsActorProperty {
@@ -623,6 +1120,12 @@ func (this ActivityStreamsAccept) GetActivityStreamsResult() vocab.ActivityStrea
 	return this.ActivityStreamsResult
 }
 
+// GetActivityStreamsSensitive returns the "sensitive" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsAccept) GetActivityStreamsSensitive() vocab.ActivityStreamsSensitiveProperty {
+	return this.ActivityStreamsSensitive
+}
+
 // GetActivityStreamsShares returns the "shares" property if it exists, and nil
 // otherwise.
 func (this ActivityStreamsAccept) GetActivityStreamsShares() vocab.ActivityStreamsSharesProperty {
@@ -670,6 +1173,18 @@ func (this ActivityStreamsAccept) GetActivityStreamsUrl() vocab.ActivityStreamsU
 	return this.ActivityStreamsUrl
 }
 
+// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsAccept) GetForgeFedTicketsTrackedBy() vocab.ForgeFedTicketsTrackedByProperty {
+	return this.ForgeFedTicketsTrackedBy
+}
+
+// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsAccept) GetForgeFedTracksTicketsFor() vocab.ForgeFedTracksTicketsForProperty {
+	return this.ForgeFedTracksTicketsFor
+}
+
 // GetJSONLDId returns the "id" property if it exists, and nil otherwise.
 func (this ActivityStreamsAccept) GetJSONLDId() vocab.JSONLDIdProperty {
 	return this.JSONLDId
@@ -733,12 +1248,15 @@ func (this ActivityStreamsAccept) JSONLDContext() map[string]string {
 	m = this.helperJSONLDContext(this.ActivityStreamsPublished, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsReplies, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsResult, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsSensitive, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsShares, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsStartTime, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsSummary, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTag, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTarget, m)
+	m = this.helperJSONLDContext(this.ForgeFedTicketsTrackedBy, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTo, m)
+	m = this.helperJSONLDContext(this.ForgeFedTracksTicketsFor, m)
 	m = this.helperJSONLDContext(this.JSONLDType, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsUpdated, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsUrl, m)
@@ -1142,6 +1660,20 @@ func (this ActivityStreamsAccept) LessThan(o vocab.ActivityStreamsAccept) bool {
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "sensitive"
+	if lhs, rhs := this.ActivityStreamsSensitive, o.GetActivityStreamsSensitive(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "shares"
 	if lhs, rhs := this.ActivityStreamsShares, o.GetActivityStreamsShares(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1212,6 +1744,20 @@ func (this ActivityStreamsAccept) LessThan(o vocab.ActivityStreamsAccept) bool {
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "ticketsTrackedBy"
+	if lhs, rhs := this.ForgeFedTicketsTrackedBy, o.GetForgeFedTicketsTrackedBy(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "to"
 	if lhs, rhs := this.ActivityStreamsTo, o.GetActivityStreamsTo(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1226,6 +1772,20 @@ func (this ActivityStreamsAccept) LessThan(o vocab.ActivityStreamsAccept) bool {
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "tracksTicketsFor"
+	if lhs, rhs := this.ForgeFedTracksTicketsFor, o.GetForgeFedTracksTicketsFor(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "type"
 	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1515,6 +2075,14 @@ func (this ActivityStreamsAccept) Serialize() (map[string]interface{}, error) {
 			m[this.ActivityStreamsResult.Name()] = i
 		}
 	}
+	// Maybe serialize property "sensitive"
+	if this.ActivityStreamsSensitive != nil {
+		if i, err := this.ActivityStreamsSensitive.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsSensitive.Name()] = i
+		}
+	}
 	// Maybe serialize property "shares"
 	if this.ActivityStreamsShares != nil {
 		if i, err := this.ActivityStreamsShares.Serialize(); err != nil {
@@ -1555,6 +2123,14 @@ func (this ActivityStreamsAccept) Serialize() (map[string]interface{}, error) {
 			m[this.ActivityStreamsTarget.Name()] = i
 		}
 	}
+	// Maybe serialize property "ticketsTrackedBy"
+	if this.ForgeFedTicketsTrackedBy != nil {
+		if i, err := this.ForgeFedTicketsTrackedBy.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ForgeFedTicketsTrackedBy.Name()] = i
+		}
+	}
 	// Maybe serialize property "to"
 	if this.ActivityStreamsTo != nil {
 		if i, err := this.ActivityStreamsTo.Serialize(); err != nil {
@@ -1563,6 +2139,14 @@ func (this ActivityStreamsAccept) Serialize() (map[string]interface{}, error) {
 			m[this.ActivityStreamsTo.Name()] = i
 		}
 	}
+	// Maybe serialize property "tracksTicketsFor"
+	if this.ForgeFedTracksTicketsFor != nil {
+		if i, err := this.ForgeFedTracksTicketsFor.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ForgeFedTracksTicketsFor.Name()] = i
+		}
+	}
 	// Maybe serialize property "type"
 	if this.JSONLDType != nil {
 		if i, err := this.JSONLDType.Serialize(); err != nil {
@@ -1736,6 +2320,11 @@ func (this *ActivityStreamsAccept) SetActivityStreamsResult(i vocab.ActivityStre
 	this.ActivityStreamsResult = i
 }
 
+// SetActivityStreamsSensitive sets the "sensitive" property.
+func (this *ActivityStreamsAccept) SetActivityStreamsSensitive(i vocab.ActivityStreamsSensitiveProperty) {
+	this.ActivityStreamsSensitive = i
+}
+
 // SetActivityStreamsShares sets the "shares" property.
 func (this *ActivityStreamsAccept) SetActivityStreamsShares(i vocab.ActivityStreamsSharesProperty) {
 	this.ActivityStreamsShares = i
@@ -1776,6 +2365,16 @@ func (this *ActivityStreamsAccept) SetActivityStreamsUrl(i vocab.ActivityStreams
 	this.ActivityStreamsUrl = i
 }
 
+// SetForgeFedTicketsTrackedBy sets the "ticketsTrackedBy" property.
+func (this *ActivityStreamsAccept) SetForgeFedTicketsTrackedBy(i vocab.ForgeFedTicketsTrackedByProperty) {
+	this.ForgeFedTicketsTrackedBy = i
+}
+
+// SetForgeFedTracksTicketsFor sets the "tracksTicketsFor" property.
+func (this *ActivityStreamsAccept) SetForgeFedTracksTicketsFor(i vocab.ForgeFedTracksTicketsForProperty) {
+	this.ForgeFedTracksTicketsFor = i
+}
+
 // SetJSONLDId sets the "id" property.
 func (this *ActivityStreamsAccept) SetJSONLDId(i vocab.JSONLDIdProperty) {
 	this.JSONLDId = i
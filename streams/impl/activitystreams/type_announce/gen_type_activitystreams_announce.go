@@ -5,6 +5,7 @@ package typeannounce
 import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
+	"reflect"
 	"strings"
 )
 
@@ -58,12 +59,15 @@ type ActivityStreamsAnnounce struct {
 	ActivityStreamsPublished    vocab.ActivityStreamsPublishedProperty
 	ActivityStreamsReplies      vocab.ActivityStreamsRepliesProperty
 	ActivityStreamsResult       vocab.ActivityStreamsResultProperty
+	ActivityStreamsSensitive    vocab.ActivityStreamsSensitiveProperty
 	ActivityStreamsShares       vocab.ActivityStreamsSharesProperty
 	ActivityStreamsStartTime    vocab.ActivityStreamsStartTimeProperty
 	ActivityStreamsSummary      vocab.ActivityStreamsSummaryProperty
 	ActivityStreamsTag          vocab.ActivityStreamsTagProperty
 	ActivityStreamsTarget       vocab.ActivityStreamsTargetProperty
+	ForgeFedTicketsTrackedBy    vocab.ForgeFedTicketsTrackedByProperty
 	ActivityStreamsTo           vocab.ActivityStreamsToProperty
+	ForgeFedTracksTicketsFor    vocab.ForgeFedTracksTicketsForProperty
 	JSONLDType                  vocab.JSONLDTypeProperty
 	ActivityStreamsUpdated      vocab.ActivityStreamsUpdatedProperty
 	ActivityStreamsUrl          vocab.ActivityStreamsUrlProperty
@@ -86,7 +90,7 @@ func ActivityStreamsAnnounceExtends(other vocab.Type) bool {
 // AnnounceIsDisjointWith returns true if the other provided type is disjoint with
 // the Announce type.
 func AnnounceIsDisjointWith(other vocab.Type) bool {
-	disjointWith := []string{"Link", "Mention"}
+	disjointWith := []string{"Hashtag", "Link", "Mention"}
 	for _, disjoint := range disjointWith {
 		if disjoint == other.GetTypeName() {
 			return true
@@ -280,6 +284,11 @@ func DeserializeAnnounce(m map[string]interface{}, aliasMap map[string]string) (
 	} else if p != nil {
 		this.ActivityStreamsResult = p
 	}
+	if p, err := mgr.DeserializeSensitivePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsSensitive = p
+	}
 	if p, err := mgr.DeserializeSharesPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -305,11 +314,21 @@ func DeserializeAnnounce(m map[string]interface{}, aliasMap map[string]string) (
 	} else if p != nil {
 		this.ActivityStreamsTarget = p
 	}
+	if p, err := mgr.DeserializeTicketsTrackedByPropertyForgeFed()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ForgeFedTicketsTrackedBy = p
+	}
 	if p, err := mgr.DeserializeToPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
 		this.ActivityStreamsTo = p
 	}
+	if p, err := mgr.DeserializeTracksTicketsForPropertyForgeFed()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ForgeFedTracksTicketsFor = p
+	}
 	if p, err := mgr.DeserializeTypePropertyJSONLD()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -390,6 +409,8 @@ func DeserializeAnnounce(m map[string]interface{}, aliasMap map[string]string) (
 			continue
 		} else if k == "result" {
 			continue
+		} else if k == "sensitive" {
+			continue
 		} else if k == "shares" {
 			continue
 		} else if k == "startTime" {
@@ -402,8 +423,12 @@ func DeserializeAnnounce(m map[string]interface{}, aliasMap map[string]string) (
 			continue
 		} else if k == "target" {
 			continue
+		} else if k == "ticketsTrackedBy" {
+			continue
 		} else if k == "to" {
 			continue
+		} else if k == "tracksTicketsFor" {
+			continue
 		} else if k == "type" {
 			continue
 		} else if k == "updated" {
@@ -439,6 +464,478 @@ func NewActivityStreamsAnnounce() *ActivityStreamsAnnounce {
 	}
 }
 
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
+// Clone returns a deep copy of this Announce and its properties, which can be
+// changed without changing this one.
+func (this ActivityStreamsAnnounce) Clone() vocab.ActivityStreamsAnnounce {
+	clone := this
+	if this.ActivityStreamsActor != nil {
+		clone.ActivityStreamsActor = this.ActivityStreamsActor.Clone()
+	}
+	if this.ActivityStreamsAltitude != nil {
+		clone.ActivityStreamsAltitude = this.ActivityStreamsAltitude.Clone()
+	}
+	if this.ActivityStreamsAttachment != nil {
+		clone.ActivityStreamsAttachment = this.ActivityStreamsAttachment.Clone()
+	}
+	if this.ActivityStreamsAttributedTo != nil {
+		clone.ActivityStreamsAttributedTo = this.ActivityStreamsAttributedTo.Clone()
+	}
+	if this.ActivityStreamsAudience != nil {
+		clone.ActivityStreamsAudience = this.ActivityStreamsAudience.Clone()
+	}
+	if this.ActivityStreamsBcc != nil {
+		clone.ActivityStreamsBcc = this.ActivityStreamsBcc.Clone()
+	}
+	if this.ActivityStreamsBto != nil {
+		clone.ActivityStreamsBto = this.ActivityStreamsBto.Clone()
+	}
+	if this.ActivityStreamsCc != nil {
+		clone.ActivityStreamsCc = this.ActivityStreamsCc.Clone()
+	}
+	if this.ActivityStreamsContent != nil {
+		clone.ActivityStreamsContent = this.ActivityStreamsContent.Clone()
+	}
+	if this.ActivityStreamsContext != nil {
+		clone.ActivityStreamsContext = this.ActivityStreamsContext.Clone()
+	}
+	if this.ActivityStreamsDuration != nil {
+		clone.ActivityStreamsDuration = this.ActivityStreamsDuration.Clone()
+	}
+	if this.ActivityStreamsEndTime != nil {
+		clone.ActivityStreamsEndTime = this.ActivityStreamsEndTime.Clone()
+	}
+	if this.ActivityStreamsGenerator != nil {
+		clone.ActivityStreamsGenerator = this.ActivityStreamsGenerator.Clone()
+	}
+	if this.ActivityStreamsIcon != nil {
+		clone.ActivityStreamsIcon = this.ActivityStreamsIcon.Clone()
+	}
+	if this.JSONLDId != nil {
+		clone.JSONLDId = this.JSONLDId.Clone()
+	}
+	if this.ActivityStreamsImage != nil {
+		clone.ActivityStreamsImage = this.ActivityStreamsImage.Clone()
+	}
+	if this.ActivityStreamsInReplyTo != nil {
+		clone.ActivityStreamsInReplyTo = this.ActivityStreamsInReplyTo.Clone()
+	}
+	if this.ActivityStreamsInstrument != nil {
+		clone.ActivityStreamsInstrument = this.ActivityStreamsInstrument.Clone()
+	}
+	if this.ActivityStreamsLikes != nil {
+		clone.ActivityStreamsLikes = this.ActivityStreamsLikes.Clone()
+	}
+	if this.ActivityStreamsLocation != nil {
+		clone.ActivityStreamsLocation = this.ActivityStreamsLocation.Clone()
+	}
+	if this.ActivityStreamsMediaType != nil {
+		clone.ActivityStreamsMediaType = this.ActivityStreamsMediaType.Clone()
+	}
+	if this.ActivityStreamsName != nil {
+		clone.ActivityStreamsName = this.ActivityStreamsName.Clone()
+	}
+	if this.ActivityStreamsObject != nil {
+		clone.ActivityStreamsObject = this.ActivityStreamsObject.Clone()
+	}
+	if this.ActivityStreamsOrigin != nil {
+		clone.ActivityStreamsOrigin = this.ActivityStreamsOrigin.Clone()
+	}
+	if this.ActivityStreamsPreview != nil {
+		clone.ActivityStreamsPreview = this.ActivityStreamsPreview.Clone()
+	}
+	if this.ActivityStreamsPublished != nil {
+		clone.ActivityStreamsPublished = this.ActivityStreamsPublished.Clone()
+	}
+	if this.ActivityStreamsReplies != nil {
+		clone.ActivityStreamsReplies = this.ActivityStreamsReplies.Clone()
+	}
+	if this.ActivityStreamsResult != nil {
+		clone.ActivityStreamsResult = this.ActivityStreamsResult.Clone()
+	}
+	if this.ActivityStreamsSensitive != nil {
+		clone.ActivityStreamsSensitive = this.ActivityStreamsSensitive.Clone()
+	}
+	if this.ActivityStreamsShares != nil {
+		clone.ActivityStreamsShares = this.ActivityStreamsShares.Clone()
+	}
+	if this.ActivityStreamsStartTime != nil {
+		clone.ActivityStreamsStartTime = this.ActivityStreamsStartTime.Clone()
+	}
+	if this.ActivityStreamsSummary != nil {
+		clone.ActivityStreamsSummary = this.ActivityStreamsSummary.Clone()
+	}
+	if this.ActivityStreamsTag != nil {
+		clone.ActivityStreamsTag = this.ActivityStreamsTag.Clone()
+	}
+	if this.ActivityStreamsTarget != nil {
+		clone.ActivityStreamsTarget = this.ActivityStreamsTarget.Clone()
+	}
+	if this.ForgeFedTicketsTrackedBy != nil {
+		clone.ForgeFedTicketsTrackedBy = this.ForgeFedTicketsTrackedBy.Clone()
+	}
+	if this.ActivityStreamsTo != nil {
+		clone.ActivityStreamsTo = this.ActivityStreamsTo.Clone()
+	}
+	if this.ForgeFedTracksTicketsFor != nil {
+		clone.ForgeFedTracksTicketsFor = this.ForgeFedTracksTicketsFor.Clone()
+	}
+	if this.JSONLDType != nil {
+		clone.JSONLDType = this.JSONLDType.Clone()
+	}
+	if this.ActivityStreamsUpdated != nil {
+		clone.ActivityStreamsUpdated = this.ActivityStreamsUpdated.Clone()
+	}
+	if this.ActivityStreamsUrl != nil {
+		clone.ActivityStreamsUrl = this.ActivityStreamsUrl.Clone()
+	}
+	clone.unknown = cloneUnknown(this.unknown).(map[string]interface{})
+	return &clone
+}
+
+// Equals determines whether this Announce has the same properties as another,
+// comparing them deeply. The @context of deserialized values is ignored.
+func (this ActivityStreamsAnnounce) Equals(o vocab.ActivityStreamsAnnounce) bool {
+	if lhs, rhs := this.ActivityStreamsActor, o.GetActivityStreamsActor(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsAltitude, o.GetActivityStreamsAltitude(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsAttachment, o.GetActivityStreamsAttachment(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsAttributedTo, o.GetActivityStreamsAttributedTo(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsAudience, o.GetActivityStreamsAudience(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsBcc, o.GetActivityStreamsBcc(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsBto, o.GetActivityStreamsBto(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsCc, o.GetActivityStreamsCc(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsContent, o.GetActivityStreamsContent(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsContext, o.GetActivityStreamsContext(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsDuration, o.GetActivityStreamsDuration(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsEndTime, o.GetActivityStreamsEndTime(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsGenerator, o.GetActivityStreamsGenerator(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsIcon, o.GetActivityStreamsIcon(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsImage, o.GetActivityStreamsImage(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsInReplyTo, o.GetActivityStreamsInReplyTo(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsInstrument, o.GetActivityStreamsInstrument(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsLikes, o.GetActivityStreamsLikes(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsLocation, o.GetActivityStreamsLocation(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsMediaType, o.GetActivityStreamsMediaType(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsName, o.GetActivityStreamsName(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsObject, o.GetActivityStreamsObject(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsOrigin, o.GetActivityStreamsOrigin(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsPreview, o.GetActivityStreamsPreview(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsPublished, o.GetActivityStreamsPublished(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsReplies, o.GetActivityStreamsReplies(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsResult, o.GetActivityStreamsResult(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsSensitive, o.GetActivityStreamsSensitive(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsShares, o.GetActivityStreamsShares(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsStartTime, o.GetActivityStreamsStartTime(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsSummary, o.GetActivityStreamsSummary(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsTag, o.GetActivityStreamsTag(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsTarget, o.GetActivityStreamsTarget(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ForgeFedTicketsTrackedBy, o.GetForgeFedTicketsTrackedBy(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsTo, o.GetActivityStreamsTo(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ForgeFedTracksTicketsFor, o.GetForgeFedTracksTicketsFor(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsUpdated, o.GetActivityStreamsUpdated(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsUrl, o.GetActivityStreamsUrl(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	return equalsUnknown(this.unknown, o.GetUnknownProperties())
+}
+
 // GetActivityStreamsActor returns the "actor" property if it exists, and nil
 // otherwise.
 func (this ActivityStreamsAnnounce) GetActivityStreamsActor() vocab.ActivityStreamsActorProperty {
@@ -600,6 +1097,12 @@ func (this ActivityStreamsAnnounce) GetActivityStreamsResult() vocab.ActivityStr
 	return this.ActivityStreamsResult
 }
 
+// GetActivityStreamsSensitive returns the "sensitive" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsAnnounce) GetActivityStreamsSensitive() vocab.ActivityStreamsSensitiveProperty {
+	return this.ActivityStreamsSensitive
+}
+
 // GetActivityStreamsShares returns the "shares" property if it exists, and nil
 // otherwise.
 func (this ActivityStreamsAnnounce) GetActivityStreamsShares() vocab.ActivityStreamsSharesProperty {
@@ -647,6 +1150,18 @@ func (this ActivityStreamsAnnounce) GetActivityStreamsUrl() vocab.ActivityStream
 	return this.ActivityStreamsUrl
 }
 
+// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsAnnounce) GetForgeFedTicketsTrackedBy() vocab.ForgeFedTicketsTrackedByProperty {
+	return this.ForgeFedTicketsTrackedBy
+}
+
+// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsAnnounce) GetForgeFedTracksTicketsFor() vocab.ForgeFedTracksTicketsForProperty {
+	return this.ForgeFedTracksTicketsFor
+}
+
 // GetJSONLDId returns the "id" property if it exists, and nil otherwise.
 func (this ActivityStreamsAnnounce) GetJSONLDId() vocab.JSONLDIdProperty {
 	return this.JSONLDId
@@ -710,12 +1225,15 @@ func (this ActivityStreamsAnnounce) JSONLDContext() map[string]string {
 	m = this.helperJSONLDContext(this.ActivityStreamsPublished, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsReplies, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsResult, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsSensitive, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsShares, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsStartTime, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsSummary, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTag, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTarget, m)
+	m = this.helperJSONLDContext(this.ForgeFedTicketsTrackedBy, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTo, m)
+	m = this.helperJSONLDContext(this.ForgeFedTracksTicketsFor, m)
 	m = this.helperJSONLDContext(this.JSONLDType, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsUpdated, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsUrl, m)
@@ -1119,6 +1637,20 @@ func (this ActivityStreamsAnnounce) LessThan(o vocab.ActivityStreamsAnnounce) bo
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "sensitive"
+	if lhs, rhs := this.ActivityStreamsSensitive, o.GetActivityStreamsSensitive(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "shares"
 	if lhs, rhs := this.ActivityStreamsShares, o.GetActivityStreamsShares(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1189,6 +1721,20 @@ func (this ActivityStreamsAnnounce) LessThan(o vocab.ActivityStreamsAnnounce) bo
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "ticketsTrackedBy"
+	if lhs, rhs := this.ForgeFedTicketsTrackedBy, o.GetForgeFedTicketsTrackedBy(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "to"
 	if lhs, rhs := this.ActivityStreamsTo, o.GetActivityStreamsTo(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1203,6 +1749,20 @@ func (this ActivityStreamsAnnounce) LessThan(o vocab.ActivityStreamsAnnounce) bo
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "tracksTicketsFor"
+	if lhs, rhs := this.ForgeFedTracksTicketsFor, o.GetForgeFedTracksTicketsFor(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "type"
 	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1492,6 +2052,14 @@ func (this ActivityStreamsAnnounce) Serialize() (map[string]interface{}, error)
 			m[this.ActivityStreamsResult.Name()] = i
 		}
 	}
+	// Maybe serialize property "sensitive"
+	if this.ActivityStreamsSensitive != nil {
+		if i, err := this.ActivityStreamsSensitive.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsSensitive.Name()] = i
+		}
+	}
 	// Maybe serialize property "shares"
 	if this.ActivityStreamsShares != nil {
 		if i, err := this.ActivityStreamsShares.Serialize(); err != nil {
@@ -1532,6 +2100,14 @@ func (this ActivityStreamsAnnounce) Serialize() (map[string]interface{}, error)
 			m[this.ActivityStreamsTarget.Name()] = i
 		}
 	}
+	// Maybe serialize property "ticketsTrackedBy"
+	if this.ForgeFedTicketsTrackedBy != nil {
+		if i, err := this.ForgeFedTicketsTrackedBy.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ForgeFedTicketsTrackedBy.Name()] = i
+		}
+	}
 	// Maybe serialize property "to"
 	if this.ActivityStreamsTo != nil {
 		if i, err := this.ActivityStreamsTo.Serialize(); err != nil {
@@ -1540,6 +2116,14 @@ func (this ActivityStreamsAnnounce) Serialize() (map[string]interface{}, error)
 			m[this.ActivityStreamsTo.Name()] = i
 		}
 	}
+	// Maybe serialize property "tracksTicketsFor"
+	if this.ForgeFedTracksTicketsFor != nil {
+		if i, err := this.ForgeFedTracksTicketsFor.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ForgeFedTracksTicketsFor.Name()] = i
+		}
+	}
 	// Maybe serialize property "type"
 	if this.JSONLDType != nil {
 		if i, err := this.JSONLDType.Serialize(); err != nil {
@@ -1713,6 +2297,11 @@ func (this *ActivityStreamsAnnounce) SetActivityStreamsResult(i vocab.ActivitySt
 	this.ActivityStreamsResult = i
 }
 
+// SetActivityStreamsSensitive sets the "sensitive" property.
+func (this *ActivityStreamsAnnounce) SetActivityStreamsSensitive(i vocab.ActivityStreamsSensitiveProperty) {
+	this.ActivityStreamsSensitive = i
+}
+
 // SetActivityStreamsShares sets the "shares" property.
 func (this *ActivityStreamsAnnounce) SetActivityStreamsShares(i vocab.ActivityStreamsSharesProperty) {
 	this.ActivityStreamsShares = i
@@ -1753,6 +2342,16 @@ func (this *ActivityStreamsAnnounce) SetActivityStreamsUrl(i vocab.ActivityStrea
 	this.ActivityStreamsUrl = i
 }
 
+// SetForgeFedTicketsTrackedBy sets the "ticketsTrackedBy" property.
+func (this *ActivityStreamsAnnounce) SetForgeFedTicketsTrackedBy(i vocab.ForgeFedTicketsTrackedByProperty) {
+	this.ForgeFedTicketsTrackedBy = i
+}
+
+// SetForgeFedTracksTicketsFor sets the "tracksTicketsFor" property.
+func (this *ActivityStreamsAnnounce) SetForgeFedTracksTicketsFor(i vocab.ForgeFedTracksTicketsForProperty) {
+	this.ForgeFedTracksTicketsFor = i
+}
+
 // SetJSONLDId sets the "id" property.
 func (this *ActivityStreamsAnnounce) SetJSONLDId(i vocab.JSONLDIdProperty) {
 	this.JSONLDId = i
@@ -5,6 +5,7 @@ package typeapplication
 import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
+	"reflect"
 	"strings"
 )
 
@@ -16,48 +17,57 @@ import (
 //     "type": "Application"
 //   }
 type ActivityStreamsApplication struct {
-	ActivityStreamsAltitude          vocab.ActivityStreamsAltitudeProperty
-	ActivityStreamsAttachment        vocab.ActivityStreamsAttachmentProperty
-	ActivityStreamsAttributedTo      vocab.ActivityStreamsAttributedToProperty
-	ActivityStreamsAudience          vocab.ActivityStreamsAudienceProperty
-	ActivityStreamsBcc               vocab.ActivityStreamsBccProperty
-	ActivityStreamsBto               vocab.ActivityStreamsBtoProperty
-	ActivityStreamsCc                vocab.ActivityStreamsCcProperty
-	ActivityStreamsContent           vocab.ActivityStreamsContentProperty
-	ActivityStreamsContext           vocab.ActivityStreamsContextProperty
-	ActivityStreamsDuration          vocab.ActivityStreamsDurationProperty
-	ActivityStreamsEndTime           vocab.ActivityStreamsEndTimeProperty
-	ActivityStreamsFollowers         vocab.ActivityStreamsFollowersProperty
-	ActivityStreamsFollowing         vocab.ActivityStreamsFollowingProperty
-	ActivityStreamsGenerator         vocab.ActivityStreamsGeneratorProperty
-	ActivityStreamsIcon              vocab.ActivityStreamsIconProperty
-	JSONLDId                         vocab.JSONLDIdProperty
-	ActivityStreamsImage             vocab.ActivityStreamsImageProperty
-	ActivityStreamsInReplyTo         vocab.ActivityStreamsInReplyToProperty
-	ActivityStreamsInbox             vocab.ActivityStreamsInboxProperty
-	ActivityStreamsLiked             vocab.ActivityStreamsLikedProperty
-	ActivityStreamsLikes             vocab.ActivityStreamsLikesProperty
-	ActivityStreamsLocation          vocab.ActivityStreamsLocationProperty
-	ActivityStreamsMediaType         vocab.ActivityStreamsMediaTypeProperty
-	ActivityStreamsName              vocab.ActivityStreamsNameProperty
-	ActivityStreamsObject            vocab.ActivityStreamsObjectProperty
-	ActivityStreamsOutbox            vocab.ActivityStreamsOutboxProperty
-	ActivityStreamsPreferredUsername vocab.ActivityStreamsPreferredUsernameProperty
-	ActivityStreamsPreview           vocab.ActivityStreamsPreviewProperty
-	W3IDSecurityV1PublicKey          vocab.W3IDSecurityV1PublicKeyProperty
-	ActivityStreamsPublished         vocab.ActivityStreamsPublishedProperty
-	ActivityStreamsReplies           vocab.ActivityStreamsRepliesProperty
-	ActivityStreamsShares            vocab.ActivityStreamsSharesProperty
-	ActivityStreamsStartTime         vocab.ActivityStreamsStartTimeProperty
-	ActivityStreamsStreams           vocab.ActivityStreamsStreamsProperty
-	ActivityStreamsSummary           vocab.ActivityStreamsSummaryProperty
-	ActivityStreamsTag               vocab.ActivityStreamsTagProperty
-	ActivityStreamsTo                vocab.ActivityStreamsToProperty
-	JSONLDType                       vocab.JSONLDTypeProperty
-	ActivityStreamsUpdated           vocab.ActivityStreamsUpdatedProperty
-	ActivityStreamsUrl               vocab.ActivityStreamsUrlProperty
-	alias                            string
-	unknown                          map[string]interface{}
+	ActivityStreamsAlsoKnownAs               vocab.ActivityStreamsAlsoKnownAsProperty
+	ActivityStreamsAltitude                  vocab.ActivityStreamsAltitudeProperty
+	ActivityStreamsAttachment                vocab.ActivityStreamsAttachmentProperty
+	ActivityStreamsAttributedTo              vocab.ActivityStreamsAttributedToProperty
+	ActivityStreamsAudience                  vocab.ActivityStreamsAudienceProperty
+	ActivityStreamsBcc                       vocab.ActivityStreamsBccProperty
+	ActivityStreamsBto                       vocab.ActivityStreamsBtoProperty
+	ActivityStreamsCc                        vocab.ActivityStreamsCcProperty
+	ActivityStreamsContent                   vocab.ActivityStreamsContentProperty
+	ActivityStreamsContext                   vocab.ActivityStreamsContextProperty
+	TootDiscoverable                         vocab.TootDiscoverableProperty
+	ActivityStreamsDuration                  vocab.ActivityStreamsDurationProperty
+	ActivityStreamsEndTime                   vocab.ActivityStreamsEndTimeProperty
+	TootFeatured                             vocab.TootFeaturedProperty
+	TootFeaturedTags                         vocab.TootFeaturedTagsProperty
+	ActivityStreamsFollowers                 vocab.ActivityStreamsFollowersProperty
+	ActivityStreamsFollowing                 vocab.ActivityStreamsFollowingProperty
+	ActivityStreamsGenerator                 vocab.ActivityStreamsGeneratorProperty
+	ActivityStreamsIcon                      vocab.ActivityStreamsIconProperty
+	JSONLDId                                 vocab.JSONLDIdProperty
+	ActivityStreamsImage                     vocab.ActivityStreamsImageProperty
+	ActivityStreamsInReplyTo                 vocab.ActivityStreamsInReplyToProperty
+	ActivityStreamsInbox                     vocab.ActivityStreamsInboxProperty
+	ActivityStreamsLiked                     vocab.ActivityStreamsLikedProperty
+	ActivityStreamsLikes                     vocab.ActivityStreamsLikesProperty
+	ActivityStreamsLocation                  vocab.ActivityStreamsLocationProperty
+	ActivityStreamsManuallyApprovesFollowers vocab.ActivityStreamsManuallyApprovesFollowersProperty
+	ActivityStreamsMediaType                 vocab.ActivityStreamsMediaTypeProperty
+	ActivityStreamsMovedTo                   vocab.ActivityStreamsMovedToProperty
+	ActivityStreamsName                      vocab.ActivityStreamsNameProperty
+	ActivityStreamsObject                    vocab.ActivityStreamsObjectProperty
+	ActivityStreamsOutbox                    vocab.ActivityStreamsOutboxProperty
+	ActivityStreamsPreferredUsername         vocab.ActivityStreamsPreferredUsernameProperty
+	ActivityStreamsPreview                   vocab.ActivityStreamsPreviewProperty
+	W3IDSecurityV1PublicKey                  vocab.W3IDSecurityV1PublicKeyProperty
+	ActivityStreamsPublished                 vocab.ActivityStreamsPublishedProperty
+	ActivityStreamsReplies                   vocab.ActivityStreamsRepliesProperty
+	ActivityStreamsSensitive                 vocab.ActivityStreamsSensitiveProperty
+	ActivityStreamsShares                    vocab.ActivityStreamsSharesProperty
+	ActivityStreamsStartTime                 vocab.ActivityStreamsStartTimeProperty
+	ActivityStreamsStreams                   vocab.ActivityStreamsStreamsProperty
+	ActivityStreamsSummary                   vocab.ActivityStreamsSummaryProperty
+	ActivityStreamsTag                       vocab.ActivityStreamsTagProperty
+	ForgeFedTicketsTrackedBy                 vocab.ForgeFedTicketsTrackedByProperty
+	ActivityStreamsTo                        vocab.ActivityStreamsToProperty
+	ForgeFedTracksTicketsFor                 vocab.ForgeFedTracksTicketsForProperty
+	JSONLDType                               vocab.JSONLDTypeProperty
+	ActivityStreamsUpdated                   vocab.ActivityStreamsUpdatedProperty
+	ActivityStreamsUrl                       vocab.ActivityStreamsUrlProperty
+	alias                                    string
+	unknown                                  map[string]interface{}
 }
 
 // ActivityStreamsApplicationExtends returns true if the Application type extends
@@ -75,7 +85,7 @@ func ActivityStreamsApplicationExtends(other vocab.Type) bool {
 // ApplicationIsDisjointWith returns true if the other provided type is disjoint
 // with the Application type.
 func ApplicationIsDisjointWith(other vocab.Type) bool {
-	disjointWith := []string{"Link", "Mention"}
+	disjointWith := []string{"Hashtag", "Link", "Mention"}
 	for _, disjoint := range disjointWith {
 		if disjoint == other.GetTypeName() {
 			return true
@@ -129,6 +139,11 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 		return nil, fmt.Errorf("\"type\" property is unrecognized type: %T", typeValue)
 	}
 	// Begin: Known property deserialization
+	if p, err := mgr.DeserializeAlsoKnownAsPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsAlsoKnownAs = p
+	}
 	if p, err := mgr.DeserializeAltitudePropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -174,6 +189,11 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.ActivityStreamsContext = p
 	}
+	if p, err := mgr.DeserializeDiscoverablePropertyToot()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.TootDiscoverable = p
+	}
 	if p, err := mgr.DeserializeDurationPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -184,6 +204,16 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.ActivityStreamsEndTime = p
 	}
+	if p, err := mgr.DeserializeFeaturedPropertyToot()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.TootFeatured = p
+	}
+	if p, err := mgr.DeserializeFeaturedTagsPropertyToot()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.TootFeaturedTags = p
+	}
 	if p, err := mgr.DeserializeFollowersPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -239,11 +269,21 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.ActivityStreamsLocation = p
 	}
+	if p, err := mgr.DeserializeManuallyApprovesFollowersPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsManuallyApprovesFollowers = p
+	}
 	if p, err := mgr.DeserializeMediaTypePropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
 		this.ActivityStreamsMediaType = p
 	}
+	if p, err := mgr.DeserializeMovedToPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsMovedTo = p
+	}
 	if p, err := mgr.DeserializeNamePropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -284,6 +324,11 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.ActivityStreamsReplies = p
 	}
+	if p, err := mgr.DeserializeSensitivePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsSensitive = p
+	}
 	if p, err := mgr.DeserializeSharesPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -309,11 +354,21 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.ActivityStreamsTag = p
 	}
+	if p, err := mgr.DeserializeTicketsTrackedByPropertyForgeFed()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ForgeFedTicketsTrackedBy = p
+	}
 	if p, err := mgr.DeserializeToPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
 		this.ActivityStreamsTo = p
 	}
+	if p, err := mgr.DeserializeTracksTicketsForPropertyForgeFed()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ForgeFedTracksTicketsFor = p
+	}
 	if p, err := mgr.DeserializeTypePropertyJSONLD()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -334,7 +389,9 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	// Begin: Unknown deserialization
 	for k, v := range m {
 		// Begin: Code that ensures a property name is unknown
-		if k == "altitude" {
+		if k == "alsoKnownAs" {
+			continue
+		} else if k == "altitude" {
 			continue
 		} else if k == "attachment" {
 			continue
@@ -354,10 +411,16 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 			continue
 		} else if k == "context" {
 			continue
+		} else if k == "discoverable" {
+			continue
 		} else if k == "duration" {
 			continue
 		} else if k == "endTime" {
 			continue
+		} else if k == "featured" {
+			continue
+		} else if k == "featuredTags" {
+			continue
 		} else if k == "followers" {
 			continue
 		} else if k == "following" {
@@ -380,8 +443,12 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 			continue
 		} else if k == "location" {
 			continue
+		} else if k == "manuallyApprovesFollowers" {
+			continue
 		} else if k == "mediaType" {
 			continue
+		} else if k == "movedTo" {
+			continue
 		} else if k == "name" {
 			continue
 		} else if k == "nameMap" {
@@ -402,6 +469,8 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 			continue
 		} else if k == "replies" {
 			continue
+		} else if k == "sensitive" {
+			continue
 		} else if k == "shares" {
 			continue
 		} else if k == "startTime" {
@@ -414,8 +483,12 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 			continue
 		} else if k == "tag" {
 			continue
+		} else if k == "ticketsTrackedBy" {
+			continue
 		} else if k == "to" {
 			continue
+		} else if k == "tracksTicketsFor" {
+			continue
 		} else if k == "type" {
 			continue
 		} else if k == "updated" {
@@ -426,29 +499,597 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 
 		this.unknown[k] = v
 	}
-	// End: Unknown deserialization
-
-	return this, nil
-}
-
-// IsOrExtendsApplication returns true if the other provided type is the
-// Application type or extends from the Application type.
-func IsOrExtendsApplication(other vocab.Type) bool {
-	if other.GetTypeName() == "Application" {
-		return true
+	// End: Unknown deserialization
+
+	return this, nil
+}
+
+// IsOrExtendsApplication returns true if the other provided type is the
+// Application type or extends from the Application type.
+func IsOrExtendsApplication(other vocab.Type) bool {
+	if other.GetTypeName() == "Application" {
+		return true
+	}
+	return ApplicationIsExtendedBy(other)
+}
+
+// NewActivityStreamsApplication creates a new Application type
+func NewActivityStreamsApplication() *ActivityStreamsApplication {
+	typeProp := typePropertyConstructor()
+	typeProp.AppendXMLSchemaString("Application")
+	return &ActivityStreamsApplication{
+		JSONLDType: typeProp,
+		alias:      "",
+		unknown:    make(map[string]interface{}),
+	}
+}
+
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
+// Clone returns a deep copy of this Application and its properties, which can be
+// changed without changing this one.
+func (this ActivityStreamsApplication) Clone() vocab.ActivityStreamsApplication {
+	clone := this
+	if this.ActivityStreamsAlsoKnownAs != nil {
+		clone.ActivityStreamsAlsoKnownAs = this.ActivityStreamsAlsoKnownAs.Clone()
+	}
+	if this.ActivityStreamsAltitude != nil {
+		clone.ActivityStreamsAltitude = this.ActivityStreamsAltitude.Clone()
+	}
+	if this.ActivityStreamsAttachment != nil {
+		clone.ActivityStreamsAttachment = this.ActivityStreamsAttachment.Clone()
+	}
+	if this.ActivityStreamsAttributedTo != nil {
+		clone.ActivityStreamsAttributedTo = this.ActivityStreamsAttributedTo.Clone()
+	}
+	if this.ActivityStreamsAudience != nil {
+		clone.ActivityStreamsAudience = this.ActivityStreamsAudience.Clone()
+	}
+	if this.ActivityStreamsBcc != nil {
+		clone.ActivityStreamsBcc = this.ActivityStreamsBcc.Clone()
+	}
+	if this.ActivityStreamsBto != nil {
+		clone.ActivityStreamsBto = this.ActivityStreamsBto.Clone()
+	}
+	if this.ActivityStreamsCc != nil {
+		clone.ActivityStreamsCc = this.ActivityStreamsCc.Clone()
+	}
+	if this.ActivityStreamsContent != nil {
+		clone.ActivityStreamsContent = this.ActivityStreamsContent.Clone()
+	}
+	if this.ActivityStreamsContext != nil {
+		clone.ActivityStreamsContext = this.ActivityStreamsContext.Clone()
+	}
+	if this.TootDiscoverable != nil {
+		clone.TootDiscoverable = this.TootDiscoverable.Clone()
+	}
+	if this.ActivityStreamsDuration != nil {
+		clone.ActivityStreamsDuration = this.ActivityStreamsDuration.Clone()
+	}
+	if this.ActivityStreamsEndTime != nil {
+		clone.ActivityStreamsEndTime = this.ActivityStreamsEndTime.Clone()
+	}
+	if this.TootFeatured != nil {
+		clone.TootFeatured = this.TootFeatured.Clone()
+	}
+	if this.TootFeaturedTags != nil {
+		clone.TootFeaturedTags = this.TootFeaturedTags.Clone()
+	}
+	if this.ActivityStreamsFollowers != nil {
+		clone.ActivityStreamsFollowers = this.ActivityStreamsFollowers.Clone()
+	}
+	if this.ActivityStreamsFollowing != nil {
+		clone.ActivityStreamsFollowing = this.ActivityStreamsFollowing.Clone()
+	}
+	if this.ActivityStreamsGenerator != nil {
+		clone.ActivityStreamsGenerator = this.ActivityStreamsGenerator.Clone()
+	}
+	if this.ActivityStreamsIcon != nil {
+		clone.ActivityStreamsIcon = this.ActivityStreamsIcon.Clone()
+	}
+	if this.JSONLDId != nil {
+		clone.JSONLDId = this.JSONLDId.Clone()
+	}
+	if this.ActivityStreamsImage != nil {
+		clone.ActivityStreamsImage = this.ActivityStreamsImage.Clone()
+	}
+	if this.ActivityStreamsInReplyTo != nil {
+		clone.ActivityStreamsInReplyTo = this.ActivityStreamsInReplyTo.Clone()
+	}
+	if this.ActivityStreamsInbox != nil {
+		clone.ActivityStreamsInbox = this.ActivityStreamsInbox.Clone()
+	}
+	if this.ActivityStreamsLiked != nil {
+		clone.ActivityStreamsLiked = this.ActivityStreamsLiked.Clone()
+	}
+	if this.ActivityStreamsLikes != nil {
+		clone.ActivityStreamsLikes = this.ActivityStreamsLikes.Clone()
+	}
+	if this.ActivityStreamsLocation != nil {
+		clone.ActivityStreamsLocation = this.ActivityStreamsLocation.Clone()
+	}
+	if this.ActivityStreamsManuallyApprovesFollowers != nil {
+		clone.ActivityStreamsManuallyApprovesFollowers = this.ActivityStreamsManuallyApprovesFollowers.Clone()
+	}
+	if this.ActivityStreamsMediaType != nil {
+		clone.ActivityStreamsMediaType = this.ActivityStreamsMediaType.Clone()
+	}
+	if this.ActivityStreamsMovedTo != nil {
+		clone.ActivityStreamsMovedTo = this.ActivityStreamsMovedTo.Clone()
+	}
+	if this.ActivityStreamsName != nil {
+		clone.ActivityStreamsName = this.ActivityStreamsName.Clone()
+	}
+	if this.ActivityStreamsObject != nil {
+		clone.ActivityStreamsObject = this.ActivityStreamsObject.Clone()
+	}
+	if this.ActivityStreamsOutbox != nil {
+		clone.ActivityStreamsOutbox = this.ActivityStreamsOutbox.Clone()
+	}
+	if this.ActivityStreamsPreferredUsername != nil {
+		clone.ActivityStreamsPreferredUsername = this.ActivityStreamsPreferredUsername.Clone()
+	}
+	if this.ActivityStreamsPreview != nil {
+		clone.ActivityStreamsPreview = this.ActivityStreamsPreview.Clone()
+	}
+	if this.W3IDSecurityV1PublicKey != nil {
+		clone.W3IDSecurityV1PublicKey = this.W3IDSecurityV1PublicKey.Clone()
+	}
+	if this.ActivityStreamsPublished != nil {
+		clone.ActivityStreamsPublished = this.ActivityStreamsPublished.Clone()
+	}
+	if this.ActivityStreamsReplies != nil {
+		clone.ActivityStreamsReplies = this.ActivityStreamsReplies.Clone()
+	}
+	if this.ActivityStreamsSensitive != nil {
+		clone.ActivityStreamsSensitive = this.ActivityStreamsSensitive.Clone()
+	}
+	if this.ActivityStreamsShares != nil {
+		clone.ActivityStreamsShares = this.ActivityStreamsShares.Clone()
+	}
+	if this.ActivityStreamsStartTime != nil {
+		clone.ActivityStreamsStartTime = this.ActivityStreamsStartTime.Clone()
+	}
+	if this.ActivityStreamsStreams != nil {
+		clone.ActivityStreamsStreams = this.ActivityStreamsStreams.Clone()
+	}
+	if this.ActivityStreamsSummary != nil {
+		clone.ActivityStreamsSummary = this.ActivityStreamsSummary.Clone()
+	}
+	if this.ActivityStreamsTag != nil {
+		clone.ActivityStreamsTag = this.ActivityStreamsTag.Clone()
+	}
+	if this.ForgeFedTicketsTrackedBy != nil {
+		clone.ForgeFedTicketsTrackedBy = this.ForgeFedTicketsTrackedBy.Clone()
+	}
+	if this.ActivityStreamsTo != nil {
+		clone.ActivityStreamsTo = this.ActivityStreamsTo.Clone()
+	}
+	if this.ForgeFedTracksTicketsFor != nil {
+		clone.ForgeFedTracksTicketsFor = this.ForgeFedTracksTicketsFor.Clone()
+	}
+	if this.JSONLDType != nil {
+		clone.JSONLDType = this.JSONLDType.Clone()
+	}
+	if this.ActivityStreamsUpdated != nil {
+		clone.ActivityStreamsUpdated = this.ActivityStreamsUpdated.Clone()
+	}
+	if this.ActivityStreamsUrl != nil {
+		clone.ActivityStreamsUrl = this.ActivityStreamsUrl.Clone()
+	}
+	clone.unknown = cloneUnknown(this.unknown).(map[string]interface{})
+	return &clone
+}
+
+// Equals determines whether this Application has the same properties as another,
+// comparing them deeply. The @context of deserialized values is ignored.
+func (this ActivityStreamsApplication) Equals(o vocab.ActivityStreamsApplication) bool {
+	if lhs, rhs := this.ActivityStreamsAlsoKnownAs, o.GetActivityStreamsAlsoKnownAs(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsAltitude, o.GetActivityStreamsAltitude(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsAttachment, o.GetActivityStreamsAttachment(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsAttributedTo, o.GetActivityStreamsAttributedTo(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsAudience, o.GetActivityStreamsAudience(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsBcc, o.GetActivityStreamsBcc(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsBto, o.GetActivityStreamsBto(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsCc, o.GetActivityStreamsCc(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsContent, o.GetActivityStreamsContent(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsContext, o.GetActivityStreamsContext(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.TootDiscoverable, o.GetTootDiscoverable(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsDuration, o.GetActivityStreamsDuration(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsEndTime, o.GetActivityStreamsEndTime(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.TootFeatured, o.GetTootFeatured(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.TootFeaturedTags, o.GetTootFeaturedTags(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsFollowers, o.GetActivityStreamsFollowers(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsFollowing, o.GetActivityStreamsFollowing(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsGenerator, o.GetActivityStreamsGenerator(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsIcon, o.GetActivityStreamsIcon(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsImage, o.GetActivityStreamsImage(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsInReplyTo, o.GetActivityStreamsInReplyTo(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsInbox, o.GetActivityStreamsInbox(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsLiked, o.GetActivityStreamsLiked(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsLikes, o.GetActivityStreamsLikes(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsLocation, o.GetActivityStreamsLocation(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsManuallyApprovesFollowers, o.GetActivityStreamsManuallyApprovesFollowers(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsMediaType, o.GetActivityStreamsMediaType(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsMovedTo, o.GetActivityStreamsMovedTo(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsName, o.GetActivityStreamsName(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsObject, o.GetActivityStreamsObject(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsOutbox, o.GetActivityStreamsOutbox(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsPreferredUsername, o.GetActivityStreamsPreferredUsername(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsPreview, o.GetActivityStreamsPreview(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.W3IDSecurityV1PublicKey, o.GetW3IDSecurityV1PublicKey(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsPublished, o.GetActivityStreamsPublished(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsReplies, o.GetActivityStreamsReplies(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsSensitive, o.GetActivityStreamsSensitive(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsShares, o.GetActivityStreamsShares(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsStartTime, o.GetActivityStreamsStartTime(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
 	}
-	return ApplicationIsExtendedBy(other)
+	if lhs, rhs := this.ActivityStreamsStreams, o.GetActivityStreamsStreams(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsSummary, o.GetActivityStreamsSummary(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsTag, o.GetActivityStreamsTag(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ForgeFedTicketsTrackedBy, o.GetForgeFedTicketsTrackedBy(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsTo, o.GetActivityStreamsTo(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ForgeFedTracksTicketsFor, o.GetForgeFedTracksTicketsFor(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsUpdated, o.GetActivityStreamsUpdated(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsUrl, o.GetActivityStreamsUrl(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	return equalsUnknown(this.unknown, o.GetUnknownProperties())
 }
 
-// NewActivityStreamsApplication creates a new Application type
-func NewActivityStreamsApplication() *ActivityStreamsApplication {
-	typeProp := typePropertyConstructor()
-	typeProp.AppendXMLSchemaString("Application")
-	return &ActivityStreamsApplication{
-		JSONLDType: typeProp,
-		alias:      "",
-		unknown:    make(map[string]interface{}),
-	}
+// GetActivityStreamsAlsoKnownAs returns the "alsoKnownAs" property if it exists,
+// and nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsAlsoKnownAs() vocab.ActivityStreamsAlsoKnownAsProperty {
+	return this.ActivityStreamsAlsoKnownAs
 }
 
 // GetActivityStreamsAltitude returns the "altitude" property if it exists, and
@@ -576,12 +1217,24 @@ func (this ActivityStreamsApplication) GetActivityStreamsLocation() vocab.Activi
 	return this.ActivityStreamsLocation
 }
 
+// GetActivityStreamsManuallyApprovesFollowers returns the
+// "manuallyApprovesFollowers" property if it exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsManuallyApprovesFollowers() vocab.ActivityStreamsManuallyApprovesFollowersProperty {
+	return this.ActivityStreamsManuallyApprovesFollowers
+}
+
 // GetActivityStreamsMediaType returns the "mediaType" property if it exists, and
 // nil otherwise.
 func (this ActivityStreamsApplication) GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty {
 	return this.ActivityStreamsMediaType
 }
 
+// GetActivityStreamsMovedTo returns the "movedTo" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsMovedTo() vocab.ActivityStreamsMovedToProperty {
+	return this.ActivityStreamsMovedTo
+}
+
 // GetActivityStreamsName returns the "name" property if it exists, and nil
 // otherwise.
 func (this ActivityStreamsApplication) GetActivityStreamsName() vocab.ActivityStreamsNameProperty {
@@ -624,6 +1277,12 @@ func (this ActivityStreamsApplication) GetActivityStreamsReplies() vocab.Activit
 	return this.ActivityStreamsReplies
 }
 
+// GetActivityStreamsSensitive returns the "sensitive" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsSensitive() vocab.ActivityStreamsSensitiveProperty {
+	return this.ActivityStreamsSensitive
+}
+
 // GetActivityStreamsShares returns the "shares" property if it exists, and nil
 // otherwise.
 func (this ActivityStreamsApplication) GetActivityStreamsShares() vocab.ActivityStreamsSharesProperty {
@@ -671,6 +1330,18 @@ func (this ActivityStreamsApplication) GetActivityStreamsUrl() vocab.ActivityStr
 	return this.ActivityStreamsUrl
 }
 
+// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetForgeFedTicketsTrackedBy() vocab.ForgeFedTicketsTrackedByProperty {
+	return this.ForgeFedTicketsTrackedBy
+}
+
+// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetForgeFedTracksTicketsFor() vocab.ForgeFedTracksTicketsForProperty {
+	return this.ForgeFedTracksTicketsFor
+}
+
 // GetJSONLDId returns the "id" property if it exists, and nil otherwise.
 func (this ActivityStreamsApplication) GetJSONLDId() vocab.JSONLDIdProperty {
 	return this.JSONLDId
@@ -681,6 +1352,23 @@ func (this ActivityStreamsApplication) GetJSONLDType() vocab.JSONLDTypeProperty
 	return this.JSONLDType
 }
 
+// GetTootDiscoverable returns the "discoverable" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetTootDiscoverable() vocab.TootDiscoverableProperty {
+	return this.TootDiscoverable
+}
+
+// GetTootFeatured returns the "featured" property if it exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetTootFeatured() vocab.TootFeaturedProperty {
+	return this.TootFeatured
+}
+
+// GetTootFeaturedTags returns the "featuredTags" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetTootFeaturedTags() vocab.TootFeaturedTagsProperty {
+	return this.TootFeaturedTags
+}
+
 // GetTypeName returns the name of this type.
 func (this ActivityStreamsApplication) GetTypeName() string {
 	return "Application"
@@ -712,6 +1400,7 @@ func (this ActivityStreamsApplication) IsExtending(other vocab.Type) bool {
 // alias used to import the type and its properties.
 func (this ActivityStreamsApplication) JSONLDContext() map[string]string {
 	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
+	m = this.helperJSONLDContext(this.ActivityStreamsAlsoKnownAs, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsAltitude, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsAttachment, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsAttributedTo, m)
@@ -721,8 +1410,11 @@ func (this ActivityStreamsApplication) JSONLDContext() map[string]string {
 	m = this.helperJSONLDContext(this.ActivityStreamsCc, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsContent, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsContext, m)
+	m = this.helperJSONLDContext(this.TootDiscoverable, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsDuration, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsEndTime, m)
+	m = this.helperJSONLDContext(this.TootFeatured, m)
+	m = this.helperJSONLDContext(this.TootFeaturedTags, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsFollowers, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsFollowing, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsGenerator, m)
@@ -734,7 +1426,9 @@ func (this ActivityStreamsApplication) JSONLDContext() map[string]string {
 	m = this.helperJSONLDContext(this.ActivityStreamsLiked, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsLikes, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsLocation, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsManuallyApprovesFollowers, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsMediaType, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsMovedTo, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsName, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsObject, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsOutbox, m)
@@ -743,12 +1437,15 @@ func (this ActivityStreamsApplication) JSONLDContext() map[string]string {
 	m = this.helperJSONLDContext(this.W3IDSecurityV1PublicKey, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsPublished, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsReplies, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsSensitive, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsShares, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsStartTime, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsStreams, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsSummary, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTag, m)
+	m = this.helperJSONLDContext(this.ForgeFedTicketsTrackedBy, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTo, m)
+	m = this.helperJSONLDContext(this.ForgeFedTracksTicketsFor, m)
 	m = this.helperJSONLDContext(this.JSONLDType, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsUpdated, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsUrl, m)
@@ -760,6 +1457,20 @@ func (this ActivityStreamsApplication) JSONLDContext() map[string]string {
 // determination.
 func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplication) bool {
 	// Begin: Compare known properties
+	// Compare property "alsoKnownAs"
+	if lhs, rhs := this.ActivityStreamsAlsoKnownAs, o.GetActivityStreamsAlsoKnownAs(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "altitude"
 	if lhs, rhs := this.ActivityStreamsAltitude, o.GetActivityStreamsAltitude(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -886,6 +1597,20 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "discoverable"
+	if lhs, rhs := this.TootDiscoverable, o.GetTootDiscoverable(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "duration"
 	if lhs, rhs := this.ActivityStreamsDuration, o.GetActivityStreamsDuration(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -914,6 +1639,34 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "featured"
+	if lhs, rhs := this.TootFeatured, o.GetTootFeatured(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "featuredTags"
+	if lhs, rhs := this.TootFeaturedTags, o.GetTootFeaturedTags(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "followers"
 	if lhs, rhs := this.ActivityStreamsFollowers, o.GetActivityStreamsFollowers(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1068,6 +1821,20 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "manuallyApprovesFollowers"
+	if lhs, rhs := this.ActivityStreamsManuallyApprovesFollowers, o.GetActivityStreamsManuallyApprovesFollowers(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "mediaType"
 	if lhs, rhs := this.ActivityStreamsMediaType, o.GetActivityStreamsMediaType(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1082,6 +1849,20 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "movedTo"
+	if lhs, rhs := this.ActivityStreamsMovedTo, o.GetActivityStreamsMovedTo(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "name"
 	if lhs, rhs := this.ActivityStreamsName, o.GetActivityStreamsName(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1194,6 +1975,20 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "sensitive"
+	if lhs, rhs := this.ActivityStreamsSensitive, o.GetActivityStreamsSensitive(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "shares"
 	if lhs, rhs := this.ActivityStreamsShares, o.GetActivityStreamsShares(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1264,6 +2059,20 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "ticketsTrackedBy"
+	if lhs, rhs := this.ForgeFedTicketsTrackedBy, o.GetForgeFedTicketsTrackedBy(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "to"
 	if lhs, rhs := this.ActivityStreamsTo, o.GetActivityStreamsTo(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1278,6 +2087,20 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "tracksTicketsFor"
+	if lhs, rhs := this.ForgeFedTracksTicketsFor, o.GetForgeFedTracksTicketsFor(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "type"
 	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1343,6 +2166,14 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 	}
 	m["type"] = typeName
 	// Begin: Serialize known properties
+	// Maybe serialize property "alsoKnownAs"
+	if this.ActivityStreamsAlsoKnownAs != nil {
+		if i, err := this.ActivityStreamsAlsoKnownAs.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsAlsoKnownAs.Name()] = i
+		}
+	}
 	// Maybe serialize property "altitude"
 	if this.ActivityStreamsAltitude != nil {
 		if i, err := this.ActivityStreamsAltitude.Serialize(); err != nil {
@@ -1415,6 +2246,14 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 			m[this.ActivityStreamsContext.Name()] = i
 		}
 	}
+	// Maybe serialize property "discoverable"
+	if this.TootDiscoverable != nil {
+		if i, err := this.TootDiscoverable.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.TootDiscoverable.Name()] = i
+		}
+	}
 	// Maybe serialize property "duration"
 	if this.ActivityStreamsDuration != nil {
 		if i, err := this.ActivityStreamsDuration.Serialize(); err != nil {
@@ -1431,6 +2270,22 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 			m[this.ActivityStreamsEndTime.Name()] = i
 		}
 	}
+	// Maybe serialize property "featured"
+	if this.TootFeatured != nil {
+		if i, err := this.TootFeatured.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.TootFeatured.Name()] = i
+		}
+	}
+	// Maybe serialize property "featuredTags"
+	if this.TootFeaturedTags != nil {
+		if i, err := this.TootFeaturedTags.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.TootFeaturedTags.Name()] = i
+		}
+	}
 	// Maybe serialize property "followers"
 	if this.ActivityStreamsFollowers != nil {
 		if i, err := this.ActivityStreamsFollowers.Serialize(); err != nil {
@@ -1519,6 +2374,14 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 			m[this.ActivityStreamsLocation.Name()] = i
 		}
 	}
+	// Maybe serialize property "manuallyApprovesFollowers"
+	if this.ActivityStreamsManuallyApprovesFollowers != nil {
+		if i, err := this.ActivityStreamsManuallyApprovesFollowers.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsManuallyApprovesFollowers.Name()] = i
+		}
+	}
 	// Maybe serialize property "mediaType"
 	if this.ActivityStreamsMediaType != nil {
 		if i, err := this.ActivityStreamsMediaType.Serialize(); err != nil {
@@ -1527,6 +2390,14 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 			m[this.ActivityStreamsMediaType.Name()] = i
 		}
 	}
+	// Maybe serialize property "movedTo"
+	if this.ActivityStreamsMovedTo != nil {
+		if i, err := this.ActivityStreamsMovedTo.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsMovedTo.Name()] = i
+		}
+	}
 	// Maybe serialize property "name"
 	if this.ActivityStreamsName != nil {
 		if i, err := this.ActivityStreamsName.Serialize(); err != nil {
@@ -1591,6 +2462,14 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 			m[this.ActivityStreamsReplies.Name()] = i
 		}
 	}
+	// Maybe serialize property "sensitive"
+	if this.ActivityStreamsSensitive != nil {
+		if i, err := this.ActivityStreamsSensitive.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsSensitive.Name()] = i
+		}
+	}
 	// Maybe serialize property "shares"
 	if this.ActivityStreamsShares != nil {
 		if i, err := this.ActivityStreamsShares.Serialize(); err != nil {
@@ -1631,6 +2510,14 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 			m[this.ActivityStreamsTag.Name()] = i
 		}
 	}
+	// Maybe serialize property "ticketsTrackedBy"
+	if this.ForgeFedTicketsTrackedBy != nil {
+		if i, err := this.ForgeFedTicketsTrackedBy.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ForgeFedTicketsTrackedBy.Name()] = i
+		}
+	}
 	// Maybe serialize property "to"
 	if this.ActivityStreamsTo != nil {
 		if i, err := this.ActivityStreamsTo.Serialize(); err != nil {
@@ -1639,6 +2526,14 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 			m[this.ActivityStreamsTo.Name()] = i
 		}
 	}
+	// Maybe serialize property "tracksTicketsFor"
+	if this.ForgeFedTracksTicketsFor != nil {
+		if i, err := this.ForgeFedTracksTicketsFor.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ForgeFedTracksTicketsFor.Name()] = i
+		}
+	}
 	// Maybe serialize property "type"
 	if this.JSONLDType != nil {
 		if i, err := this.JSONLDType.Serialize(); err != nil {
@@ -1677,6 +2572,11 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 	return m, nil
 }
 
+// SetActivityStreamsAlsoKnownAs sets the "alsoKnownAs" property.
+func (this *ActivityStreamsApplication) SetActivityStreamsAlsoKnownAs(i vocab.ActivityStreamsAlsoKnownAsProperty) {
+	this.ActivityStreamsAlsoKnownAs = i
+}
+
 // SetActivityStreamsAltitude sets the "altitude" property.
 func (this *ActivityStreamsApplication) SetActivityStreamsAltitude(i vocab.ActivityStreamsAltitudeProperty) {
 	this.ActivityStreamsAltitude = i
@@ -1782,11 +2682,22 @@ func (this *ActivityStreamsApplication) SetActivityStreamsLocation(i vocab.Activ
 	this.ActivityStreamsLocation = i
 }
 
+// SetActivityStreamsManuallyApprovesFollowers sets the
+// "manuallyApprovesFollowers" property.
+func (this *ActivityStreamsApplication) SetActivityStreamsManuallyApprovesFollowers(i vocab.ActivityStreamsManuallyApprovesFollowersProperty) {
+	this.ActivityStreamsManuallyApprovesFollowers = i
+}
+
 // SetActivityStreamsMediaType sets the "mediaType" property.
 func (this *ActivityStreamsApplication) SetActivityStreamsMediaType(i vocab.ActivityStreamsMediaTypeProperty) {
 	this.ActivityStreamsMediaType = i
 }
 
+// SetActivityStreamsMovedTo sets the "movedTo" property.
+func (this *ActivityStreamsApplication) SetActivityStreamsMovedTo(i vocab.ActivityStreamsMovedToProperty) {
+	this.ActivityStreamsMovedTo = i
+}
+
 // SetActivityStreamsName sets the "name" property.
 func (this *ActivityStreamsApplication) SetActivityStreamsName(i vocab.ActivityStreamsNameProperty) {
 	this.ActivityStreamsName = i
@@ -1822,6 +2733,11 @@ func (this *ActivityStreamsApplication) SetActivityStreamsReplies(i vocab.Activi
 	this.ActivityStreamsReplies = i
 }
 
+// SetActivityStreamsSensitive sets the "sensitive" property.
+func (this *ActivityStreamsApplication) SetActivityStreamsSensitive(i vocab.ActivityStreamsSensitiveProperty) {
+	this.ActivityStreamsSensitive = i
+}
+
 // SetActivityStreamsShares sets the "shares" property.
 func (this *ActivityStreamsApplication) SetActivityStreamsShares(i vocab.ActivityStreamsSharesProperty) {
 	this.ActivityStreamsShares = i
@@ -1862,6 +2778,16 @@ func (this *ActivityStreamsApplication) SetActivityStreamsUrl(i vocab.ActivitySt
 	this.ActivityStreamsUrl = i
 }
 
+// SetForgeFedTicketsTrackedBy sets the "ticketsTrackedBy" property.
+func (this *ActivityStreamsApplication) SetForgeFedTicketsTrackedBy(i vocab.ForgeFedTicketsTrackedByProperty) {
+	this.ForgeFedTicketsTrackedBy = i
+}
+
+// SetForgeFedTracksTicketsFor sets the "tracksTicketsFor" property.
+func (this *ActivityStreamsApplication) SetForgeFedTracksTicketsFor(i vocab.ForgeFedTracksTicketsForProperty) {
+	this.ForgeFedTracksTicketsFor = i
+}
+
 // SetJSONLDId sets the "id" property.
 func (this *ActivityStreamsApplication) SetJSONLDId(i vocab.JSONLDIdProperty) {
 	this.JSONLDId = i
@@ -1872,6 +2798,21 @@ func (this *ActivityStreamsApplication) SetJSONLDType(i vocab.JSONLDTypeProperty
 	this.JSONLDType = i
 }
 
+// SetTootDiscoverable sets the "discoverable" property.
+func (this *ActivityStreamsApplication) SetTootDiscoverable(i vocab.TootDiscoverableProperty) {
+	this.TootDiscoverable = i
+}
+
+// SetTootFeatured sets the "featured" property.
+func (this *ActivityStreamsApplication) SetTootFeatured(i vocab.TootFeaturedProperty) {
+	this.TootFeatured = i
+}
+
+// SetTootFeaturedTags sets the "featuredTags" property.
+func (this *ActivityStreamsApplication) SetTootFeaturedTags(i vocab.TootFeaturedTagsProperty) {
+	this.TootFeaturedTags = i
+}
+
 // SetW3IDSecurityV1PublicKey sets the "publicKey" property.
 func (this *ActivityStreamsApplication) SetW3IDSecurityV1PublicKey(i vocab.W3IDSecurityV1PublicKeyProperty) {
 	this.W3IDSecurityV1PublicKey = i
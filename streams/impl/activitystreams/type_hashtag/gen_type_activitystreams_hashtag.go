@@ -0,0 +1,918 @@
+// Code generated by astool. DO NOT EDIT.
+
+package typehashtag
+
+import (
+	"fmt"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"reflect"
+	"strings"
+)
+
+// A specialized Link that represents a #hashtag. This term is widely used in the
+// as: namespace by federated software, although it is not in the
+// ActivityStreams Vocabulary.
+//
+// Hashtag Example (https://www.w3.org/TR/activitystreams-vocabulary/#ex-hashtag-jsonld):
+//   {
+//     "href": "http://example.org/tags/cats",
+//     "name": "#cats",
+//     "type": "Hashtag"
+//   }
+type ActivityStreamsHashtag struct {
+	ActivityStreamsAttributedTo vocab.ActivityStreamsAttributedToProperty
+	ActivityStreamsHeight       vocab.ActivityStreamsHeightProperty
+	ActivityStreamsHref         vocab.ActivityStreamsHrefProperty
+	ActivityStreamsHreflang     vocab.ActivityStreamsHreflangProperty
+	JSONLDId                    vocab.JSONLDIdProperty
+	ActivityStreamsMediaType    vocab.ActivityStreamsMediaTypeProperty
+	ActivityStreamsName         vocab.ActivityStreamsNameProperty
+	ActivityStreamsPreview      vocab.ActivityStreamsPreviewProperty
+	ActivityStreamsRel          vocab.ActivityStreamsRelProperty
+	ActivityStreamsSummary      vocab.ActivityStreamsSummaryProperty
+	JSONLDType                  vocab.JSONLDTypeProperty
+	ActivityStreamsWidth        vocab.ActivityStreamsWidthProperty
+	alias                       string
+	unknown                     map[string]interface{}
+}
+
+// ActivityStreamsHashtagExtends returns true if the Hashtag type extends from the
+// other type.
+func ActivityStreamsHashtagExtends(other vocab.Type) bool {
+	extensions := []string{"Link"}
+	for _, ext := range extensions {
+		if ext == other.GetTypeName() {
+			return true
+		}
+	}
+	return false
+}
+
+// DeserializeHashtag creates a Hashtag from a map representation that has been
+// unmarshalled from a text or binary format.
+func DeserializeHashtag(m map[string]interface{}, aliasMap map[string]string) (*ActivityStreamsHashtag, error) {
+	alias := ""
+	aliasPrefix := ""
+	if a, ok := aliasMap["https://www.w3.org/ns/activitystreams"]; ok {
+		alias = a
+		aliasPrefix = a + ":"
+	}
+	this := &ActivityStreamsHashtag{
+		alias:   alias,
+		unknown: make(map[string]interface{}),
+	}
+	if typeValue, ok := m["type"]; !ok {
+		return nil, fmt.Errorf("no \"type\" property in map")
+	} else if typeString, ok := typeValue.(string); ok {
+		typeName := strings.TrimPrefix(typeString, aliasPrefix)
+		if typeName != "Hashtag" {
+			return nil, fmt.Errorf("\"type\" property is not of %q type: %s", "Hashtag", typeName)
+		}
+		// Fall through, success in finding a proper Type
+	} else if arrType, ok := typeValue.([]interface{}); ok {
+		found := false
+		for _, elemVal := range arrType {
+			if typeString, ok := elemVal.(string); ok && strings.TrimPrefix(typeString, aliasPrefix) == "Hashtag" {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("could not find a \"type\" property of value %q", "Hashtag")
+		}
+		// Fall through, success in finding a proper Type
+	} else {
+		return nil, fmt.Errorf("\"type\" property is unrecognized type: %T", typeValue)
+	}
+	// Begin: Known property deserialization
+	if p, err := mgr.DeserializeAttributedToPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsAttributedTo = p
+	}
+	if p, err := mgr.DeserializeHeightPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsHeight = p
+	}
+	if p, err := mgr.DeserializeHrefPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsHref = p
+	}
+	if p, err := mgr.DeserializeHreflangPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsHreflang = p
+	}
+	if p, err := mgr.DeserializeIdPropertyJSONLD()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.JSONLDId = p
+	}
+	if p, err := mgr.DeserializeMediaTypePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsMediaType = p
+	}
+	if p, err := mgr.DeserializeNamePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsName = p
+	}
+	if p, err := mgr.DeserializePreviewPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsPreview = p
+	}
+	if p, err := mgr.DeserializeRelPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsRel = p
+	}
+	if p, err := mgr.DeserializeSummaryPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsSummary = p
+	}
+	if p, err := mgr.DeserializeTypePropertyJSONLD()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.JSONLDType = p
+	}
+	if p, err := mgr.DeserializeWidthPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsWidth = p
+	}
+	// End: Known property deserialization
+
+	// Begin: Unknown deserialization
+	for k, v := range m {
+		// Begin: Code that ensures a property name is unknown
+		if k == "attributedTo" {
+			continue
+		} else if k == "height" {
+			continue
+		} else if k == "href" {
+			continue
+		} else if k == "hreflang" {
+			continue
+		} else if k == "id" {
+			continue
+		} else if k == "mediaType" {
+			continue
+		} else if k == "name" {
+			continue
+		} else if k == "nameMap" {
+			continue
+		} else if k == "preview" {
+			continue
+		} else if k == "rel" {
+			continue
+		} else if k == "summary" {
+			continue
+		} else if k == "summaryMap" {
+			continue
+		} else if k == "type" {
+			continue
+		} else if k == "width" {
+			continue
+		} // End: Code that ensures a property name is unknown
+
+		this.unknown[k] = v
+	}
+	// End: Unknown deserialization
+
+	return this, nil
+}
+
+// HashtagIsDisjointWith returns true if the other provided type is disjoint with
+// the Hashtag type.
+func HashtagIsDisjointWith(other vocab.Type) bool {
+	disjointWith := []string{"Accept", "Activity", "Add", "Announce", "Application", "Arrive", "Article", "Audio", "Block", "Collection", "CollectionPage", "Commit", "Create", "Delete", "Dislike", "Document", "Emoji", "Event", "Flag", "Follow", "Group", "Ignore", "Image", "IntransitiveActivity", "Invite", "Join", "Leave", "Like", "Listen", "Move", "Note", "Object", "Offer", "OrderedCollection", "OrderedCollectionPage", "OrderedCollectionPage", "Organization", "Page", "Patch", "Person", "Place", "Profile", "PropertyValue", "Push", "Question", "Read", "Reject", "Relationship", "Remove", "Repository", "Service", "TentativeAccept", "TentativeReject", "Ticket", "TicketDependency", "Tombstone", "Travel", "Undo", "Update", "Video", "View"}
+	for _, disjoint := range disjointWith {
+		if disjoint == other.GetTypeName() {
+			return true
+		}
+	}
+	return false
+}
+
+// HashtagIsExtendedBy returns true if the other provided type extends from the
+// Hashtag type. Note that it returns false if the types are the same; see the
+// "IsOrExtendsHashtag" variant instead.
+func HashtagIsExtendedBy(other vocab.Type) bool {
+	// Shortcut implementation: is not extended by anything.
+	return false
+}
+
+// IsOrExtendsHashtag returns true if the other provided type is the Hashtag type
+// or extends from the Hashtag type.
+func IsOrExtendsHashtag(other vocab.Type) bool {
+	if other.GetTypeName() == "Hashtag" {
+		return true
+	}
+	return HashtagIsExtendedBy(other)
+}
+
+// NewActivityStreamsHashtag creates a new Hashtag type
+func NewActivityStreamsHashtag() *ActivityStreamsHashtag {
+	typeProp := typePropertyConstructor()
+	typeProp.AppendXMLSchemaString("Hashtag")
+	return &ActivityStreamsHashtag{
+		JSONLDType: typeProp,
+		alias:      "",
+		unknown:    make(map[string]interface{}),
+	}
+}
+
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
+// Clone returns a deep copy of this Hashtag and its properties, which can be
+// changed without changing this one.
+func (this ActivityStreamsHashtag) Clone() vocab.ActivityStreamsHashtag {
+	clone := this
+	if this.ActivityStreamsAttributedTo != nil {
+		clone.ActivityStreamsAttributedTo = this.ActivityStreamsAttributedTo.Clone()
+	}
+	if this.ActivityStreamsHeight != nil {
+		clone.ActivityStreamsHeight = this.ActivityStreamsHeight.Clone()
+	}
+	if this.ActivityStreamsHref != nil {
+		clone.ActivityStreamsHref = this.ActivityStreamsHref.Clone()
+	}
+	if this.ActivityStreamsHreflang != nil {
+		clone.ActivityStreamsHreflang = this.ActivityStreamsHreflang.Clone()
+	}
+	if this.JSONLDId != nil {
+		clone.JSONLDId = this.JSONLDId.Clone()
+	}
+	if this.ActivityStreamsMediaType != nil {
+		clone.ActivityStreamsMediaType = this.ActivityStreamsMediaType.Clone()
+	}
+	if this.ActivityStreamsName != nil {
+		clone.ActivityStreamsName = this.ActivityStreamsName.Clone()
+	}
+	if this.ActivityStreamsPreview != nil {
+		clone.ActivityStreamsPreview = this.ActivityStreamsPreview.Clone()
+	}
+	if this.ActivityStreamsRel != nil {
+		clone.ActivityStreamsRel = this.ActivityStreamsRel.Clone()
+	}
+	if this.ActivityStreamsSummary != nil {
+		clone.ActivityStreamsSummary = this.ActivityStreamsSummary.Clone()
+	}
+	if this.JSONLDType != nil {
+		clone.JSONLDType = this.JSONLDType.Clone()
+	}
+	if this.ActivityStreamsWidth != nil {
+		clone.ActivityStreamsWidth = this.ActivityStreamsWidth.Clone()
+	}
+	clone.unknown = cloneUnknown(this.unknown).(map[string]interface{})
+	return &clone
+}
+
+// Equals determines whether this Hashtag has the same properties as another,
+// comparing them deeply. The @context of deserialized values is ignored.
+func (this ActivityStreamsHashtag) Equals(o vocab.ActivityStreamsHashtag) bool {
+	if lhs, rhs := this.ActivityStreamsAttributedTo, o.GetActivityStreamsAttributedTo(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsHeight, o.GetActivityStreamsHeight(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsHref, o.GetActivityStreamsHref(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsHreflang, o.GetActivityStreamsHreflang(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsMediaType, o.GetActivityStreamsMediaType(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsName, o.GetActivityStreamsName(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsPreview, o.GetActivityStreamsPreview(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsRel, o.GetActivityStreamsRel(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsSummary, o.GetActivityStreamsSummary(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.ActivityStreamsWidth, o.GetActivityStreamsWidth(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	return equalsUnknown(this.unknown, o.GetUnknownProperties())
+}
+
+// GetActivityStreamsAttributedTo returns the "attributedTo" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsAttributedTo() vocab.ActivityStreamsAttributedToProperty {
+	return this.ActivityStreamsAttributedTo
+}
+
+// GetActivityStreamsHeight returns the "height" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsHeight() vocab.ActivityStreamsHeightProperty {
+	return this.ActivityStreamsHeight
+}
+
+// GetActivityStreamsHref returns the "href" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsHref() vocab.ActivityStreamsHrefProperty {
+	return this.ActivityStreamsHref
+}
+
+// GetActivityStreamsHreflang returns the "hreflang" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsHreflang() vocab.ActivityStreamsHreflangProperty {
+	return this.ActivityStreamsHreflang
+}
+
+// GetActivityStreamsMediaType returns the "mediaType" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty {
+	return this.ActivityStreamsMediaType
+}
+
+// GetActivityStreamsName returns the "name" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsName() vocab.ActivityStreamsNameProperty {
+	return this.ActivityStreamsName
+}
+
+// GetActivityStreamsPreview returns the "preview" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsPreview() vocab.ActivityStreamsPreviewProperty {
+	return this.ActivityStreamsPreview
+}
+
+// GetActivityStreamsRel returns the "rel" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsRel() vocab.ActivityStreamsRelProperty {
+	return this.ActivityStreamsRel
+}
+
+// GetActivityStreamsSummary returns the "summary" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsSummary() vocab.ActivityStreamsSummaryProperty {
+	return this.ActivityStreamsSummary
+}
+
+// GetActivityStreamsWidth returns the "width" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsWidth() vocab.ActivityStreamsWidthProperty {
+	return this.ActivityStreamsWidth
+}
+
+// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
+func (this ActivityStreamsHashtag) GetJSONLDId() vocab.JSONLDIdProperty {
+	return this.JSONLDId
+}
+
+// GetJSONLDType returns the "type" property if it exists, and nil otherwise.
+func (this ActivityStreamsHashtag) GetJSONLDType() vocab.JSONLDTypeProperty {
+	return this.JSONLDType
+}
+
+// GetTypeName returns the name of this type.
+func (this ActivityStreamsHashtag) GetTypeName() string {
+	return "Hashtag"
+}
+
+// GetUnknownProperties returns the unknown properties for the Hashtag type. Note
+// that this should not be used by app developers. It is only used to help
+// determine which implementation is LessThan the other. Developers who are
+// creating a different implementation of this type's interface can use this
+// method in their LessThan implementation, but routine ActivityPub
+// applications should not use this to bypass the code generation tool.
+func (this ActivityStreamsHashtag) GetUnknownProperties() map[string]interface{} {
+	return this.unknown
+}
+
+// IsExtending returns true if the Hashtag type extends from the other type.
+func (this ActivityStreamsHashtag) IsExtending(other vocab.Type) bool {
+	return ActivityStreamsHashtagExtends(other)
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// type and the specific properties that are set. The value in the map is the
+// alias used to import the type and its properties.
+func (this ActivityStreamsHashtag) JSONLDContext() map[string]string {
+	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
+	m = this.helperJSONLDContext(this.ActivityStreamsAttributedTo, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsHeight, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsHref, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsHreflang, m)
+	m = this.helperJSONLDContext(this.JSONLDId, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsMediaType, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsName, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsPreview, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsRel, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsSummary, m)
+	m = this.helperJSONLDContext(this.JSONLDType, m)
+	m = this.helperJSONLDContext(this.ActivityStreamsWidth, m)
+
+	return m
+}
+
+// LessThan computes if this Hashtag is lesser, with an arbitrary but stable
+// determination.
+func (this ActivityStreamsHashtag) LessThan(o vocab.ActivityStreamsHashtag) bool {
+	// Begin: Compare known properties
+	// Compare property "attributedTo"
+	if lhs, rhs := this.ActivityStreamsAttributedTo, o.GetActivityStreamsAttributedTo(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "height"
+	if lhs, rhs := this.ActivityStreamsHeight, o.GetActivityStreamsHeight(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "href"
+	if lhs, rhs := this.ActivityStreamsHref, o.GetActivityStreamsHref(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "hreflang"
+	if lhs, rhs := this.ActivityStreamsHreflang, o.GetActivityStreamsHreflang(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "id"
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "mediaType"
+	if lhs, rhs := this.ActivityStreamsMediaType, o.GetActivityStreamsMediaType(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "name"
+	if lhs, rhs := this.ActivityStreamsName, o.GetActivityStreamsName(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "preview"
+	if lhs, rhs := this.ActivityStreamsPreview, o.GetActivityStreamsPreview(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "rel"
+	if lhs, rhs := this.ActivityStreamsRel, o.GetActivityStreamsRel(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "summary"
+	if lhs, rhs := this.ActivityStreamsSummary, o.GetActivityStreamsSummary(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "type"
+	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "width"
+	if lhs, rhs := this.ActivityStreamsWidth, o.GetActivityStreamsWidth(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// End: Compare known properties
+
+	// Begin: Compare unknown properties (only by number of them)
+	if len(this.unknown) < len(o.GetUnknownProperties()) {
+		return true
+	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
+		return false
+	} // End: Compare unknown properties (only by number of them)
+
+	// All properties are the same.
+	return false
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format.
+func (this ActivityStreamsHashtag) Serialize() (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	typeName := "Hashtag"
+	if len(this.alias) > 0 {
+		typeName = this.alias + ":" + "Hashtag"
+	}
+	m["type"] = typeName
+	// Begin: Serialize known properties
+	// Maybe serialize property "attributedTo"
+	if this.ActivityStreamsAttributedTo != nil {
+		if i, err := this.ActivityStreamsAttributedTo.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsAttributedTo.Name()] = i
+		}
+	}
+	// Maybe serialize property "height"
+	if this.ActivityStreamsHeight != nil {
+		if i, err := this.ActivityStreamsHeight.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsHeight.Name()] = i
+		}
+	}
+	// Maybe serialize property "href"
+	if this.ActivityStreamsHref != nil {
+		if i, err := this.ActivityStreamsHref.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsHref.Name()] = i
+		}
+	}
+	// Maybe serialize property "hreflang"
+	if this.ActivityStreamsHreflang != nil {
+		if i, err := this.ActivityStreamsHreflang.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsHreflang.Name()] = i
+		}
+	}
+	// Maybe serialize property "id"
+	if this.JSONLDId != nil {
+		if i, err := this.JSONLDId.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.JSONLDId.Name()] = i
+		}
+	}
+	// Maybe serialize property "mediaType"
+	if this.ActivityStreamsMediaType != nil {
+		if i, err := this.ActivityStreamsMediaType.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsMediaType.Name()] = i
+		}
+	}
+	// Maybe serialize property "name"
+	if this.ActivityStreamsName != nil {
+		if i, err := this.ActivityStreamsName.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsName.Name()] = i
+		}
+	}
+	// Maybe serialize property "preview"
+	if this.ActivityStreamsPreview != nil {
+		if i, err := this.ActivityStreamsPreview.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsPreview.Name()] = i
+		}
+	}
+	// Maybe serialize property "rel"
+	if this.ActivityStreamsRel != nil {
+		if i, err := this.ActivityStreamsRel.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsRel.Name()] = i
+		}
+	}
+	// Maybe serialize property "summary"
+	if this.ActivityStreamsSummary != nil {
+		if i, err := this.ActivityStreamsSummary.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsSummary.Name()] = i
+		}
+	}
+	// Maybe serialize property "type"
+	if this.JSONLDType != nil {
+		if i, err := this.JSONLDType.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.JSONLDType.Name()] = i
+		}
+	}
+	// Maybe serialize property "width"
+	if this.ActivityStreamsWidth != nil {
+		if i, err := this.ActivityStreamsWidth.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsWidth.Name()] = i
+		}
+	}
+	// End: Serialize known properties
+
+	// Begin: Serialize unknown properties
+	for k, v := range this.unknown {
+		// To be safe, ensure we aren't overwriting a known property
+		if _, has := m[k]; !has {
+			m[k] = v
+		}
+	}
+	// End: Serialize unknown properties
+
+	return m, nil
+}
+
+// SetActivityStreamsAttributedTo sets the "attributedTo" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsAttributedTo(i vocab.ActivityStreamsAttributedToProperty) {
+	this.ActivityStreamsAttributedTo = i
+}
+
+// SetActivityStreamsHeight sets the "height" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsHeight(i vocab.ActivityStreamsHeightProperty) {
+	this.ActivityStreamsHeight = i
+}
+
+// SetActivityStreamsHref sets the "href" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsHref(i vocab.ActivityStreamsHrefProperty) {
+	this.ActivityStreamsHref = i
+}
+
+// SetActivityStreamsHreflang sets the "hreflang" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsHreflang(i vocab.ActivityStreamsHreflangProperty) {
+	this.ActivityStreamsHreflang = i
+}
+
+// SetActivityStreamsMediaType sets the "mediaType" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsMediaType(i vocab.ActivityStreamsMediaTypeProperty) {
+	this.ActivityStreamsMediaType = i
+}
+
+// SetActivityStreamsName sets the "name" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsName(i vocab.ActivityStreamsNameProperty) {
+	this.ActivityStreamsName = i
+}
+
+// SetActivityStreamsPreview sets the "preview" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsPreview(i vocab.ActivityStreamsPreviewProperty) {
+	this.ActivityStreamsPreview = i
+}
+
+// SetActivityStreamsRel sets the "rel" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsRel(i vocab.ActivityStreamsRelProperty) {
+	this.ActivityStreamsRel = i
+}
+
+// SetActivityStreamsSummary sets the "summary" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsSummary(i vocab.ActivityStreamsSummaryProperty) {
+	this.ActivityStreamsSummary = i
+}
+
+// SetActivityStreamsWidth sets the "width" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsWidth(i vocab.ActivityStreamsWidthProperty) {
+	this.ActivityStreamsWidth = i
+}
+
+// SetJSONLDId sets the "id" property.
+func (this *ActivityStreamsHashtag) SetJSONLDId(i vocab.JSONLDIdProperty) {
+	this.JSONLDId = i
+}
+
+// SetJSONLDType sets the "type" property.
+func (this *ActivityStreamsHashtag) SetJSONLDType(i vocab.JSONLDTypeProperty) {
+	this.JSONLDType = i
+}
+
+// VocabularyURI returns the vocabulary's URI as a string.
+func (this ActivityStreamsHashtag) VocabularyURI() string {
+	return "https://www.w3.org/ns/activitystreams"
+}
+
+// helperJSONLDContext obtains the context uris and their aliases from a property,
+// if it is not nil.
+func (this ActivityStreamsHashtag) helperJSONLDContext(i jsonldContexter, toMerge map[string]string) map[string]string {
+	if i == nil {
+		return toMerge
+	}
+	for k, v := range i.JSONLDContext() {
+		/*
+		   Since the literal maps in this function are determined at
+		   code-generation time, this loop should not overwrite an existing key with a
+		   new value.
+		*/
+		toMerge[k] = v
+	}
+	return toMerge
+}
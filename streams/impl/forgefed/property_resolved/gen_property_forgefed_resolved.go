@@ -0,0 +1,293 @@
+// Code generated by astool. DO NOT EDIT.
+
+package propertyresolved
+
+import (
+	"fmt"
+	datetime "github.com/go-fed/activity/streams/values/dateTime"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"reflect"
+	"time"
+)
+
+// ForgeFedResolvedProperty is the functional property "resolved". It is permitted
+// to be a single default-valued value type.
+type ForgeFedResolvedProperty struct {
+	xmlschemaDateTimeMember time.Time
+	hasDateTimeMember       bool
+	unknown                 interface{}
+	iri                     *url.URL
+	alias                   string
+}
+
+// DeserializeResolvedProperty creates a "resolved" property from an interface
+// representation that has been unmarshalled from a text or binary format.
+func DeserializeResolvedProperty(m map[string]interface{}, aliasMap map[string]string) (*ForgeFedResolvedProperty, error) {
+	alias := ""
+	if a, ok := aliasMap["https://forgefed.org/ns"]; ok {
+		alias = a
+	}
+	propName := "resolved"
+	if len(alias) > 0 {
+		// Use alias both to find the property, and set within the property.
+		propName = fmt.Sprintf("%s:%s", alias, "resolved")
+	}
+	i, ok := m[propName]
+
+	if ok {
+		if s, ok := i.(string); ok {
+			u, err := url.Parse(s)
+			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
+			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
+			if err == nil && len(u.Scheme) > 0 {
+				this := &ForgeFedResolvedProperty{
+					alias: alias,
+					iri:   u,
+				}
+				return this, nil
+			}
+		}
+		if v, err := datetime.DeserializeDateTime(i); err == nil {
+			this := &ForgeFedResolvedProperty{
+				alias:                   alias,
+				hasDateTimeMember:       true,
+				xmlschemaDateTimeMember: v,
+			}
+			return this, nil
+		}
+		this := &ForgeFedResolvedProperty{
+			alias:   alias,
+			unknown: i,
+		}
+		return this, nil
+	}
+	return nil, nil
+}
+
+// NewForgeFedResolvedProperty creates a new resolved property.
+func NewForgeFedResolvedProperty() *ForgeFedResolvedProperty {
+	return &ForgeFedResolvedProperty{alias: ""}
+}
+
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
+// Clear ensures no value of this property is set. Calling IsXMLSchemaDateTime
+// afterwards will return false.
+func (this *ForgeFedResolvedProperty) Clear() {
+	this.unknown = nil
+	this.iri = nil
+	this.hasDateTimeMember = false
+}
+
+// Clone returns a deep copy of this property, which can be changed without
+// changing this one.
+func (this ForgeFedResolvedProperty) Clone() vocab.ForgeFedResolvedProperty {
+	clone := this
+	if this.iri != nil {
+		iri := *this.iri
+		clone.iri = &iri
+	}
+	clone.unknown = cloneUnknown(this.unknown)
+	return &clone
+}
+
+// Equals determines whether this property has the same value as another,
+// comparing any types it holds deeply.
+func (this ForgeFedResolvedProperty) Equals(o vocab.ForgeFedResolvedProperty) bool {
+	if this.IsXMLSchemaDateTime() && o.IsXMLSchemaDateTime() {
+		return !datetime.LessDateTime(this.Get(), o.Get()) && !datetime.LessDateTime(o.Get(), this.Get())
+	} else if this.IsXMLSchemaDateTime() || o.IsXMLSchemaDateTime() {
+		return false
+	} else if this.IsIRI() && o.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	} else if this.IsIRI() || o.IsIRI() {
+		return false
+	}
+	// Both are unknown values or neither is set.
+	lhs, _ := this.Serialize()
+	rhs, _ := o.Serialize()
+	return equalsUnknown(lhs, rhs)
+}
+
+// Get returns the value of this property. When IsXMLSchemaDateTime returns false,
+// Get will return any arbitrary value.
+func (this ForgeFedResolvedProperty) Get() time.Time {
+	return this.xmlschemaDateTimeMember
+}
+
+// GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
+// return any arbitrary value.
+func (this ForgeFedResolvedProperty) GetIRI() *url.URL {
+	return this.iri
+}
+
+// HasAny returns true if the value or IRI is set.
+func (this ForgeFedResolvedProperty) HasAny() bool {
+	return this.IsXMLSchemaDateTime() || this.iri != nil
+}
+
+// IsIRI returns true if this property is an IRI.
+func (this ForgeFedResolvedProperty) IsIRI() bool {
+	return this.iri != nil
+}
+
+// IsXMLSchemaDateTime returns true if this property is set and not an IRI.
+func (this ForgeFedResolvedProperty) IsXMLSchemaDateTime() bool {
+	return this.hasDateTimeMember
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this ForgeFedResolvedProperty) JSONLDContext() map[string]string {
+	m := map[string]string{"https://forgefed.org/ns": this.alias}
+	var child map[string]string
+
+	/*
+	   Since the literal maps in this function are determined at
+	   code-generation time, this loop should not overwrite an existing key with a
+	   new value.
+	*/
+	for k, v := range child {
+		m[k] = v
+	}
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API detail only for folks looking to replace the go-fed
+// implementation. Applications should not use this method.
+func (this ForgeFedResolvedProperty) KindIndex() int {
+	if this.IsXMLSchemaDateTime() {
+		return 0
+	}
+	if this.IsIRI() {
+		return -2
+	}
+	return -1
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this ForgeFedResolvedProperty) LessThan(o vocab.ForgeFedResolvedProperty) bool {
+	// LessThan comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return this.iri.String() < o.GetIRI().String()
+	} else if this.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return true
+	} else if o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return false
+	}
+	// LessThan comparison for the single value or unknown value.
+	if !this.IsXMLSchemaDateTime() && !o.IsXMLSchemaDateTime() {
+		// Both are unknowns.
+		return false
+	} else if this.IsXMLSchemaDateTime() && !o.IsXMLSchemaDateTime() {
+		// Values are always greater than unknown values.
+		return false
+	} else if !this.IsXMLSchemaDateTime() && o.IsXMLSchemaDateTime() {
+		// Unknowns are always less than known values.
+		return true
+	} else {
+		// Actual comparison.
+		return datetime.LessDateTime(this.Get(), o.Get())
+	}
+}
+
+// Name returns the name of this property: "resolved".
+func (this ForgeFedResolvedProperty) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":" + "resolved"
+	} else {
+		return "resolved"
+	}
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this ForgeFedResolvedProperty) Serialize() (interface{}, error) {
+	if this.IsXMLSchemaDateTime() {
+		return datetime.SerializeDateTime(this.Get())
+	} else if this.IsIRI() {
+		return this.iri.String(), nil
+	}
+	return this.unknown, nil
+}
+
+// Set sets the value of this property. Calling IsXMLSchemaDateTime afterwards
+// will return true.
+func (this *ForgeFedResolvedProperty) Set(v time.Time) {
+	this.Clear()
+	this.xmlschemaDateTimeMember = v
+	this.hasDateTimeMember = true
+}
+
+// SetIRI sets the value of this property. Calling IsIRI afterwards will return
+// true.
+func (this *ForgeFedResolvedProperty) SetIRI(v *url.URL) {
+	this.Clear()
+	this.iri = v
+}
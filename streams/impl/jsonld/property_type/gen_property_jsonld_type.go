@@ -8,6 +8,7 @@ import (
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"reflect"
 )
 
 // JSONLDTypePropertyIterator is an iterator for a property. It is permitted to be
@@ -30,6 +31,26 @@ func NewJSONLDTypePropertyIterator() *JSONLDTypePropertyIterator {
 	return &JSONLDTypePropertyIterator{alias: ""}
 }
 
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
 // deserializeJSONLDTypePropertyIterator creates an iterator from an element that
 // has been unmarshalled from a text or binary format.
 func deserializeJSONLDTypePropertyIterator(i interface{}, aliasMap map[string]string) (*JSONLDTypePropertyIterator, error) {
@@ -56,6 +77,63 @@ func deserializeJSONLDTypePropertyIterator(i interface{}, aliasMap map[string]st
 	return this, nil
 }
 
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
+// Equals determines whether this property has the same value as another,
+// comparing any types it holds deeply.
+func (this JSONLDTypePropertyIterator) Equals(o vocab.JSONLDTypePropertyIterator) bool {
+	if this.IsXMLSchemaAnyURI() && o.IsXMLSchemaAnyURI() {
+		return !anyuri.LessAnyURI(this.GetXMLSchemaAnyURI(), o.GetXMLSchemaAnyURI()) && !anyuri.LessAnyURI(o.GetXMLSchemaAnyURI(), this.GetXMLSchemaAnyURI())
+	} else if this.IsXMLSchemaAnyURI() || o.IsXMLSchemaAnyURI() {
+		return false
+	} else if this.IsXMLSchemaString() && o.IsXMLSchemaString() {
+		return !string1.LessString(this.GetXMLSchemaString(), o.GetXMLSchemaString()) && !string1.LessString(o.GetXMLSchemaString(), this.GetXMLSchemaString())
+	} else if this.IsXMLSchemaString() || o.IsXMLSchemaString() {
+		return false
+	}
+	// Both are unknown values or neither is set.
+	if other, ok := o.(*JSONLDTypePropertyIterator); ok {
+		return equalsUnknown(this.unknown, other.unknown)
+	}
+	return this.unknown == nil
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
 func (this JSONLDTypePropertyIterator) GetIRI() *url.URL {
@@ -209,6 +287,18 @@ func (this *JSONLDTypePropertyIterator) clear() {
 	this.unknown = nil
 }
 
+// clone returns a deep copy of this iterator belonging to the parent property.
+func (this JSONLDTypePropertyIterator) clone(parent vocab.JSONLDTypeProperty) *JSONLDTypePropertyIterator {
+	clone := this
+	if this.xmlschemaAnyURIMember != nil {
+		u := *this.xmlschemaAnyURIMember
+		clone.xmlschemaAnyURIMember = &u
+	}
+	clone.unknown = cloneUnknown(this.unknown)
+	clone.parent = parent
+	return &clone
+}
+
 // serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -325,6 +415,19 @@ func (this JSONLDTypeProperty) Begin() vocab.JSONLDTypePropertyIterator {
 	}
 }
 
+// Clone returns a deep copy of this property and its values, which can be changed
+// without changing this one.
+func (this JSONLDTypeProperty) Clone() vocab.JSONLDTypeProperty {
+	clone := &JSONLDTypeProperty{
+		alias:      this.alias,
+		properties: make([]*JSONLDTypePropertyIterator, 0, len(this.properties)),
+	}
+	for _, elem := range this.properties {
+		clone.properties = append(clone.properties, elem.clone(clone))
+	}
+	return clone
+}
+
 // Empty returns returns true if there are no elements.
 func (this JSONLDTypeProperty) Empty() bool {
 	return this.Len() == 0
@@ -337,6 +440,20 @@ func (this JSONLDTypeProperty) End() vocab.JSONLDTypePropertyIterator {
 	return nil
 }
 
+// Equals determines whether this property has the same values as another in the
+// same order, comparing any types they hold deeply.
+func (this JSONLDTypeProperty) Equals(o vocab.JSONLDTypeProperty) bool {
+	if this.Len() != o.Len() {
+		return false
+	}
+	for i, elem := range this.properties {
+		if !elem.Equals(o.At(i)) {
+			return false
+		}
+	}
+	return true
+}
+
 // Insert inserts an IRI value at the specified index for a property "type".
 // Existing elements at that index and higher are shifted back once.
 // Invalidates all iterators.
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"reflect"
 )
 
 // W3IDSecurityV1PublicKeyPropertyIterator is an iterator for a property. It is
@@ -25,6 +26,26 @@ func NewW3IDSecurityV1PublicKeyPropertyIterator() *W3IDSecurityV1PublicKeyProper
 	return &W3IDSecurityV1PublicKeyPropertyIterator{alias: ""}
 }
 
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
 // deserializeW3IDSecurityV1PublicKeyPropertyIterator creates an iterator from an
 // element that has been unmarshalled from a text or binary format.
 func deserializeW3IDSecurityV1PublicKeyPropertyIterator(i interface{}, aliasMap map[string]string) (*W3IDSecurityV1PublicKeyPropertyIterator, error) {
@@ -60,6 +81,63 @@ func deserializeW3IDSecurityV1PublicKeyPropertyIterator(i interface{}, aliasMap
 	return this, nil
 }
 
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
+// Equals determines whether this property has the same value as another,
+// comparing any types it holds deeply.
+func (this W3IDSecurityV1PublicKeyPropertyIterator) Equals(o vocab.W3IDSecurityV1PublicKeyPropertyIterator) bool {
+	if this.IsW3IDSecurityV1PublicKey() && o.IsW3IDSecurityV1PublicKey() {
+		return this.Get().Equals(o.Get())
+	} else if this.IsW3IDSecurityV1PublicKey() || o.IsW3IDSecurityV1PublicKey() {
+		return false
+	} else if this.IsIRI() && o.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	} else if this.IsIRI() || o.IsIRI() {
+		return false
+	}
+	// Both are unknown values or neither is set.
+	if other, ok := o.(*W3IDSecurityV1PublicKeyPropertyIterator); ok {
+		return equalsUnknown(this.unknown, other.unknown)
+	}
+	return this.unknown == nil
+}
+
 // Get returns the value of this property. When IsW3IDSecurityV1PublicKey returns
 // false, Get will return any arbitrary value.
 func (this W3IDSecurityV1PublicKeyPropertyIterator) Get() vocab.W3IDSecurityV1PublicKey {
@@ -221,6 +299,21 @@ func (this *W3IDSecurityV1PublicKeyPropertyIterator) clear() {
 	this.w3idsecurityv1PublicKeyMember = nil
 }
 
+// clone returns a deep copy of this iterator belonging to the parent property.
+func (this W3IDSecurityV1PublicKeyPropertyIterator) clone(parent vocab.W3IDSecurityV1PublicKeyProperty) *W3IDSecurityV1PublicKeyPropertyIterator {
+	clone := this
+	if this.w3idsecurityv1PublicKeyMember != nil {
+		clone.w3idsecurityv1PublicKeyMember = this.w3idsecurityv1PublicKeyMember.Clone()
+	}
+	if this.iri != nil {
+		iri := *this.iri
+		clone.iri = &iri
+	}
+	clone.unknown = cloneUnknown(this.unknown)
+	clone.parent = parent
+	return &clone
+}
+
 // serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -344,6 +437,19 @@ func (this W3IDSecurityV1PublicKeyProperty) Begin() vocab.W3IDSecurityV1PublicKe
 	}
 }
 
+// Clone returns a deep copy of this property and its values, which can be changed
+// without changing this one.
+func (this W3IDSecurityV1PublicKeyProperty) Clone() vocab.W3IDSecurityV1PublicKeyProperty {
+	clone := &W3IDSecurityV1PublicKeyProperty{
+		alias:      this.alias,
+		properties: make([]*W3IDSecurityV1PublicKeyPropertyIterator, 0, len(this.properties)),
+	}
+	for _, elem := range this.properties {
+		clone.properties = append(clone.properties, elem.clone(clone))
+	}
+	return clone
+}
+
 // Empty returns returns true if there are no elements.
 func (this W3IDSecurityV1PublicKeyProperty) Empty() bool {
 	return this.Len() == 0
@@ -356,6 +462,20 @@ func (this W3IDSecurityV1PublicKeyProperty) End() vocab.W3IDSecurityV1PublicKeyP
 	return nil
 }
 
+// Equals determines whether this property has the same values as another in the
+// same order, comparing any types they hold deeply.
+func (this W3IDSecurityV1PublicKeyProperty) Equals(o vocab.W3IDSecurityV1PublicKeyProperty) bool {
+	if this.Len() != o.Len() {
+		return false
+	}
+	for i, elem := range this.properties {
+		if !elem.Equals(o.At(i)) {
+			return false
+		}
+	}
+	return true
+}
+
 // Insert inserts an IRI value at the specified index for a property "publicKey".
 // Existing elements at that index and higher are shifted back once.
 // Invalidates all iterators.
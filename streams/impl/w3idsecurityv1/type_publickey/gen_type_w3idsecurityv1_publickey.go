@@ -2,7 +2,10 @@
 
 package typepublickey
 
-import vocab "github.com/go-fed/activity/streams/vocab"
+import (
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"reflect"
+)
 
 // A public key represents a public cryptographical key for a user
 type W3IDSecurityV1PublicKey struct {
@@ -100,6 +103,108 @@ func W3IDSecurityV1PublicKeyExtends(other vocab.Type) bool {
 	return false
 }
 
+// cloneUnknown deeply copies an unknown JSON value.
+func cloneUnknown(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = cloneUnknown(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for idx, elem := range v {
+			arr[idx] = cloneUnknown(elem)
+		}
+		return arr
+	default:
+		return i
+	}
+}
+
+// equalsUnknown determines whether two unknown JSON values are equal, ignoring
+// the @context of objects.
+func equalsUnknown(lhs, rhs interface{}) bool {
+	switch l := lhs.(type) {
+	case map[string]interface{}:
+		r, ok := rhs.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range l {
+			if k == "@context" {
+				continue
+			} else if rv, ok := r[k]; !ok || !equalsUnknown(v, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, ok := l[k]; !ok && k != "@context" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := rhs.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for idx, v := range l {
+			if !equalsUnknown(v, r[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(lhs, rhs)
+	}
+}
+
+// Clone returns a deep copy of this PublicKey and its properties, which can be
+// changed without changing this one.
+func (this W3IDSecurityV1PublicKey) Clone() vocab.W3IDSecurityV1PublicKey {
+	clone := this
+	if this.JSONLDId != nil {
+		clone.JSONLDId = this.JSONLDId.Clone()
+	}
+	if this.W3IDSecurityV1Owner != nil {
+		clone.W3IDSecurityV1Owner = this.W3IDSecurityV1Owner.Clone()
+	}
+	if this.W3IDSecurityV1PublicKeyPem != nil {
+		clone.W3IDSecurityV1PublicKeyPem = this.W3IDSecurityV1PublicKeyPem.Clone()
+	}
+	clone.unknown = cloneUnknown(this.unknown).(map[string]interface{})
+	return &clone
+}
+
+// Equals determines whether this PublicKey has the same properties as another,
+// comparing them deeply. The @context of deserialized values is ignored.
+func (this W3IDSecurityV1PublicKey) Equals(o vocab.W3IDSecurityV1PublicKey) bool {
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.W3IDSecurityV1Owner, o.GetW3IDSecurityV1Owner(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	if lhs, rhs := this.W3IDSecurityV1PublicKeyPem, o.GetW3IDSecurityV1PublicKeyPem(); lhs != nil && rhs != nil {
+		if !lhs.Equals(rhs) {
+			return false
+		}
+	} else if lhs != nil || rhs != nil {
+		return false
+	}
+	return equalsUnknown(this.unknown, o.GetUnknownProperties())
+}
+
 // GetJSONLDId returns the "id" property if it exists, and nil otherwise.
 func (this W3IDSecurityV1PublicKey) GetJSONLDId() vocab.JSONLDIdProperty {
 	return this.JSONLDId
@@ -6,6 +6,7 @@ import (
 	"github.com/go-fed/activity/streams/vocab"
 	"github.com/go-test/deep"
 	"net/url"
+	"reflect"
 	"sort"
 	"testing"
 )
@@ -522,3 +523,857 @@ func GetJSONDiff(str1, str2 []byte) ([]string, error) {
 	}
 	return deep.Equal(i1, i2), nil
 }
+
+// TestMastodonExtensions tests resolving the extension terms used by Mastodon
+// to typed properties instead of unknown ones.
+func TestMastodonExtensions(t *testing.T) {
+	const mastodonContext = `"@context": [
+    "https://www.w3.org/ns/activitystreams",
+    "https://w3id.org/security/v1",
+    {
+      "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
+      "toot": "http://joinmastodon.org/ns#",
+      "featured": {"@id": "toot:featured", "@type": "@id"},
+      "featuredTags": {"@id": "toot:featuredTags", "@type": "@id"},
+      "alsoKnownAs": {"@id": "as:alsoKnownAs", "@type": "@id"},
+      "movedTo": {"@id": "as:movedTo", "@type": "@id"},
+      "schema": "http://schema.org#",
+      "PropertyValue": "schema:PropertyValue",
+      "value": "schema:value",
+      "discoverable": "toot:discoverable",
+      "Hashtag": "as:Hashtag",
+      "Emoji": "toot:Emoji",
+      "sensitive": "as:sensitive",
+      "focalPoint": {"@container": "@list", "@id": "toot:focalPoint"},
+      "blurhash": "toot:blurhash",
+      "votersCount": "toot:votersCount"
+    }
+  ]`
+	actor := `{
+  ` + mastodonContext + `,
+  "id": "https://example.com/users/sally",
+  "type": "Person",
+  "featured": "https://example.com/users/sally/collections/featured",
+  "featuredTags": "https://example.com/users/sally/collections/tags",
+  "manuallyApprovesFollowers": true,
+  "discoverable": false,
+  "alsoKnownAs": ["https://old.example.com/users/sally"],
+  "movedTo": "https://new.example.com/users/sally",
+  "attachment": [{"type": "PropertyValue", "name": "Website", "value": "example.com"}],
+  "tag": [
+    {"type": "Emoji", "id": "https://example.com/emojis/1", "name": ":blob:"},
+    {"type": "Hashtag", "href": "https://example.com/tags/cats", "name": "#cats"}
+  ]
+}`
+	note := `{
+  ` + mastodonContext + `,
+  "id": "https://example.com/users/sally/statuses/1",
+  "type": "Note",
+  "sensitive": true,
+  "attachment": [{"type": "Document", "mediaType": "image/png", "url": "https://example.com/1.png", "blurhash": "UBL_:rOpGG-oBUNG,qRj2so|=eE1w^n4S5NH", "focalPoint": [0.5, -0.25]}]
+}`
+	question := `{
+  ` + mastodonContext + `,
+  "id": "https://example.com/users/sally/statuses/2",
+  "type": "Question",
+  "votersCount": 7
+}`
+	toType := func(s string) vocab.Type {
+		var m map[string]interface{}
+		if err := json.Unmarshal([]byte(s), &m); err != nil {
+			t.Fatal(err)
+		}
+		v, err := ToType(context.Background(), m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	t.Run("Actor", func(t *testing.T) {
+		p, ok := toType(actor).(vocab.ActivityStreamsPerson)
+		if !ok {
+			t.Fatal("actor is not a Person")
+		}
+		for k := range p.GetUnknownProperties() {
+			if k != jsonLDContext {
+				t.Errorf("unexpected unknown property: %s", k)
+			}
+		}
+		if p.GetTootFeatured().GetIRI().String() != "https://example.com/users/sally/collections/featured" {
+			t.Errorf("unexpected featured: %v", p.GetTootFeatured().GetIRI())
+		}
+		if p.GetTootFeaturedTags().GetIRI().String() != "https://example.com/users/sally/collections/tags" {
+			t.Errorf("unexpected featuredTags: %v", p.GetTootFeaturedTags().GetIRI())
+		}
+		if !p.GetActivityStreamsManuallyApprovesFollowers().Get() {
+			t.Error("expected manuallyApprovesFollowers")
+		}
+		if p.GetTootDiscoverable().Get() {
+			t.Error("expected not discoverable")
+		}
+		if p.GetActivityStreamsAlsoKnownAs().At(0).Get().String() != "https://old.example.com/users/sally" {
+			t.Errorf("unexpected alsoKnownAs: %v", p.GetActivityStreamsAlsoKnownAs().At(0).Get())
+		}
+		if p.GetActivityStreamsMovedTo().Get().String() != "https://new.example.com/users/sally" {
+			t.Errorf("unexpected movedTo: %v", p.GetActivityStreamsMovedTo().Get())
+		}
+		pv := p.GetActivityStreamsAttachment().At(0).GetSchemaPropertyValue()
+		if pv == nil || pv.GetSchemaValue().Get() != "example.com" {
+			t.Errorf("unexpected attachment: %v", p.GetActivityStreamsAttachment().At(0).GetType())
+		}
+		if !p.GetActivityStreamsTag().At(0).IsTootEmoji() {
+			t.Errorf("unexpected tag: %v", p.GetActivityStreamsTag().At(0).GetType())
+		}
+		if !p.GetActivityStreamsTag().At(1).IsActivityStreamsHashtag() {
+			t.Errorf("unexpected tag: %v", p.GetActivityStreamsTag().At(1).GetType())
+		}
+	})
+	t.Run("Note", func(t *testing.T) {
+		n, ok := toType(note).(vocab.ActivityStreamsNote)
+		if !ok {
+			t.Fatal("note is not a Note")
+		}
+		if !n.GetActivityStreamsSensitive().Get() {
+			t.Error("expected sensitive")
+		}
+		d := n.GetActivityStreamsAttachment().At(0).GetActivityStreamsDocument()
+		if d == nil {
+			t.Fatalf("unexpected attachment: %v", n.GetActivityStreamsAttachment().At(0).GetType())
+		}
+		if len(d.GetUnknownProperties()) != 0 {
+			t.Errorf("unexpected unknown properties: %v", d.GetUnknownProperties())
+		}
+		if d.GetTootBlurhash().Get() != "UBL_:rOpGG-oBUNG,qRj2so|=eE1w^n4S5NH" {
+			t.Errorf("unexpected blurhash: %v", d.GetTootBlurhash().Get())
+		}
+		fp := d.GetTootFocalPoint()
+		if fp.Len() != 2 || fp.At(0).Get() != 0.5 || fp.At(1).Get() != -0.25 {
+			t.Errorf("unexpected focalPoint")
+		}
+	})
+	t.Run("Question", func(t *testing.T) {
+		q, ok := toType(question).(vocab.ActivityStreamsQuestion)
+		if !ok {
+			t.Fatal("question is not a Question")
+		}
+		if q.GetTootVotersCount().Get() != 7 {
+			t.Errorf("unexpected votersCount: %d", q.GetTootVotersCount().Get())
+		}
+	})
+	t.Run("RoundTrip", func(t *testing.T) {
+		m, err := Serialize(toType(note))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m["sensitive"] != true {
+			t.Errorf("unexpected sensitive: %v", m["sensitive"])
+		}
+		attachment := m["attachment"].(map[string]interface{})
+		if attachment["blurhash"] == nil || attachment["focalPoint"] == nil {
+			t.Errorf("unexpected attachment: %v", attachment)
+		}
+	})
+}
+
+// TestForgeFed tests resolving and serializing ForgeFed values.
+func TestForgeFed(t *testing.T) {
+	const push = `{
+  "@context": ["https://www.w3.org/ns/activitystreams", "https://forgefed.org/ns"],
+  "id": "https://dev.example/aviva/outbox/reBGo",
+  "type": "Push",
+  "actor": "https://dev.example/aviva",
+  "target": "https://dev.example/aviva/myproject",
+  "ref": "refs/heads/master",
+  "hashBefore": "017cbb00ea2d4d1a2c4b2fad3c0d8ed97e3d5d7b",
+  "hashAfter": "be9f48a341c4bb5cd79ae7ab85fbf0c05d2837bb",
+  "object": {
+    "type": "OrderedCollection",
+    "totalItems": 1,
+    "orderedItems": [{
+      "type": "Commit",
+      "id": "https://dev.example/aviva/myproject/commits/be9f48a341c4bb5cd79ae7ab85fbf0c05d2837bb",
+      "hash": "be9f48a341c4bb5cd79ae7ab85fbf0c05d2837bb",
+      "committed": "2019-07-26T23:45:01Z",
+      "filesAdded": ["README.md"],
+      "description": {"type": "Object", "mediaType": "text/plain", "content": "Add README"}
+    }]
+  }
+}`
+	const ticket = `{
+  "@context": ["https://www.w3.org/ns/activitystreams", "https://forgefed.org/ns"],
+  "id": "https://dev.example/aviva/game-of-life/issues/107",
+  "type": "Ticket",
+  "attributedTo": "https://forge.example/luke",
+  "assignedTo": "https://dev.example/aviva",
+  "isResolved": true,
+  "dependsOn": "https://dev.example/aviva/game-of-life/issues/107/dependencies"
+}`
+	var received []vocab.Type
+	res, err := NewJSONResolver(func(c context.Context, p vocab.ForgeFedPush) error {
+		received = append(received, p)
+		return nil
+	}, func(c context.Context, p vocab.ForgeFedTicket) error {
+		received = append(received, p)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{push, ticket} {
+		var m map[string]interface{}
+		if err = json.Unmarshal([]byte(s), &m); err != nil {
+			t.Fatal(err)
+		}
+		if err = res.Resolve(context.Background(), m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(received) != 2 {
+		t.Fatalf("expected 2 resolved values, got %d", len(received))
+	}
+	p := received[0].(vocab.ForgeFedPush)
+	if !IsOrExtendsActivityStreamsActivity(p) {
+		t.Error("expected Push to extend Activity")
+	}
+	if p.GetForgeFedRef().Get() != "refs/heads/master" {
+		t.Errorf("unexpected ref: %v", p.GetForgeFedRef().Get())
+	}
+	if p.GetForgeFedHashAfter().Get() != "be9f48a341c4bb5cd79ae7ab85fbf0c05d2837bb" {
+		t.Errorf("unexpected hashAfter: %v", p.GetForgeFedHashAfter().Get())
+	}
+	commits := p.GetActivityStreamsObject().At(0).GetActivityStreamsOrderedCollection()
+	commit := commits.GetActivityStreamsOrderedItems().At(0).GetForgeFedCommit()
+	if commit == nil {
+		t.Fatal("expected a Commit")
+	}
+	if commit.GetForgeFedFilesAdded().At(0).Get() != "README.md" {
+		t.Errorf("unexpected filesAdded: %v", commit.GetForgeFedFilesAdded().At(0).Get())
+	}
+	if commit.GetForgeFedDescription().GetActivityStreamsObject() == nil {
+		t.Error("expected a description")
+	}
+	tk := received[1].(vocab.ForgeFedTicket)
+	if !tk.GetForgeFedIsResolved().Get() {
+		t.Error("expected isResolved")
+	}
+	if tk.GetForgeFedAssignedTo().GetIRI().String() != "https://dev.example/aviva" {
+		t.Errorf("unexpected assignedTo: %v", tk.GetForgeFedAssignedTo().GetIRI())
+	}
+	m, err := Serialize(tk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m["isResolved"] != true || m["dependsOn"] != "https://dev.example/aviva/game-of-life/issues/107/dependencies" {
+		t.Errorf("unexpected serialization: %v", m)
+	}
+}
+
+// testWidget is an application's type of an extension vocabulary.
+type testWidget struct {
+	id    vocab.JSONLDIdProperty
+	color string
+}
+
+func (w *testWidget) GetJSONLDId() vocab.JSONLDIdProperty  { return w.id }
+func (w *testWidget) GetTypeName() string                  { return "Widget" }
+func (w *testWidget) SetJSONLDId(i vocab.JSONLDIdProperty) { w.id = i }
+func (w *testWidget) VocabularyURI() string                { return "https://example.com/ns" }
+func (w *testWidget) JSONLDContext() map[string]string {
+	return map[string]string{w.VocabularyURI(): "ex"}
+}
+func (w *testWidget) Serialize() (map[string]interface{}, error) {
+	m := map[string]interface{}{"type": "ex:Widget", "ex:color": w.color}
+	if w.id != nil {
+		id, err := w.id.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		m["id"] = id
+	}
+	return m, nil
+}
+
+// testWidgetExtension is the extension vocabulary of testWidget.
+var testWidgetExtension = Extension{
+	VocabularyURI: "https://example.com/ns",
+	Alias:         "ex",
+	Types: map[string]ExtensionTypeDeserializer{
+		"Widget": func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			w := &testWidget{}
+			w.color, _ = m[aliasPrefix(extensionAlias(aliasMap, "https://example.com/ns"))+"color"].(string)
+			return w, nil
+		},
+	},
+	Properties: []string{"color", "gadget"},
+}
+
+// TestExtension tests resolving and serializing the types and properties of an
+// extension vocabulary registered at runtime.
+func TestExtension(t *testing.T) {
+	ctx := context.Background()
+	widget := `{
+  "@context": ["https://www.w3.org/ns/activitystreams", {"ex": "https://example.com/ns"}],
+  "type": "ex:Widget",
+  "ex:color": "red"
+}`
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(widget), &m); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ToType(ctx, m); err != ErrUnhandledType {
+		t.Fatalf("expected unregistered type to be unhandled, got %v", err)
+	}
+	if err := RegisterExtension(testWidgetExtension); err != nil {
+		t.Fatal(err)
+	}
+	defer UnregisterExtension(testWidgetExtension.VocabularyURI)
+	t.Run("ToType", func(t *testing.T) {
+		v, err := ToType(ctx, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if w, ok := v.(*testWidget); !ok || w.color != "red" {
+			t.Errorf("unexpected widget: %#v", v)
+		}
+	})
+	t.Run("RequiresVocabularyInContext", func(t *testing.T) {
+		bare := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Widget",
+  "color": "red"
+}`
+		var bm map[string]interface{}
+		if err := json.Unmarshal([]byte(bare), &bm); err != nil {
+			t.Fatal(err)
+		}
+		if v, err := ToType(ctx, bm); err != ErrUnhandledType {
+			t.Errorf("expected a type outside the context to be unhandled, got %#v, %v", v, err)
+		}
+	})
+	t.Run("JSONResolver", func(t *testing.T) {
+		var got vocab.Type
+		r, err := NewJSONResolver(func(c context.Context, n vocab.ActivityStreamsNote) error {
+			t.Error("unexpected Note callback")
+			return nil
+		}, func(c context.Context, v vocab.Type) error {
+			got = v
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = r.Resolve(ctx, m); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := got.(*testWidget); !ok {
+			t.Errorf("unexpected resolved value: %#v", got)
+		}
+		r, err = NewJSONResolver(func(c context.Context, n vocab.ActivityStreamsNote) error { return nil })
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = r.Resolve(ctx, m); err != ErrNoCallbackMatch {
+			t.Errorf("expected no callback match, got %v", err)
+		}
+	})
+	t.Run("Properties", func(t *testing.T) {
+		note := `{
+  "@context": ["https://www.w3.org/ns/activitystreams", {"x": "http://example.com/ns"}],
+  "type": "Note",
+  "x:color": "blue",
+  "x:gadget": {"type": "x:Widget", "x:color": "green"}
+}`
+		var nm map[string]interface{}
+		if err := json.Unmarshal([]byte(note), &nm); err != nil {
+			t.Fatal(err)
+		}
+		v, err := ToType(ctx, nm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		color := testWidgetExtension.Property("color")
+		if s, ok := color.GetString(v); !ok || s != "blue" {
+			t.Errorf("unexpected color: %q, %v", s, ok)
+		}
+		if _, ok := color.GetBool(v); ok {
+			t.Error("expected color not to be a boolean")
+		}
+		gadget := testWidgetExtension.Property("gadget")
+		g, err := gadget.GetType(ctx, v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if w, ok := g.(*testWidget); !ok || w.color != "green" {
+			t.Errorf("unexpected gadget: %#v", g)
+		}
+		if err = color.SetString(v, "yellow"); err != nil {
+			t.Fatal(err)
+		}
+		if s, _ := color.GetString(v); s != "yellow" {
+			t.Errorf("unexpected color after setting: %q", s)
+		}
+		if _, ok := v.(vocab.ActivityStreamsNote).GetUnknownProperties()["x:color"]; ok {
+			t.Error("expected previous key to be replaced")
+		}
+		if err = color.SetString(&testWidget{}, "red"); err == nil {
+			t.Error("expected error setting a property on a type without unknown properties")
+		}
+	})
+	t.Run("Serialize", func(t *testing.T) {
+		note := NewActivityStreamsNote()
+		gadget := testWidgetExtension.Property("gadget")
+		if err := gadget.SetType(note, &testWidget{color: "red"}); err != nil {
+			t.Fatal(err)
+		}
+		s, err := Serialize(note)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := `{"@context":["https://www.w3.org/ns/activitystreams",{"ex":"https://example.com/ns"}],"ex:gadget":{"ex:color":"red","type":"ex:Widget"},"type":"Note"}`
+		if string(b) != expected {
+			t.Errorf("unexpected serialization:\n%s\nexpected:\n%s", b, expected)
+		}
+		w, err := Serialize(&testWidget{color: "red"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if w[jsonLDContext].(map[string]string)["ex"] != "https://example.com/ns" {
+			t.Errorf("unexpected widget context: %v", w[jsonLDContext])
+		}
+	})
+}
+
+// TestClone tests that changing a deep copy of a value does not change the
+// original.
+func TestClone(t *testing.T) {
+	original := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/activities/1",
+  "type": "Create",
+  "actor": "https://example.com/users/sally",
+  "to": ["https://example.com/users/dakota"],
+  "object": {
+    "id": "https://example.com/notes/1",
+    "type": "Note",
+    "contentMap": {"en": "hello", "fr": "bonjour"},
+    "url": "https://example.com/@sally/1",
+    "custom": {"nested": ["a", "b"]}
+  }
+}`
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(original), &m); err != nil {
+		t.Fatal(err)
+	}
+	v, err := ToType(context.Background(), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before, err := Serialize(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clone := Clone(v)
+	cloned, err := Serialize(clone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(before, cloned); diff != nil {
+		t.Fatalf("clone differs from the original: %v", diff)
+	}
+	// Change everything in the clone.
+	create := clone.(vocab.ActivityStreamsCreate)
+	create.GetJSONLDId().Get().Path = "/activities/2"
+	create.GetActivityStreamsActor().At(0).GetIRI().Host = "changed.example.com"
+	create.GetActivityStreamsTo().AppendIRI(&url.URL{Scheme: "https", Host: "example.com", Path: "/users/lee"})
+	note := create.GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+	note.GetActivityStreamsContent().At(0).GetRDFLangString()["en"] = "goodbye"
+	note.GetActivityStreamsContent().At(0).SetLanguage("de", "hallo")
+	note.GetActivityStreamsUrl().At(0).GetXMLSchemaAnyURI().Path = "/changed"
+	note.GetUnknownProperties()["custom"].(map[string]interface{})["nested"].([]interface{})[0] = "z"
+	after, err := Serialize(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(before, after); diff != nil {
+		t.Errorf("changing the clone changed the original: %v", diff)
+	}
+	if Clone(&testWidget{}) != nil {
+		t.Error("expected nil clone of a type without a Clone method")
+	}
+}
+
+func TestEquals(t *testing.T) {
+	toType := func(s string) vocab.Type {
+		var m map[string]interface{}
+		if err := json.Unmarshal([]byte(s), &m); err != nil {
+			t.Fatal(err)
+		}
+		v, err := ToType(context.Background(), m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	original := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/notes/1",
+  "type": "Note",
+  "attributedTo": "https://example.com/users/sally",
+  "contentMap": {"en": "hello", "fr": "bonjour"},
+  "to": ["https://example.com/users/dakota", {"type": "Person", "name": "Lee"}],
+  "custom": {"nested": ["a", "b"]}
+}`
+	note := toType(original).(vocab.ActivityStreamsNote)
+	// Setup
+	tests := []struct {
+		name   string
+		change func(n vocab.ActivityStreamsNote)
+		expect bool
+	}{
+		{
+			name:   "clone",
+			change: func(n vocab.ActivityStreamsNote) {},
+			expect: true,
+		},
+		{
+			name: "language map",
+			change: func(n vocab.ActivityStreamsNote) {
+				n.GetActivityStreamsContent().At(0).SetLanguage("fr", "salut")
+			},
+			expect: false,
+		},
+		{
+			name: "IRI",
+			change: func(n vocab.ActivityStreamsNote) {
+				n.GetActivityStreamsAttributedTo().At(0).GetIRI().Path = "/users/lee"
+			},
+			expect: false,
+		},
+		{
+			name: "embedded type",
+			change: func(n vocab.ActivityStreamsNote) {
+				n.GetActivityStreamsTo().At(1).GetActivityStreamsPerson().GetActivityStreamsName().At(0).SetXMLSchemaString("Dakota")
+			},
+			expect: false,
+		},
+		{
+			name: "additional value",
+			change: func(n vocab.ActivityStreamsNote) {
+				n.GetActivityStreamsTo().AppendIRI(&url.URL{Scheme: "https", Host: "example.com", Path: "/users/lee"})
+			},
+			expect: false,
+		},
+		{
+			name: "removed property",
+			change: func(n vocab.ActivityStreamsNote) {
+				n.SetActivityStreamsAttributedTo(nil)
+			},
+			expect: false,
+		},
+		{
+			name: "unknown property",
+			change: func(n vocab.ActivityStreamsNote) {
+				n.GetUnknownProperties()["custom"].(map[string]interface{})["nested"].([]interface{})[0] = "z"
+			},
+			expect: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			other := Clone(note).(vocab.ActivityStreamsNote)
+			// Run the test
+			test.change(other)
+			// Verify results
+			if got := note.Equals(other); got != test.expect {
+				t.Errorf("expected Equals %v, got %v", test.expect, got)
+			} else if got := other.Equals(note); got != test.expect {
+				t.Errorf("expected symmetric Equals %v, got %v", test.expect, got)
+			}
+		})
+	}
+	t.Run("deserialized", func(t *testing.T) {
+		// Setup
+		created := NewActivityStreamsNote()
+		created.SetJSONLDId(toType(original).(vocab.ActivityStreamsNote).GetJSONLDId())
+		// Run the test
+		same := note.Equals(toType(original).(vocab.ActivityStreamsNote))
+		different := note.Equals(created)
+		// Verify results
+		if !same {
+			t.Error("expected values deserialized from the same payload to be equal")
+		}
+		if different {
+			t.Error("expected values with different properties to differ")
+		}
+	})
+}
+
+func TestDiff(t *testing.T) {
+	toType := func(s string) vocab.Type {
+		var m map[string]interface{}
+		if err := json.Unmarshal([]byte(s), &m); err != nil {
+			t.Fatal(err)
+		}
+		v, err := ToType(context.Background(), m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	// Setup
+	before := toType(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/notes/1",
+  "type": "Note",
+  "to": "https://example.com/users/dakota",
+  "summary": "A note",
+  "contentMap": {"en": "hello", "fr": "bonjour"},
+  "tag": [
+    {"id": "https://example.com/tags/1", "type": "Mention", "name": "@sally"},
+    {"id": "https://example.com/tags/2", "type": "Mention", "name": "@dakota"}
+  ]
+}`)
+	after := toType(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/notes/1",
+  "type": "Note",
+  "to": ["https://example.com/users/dakota", "https://example.com/users/lee"],
+  "name": "Greetings",
+  "contentMap": {"en": "hi", "de": "hallo"},
+  "tag": [
+    {"id": "https://example.com/tags/2", "type": "Mention", "name": "@lee"},
+    {"id": "https://example.com/tags/1", "type": "Mention", "name": "@sally"}
+  ]
+}`)
+	expectChanges := []Change{
+		{Kind: Removed, Path: "/summary", Old: "A note"},
+		{Kind: Removed, Path: "/contentMap/fr", Old: "bonjour"},
+		{Kind: Added, Path: "/contentMap/de", New: "hallo"},
+		{Kind: Changed, Path: "/contentMap/en", Old: "hello", New: "hi"},
+		{Kind: Added, Path: "/name", New: "Greetings"},
+		{Kind: Changed, Path: "/tag/0/name", Old: "@dakota", New: "@lee"},
+		{Kind: Added, Path: "/to/1", New: "https://example.com/users/lee"},
+	}
+	expectPatch := map[string]interface{}{
+		"summary": nil,
+		"name":    "Greetings",
+		"contentMap": map[string]interface{}{
+			"en": "hi",
+			"fr": nil,
+			"de": "hallo",
+		},
+		"tag": []interface{}{
+			map[string]interface{}{"id": "https://example.com/tags/2", "type": "Mention", "name": "@lee"},
+			map[string]interface{}{"id": "https://example.com/tags/1", "type": "Mention", "name": "@sally"},
+		},
+		"to": []interface{}{"https://example.com/users/dakota", "https://example.com/users/lee"},
+	}
+	// Run the test
+	d, err := Diff(before, after)
+	// Verify results
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(d.Changes, expectChanges); diff != nil {
+		t.Errorf("unexpected changes: %v", diff)
+	}
+	if diff := deep.Equal(d.MergePatch(), expectPatch); diff != nil {
+		t.Errorf("unexpected merge patch: %v", diff)
+	}
+	if d.Empty() {
+		t.Error("expected the difference not to be empty")
+	}
+	t.Run("reordered set", func(t *testing.T) {
+		// Setup
+		reordered := Clone(after).(vocab.ActivityStreamsNote)
+		to := reordered.GetActivityStreamsTo()
+		to.Swap(0, 1)
+		expectChanges := []Change{{
+			Kind: Changed,
+			Path: "/to",
+			Old:  []interface{}{"https://example.com/users/dakota", "https://example.com/users/lee"},
+			New:  []interface{}{"https://example.com/users/lee", "https://example.com/users/dakota"},
+		}}
+		// Run the test
+		d, err := Diff(after, reordered)
+		// Verify results
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := deep.Equal(d.Changes, expectChanges); diff != nil {
+			t.Errorf("unexpected changes: %v", diff)
+		}
+		if d.Empty() != after.(vocab.ActivityStreamsNote).Equals(reordered) {
+			t.Error("expected Empty to agree with Equals")
+		}
+		if diff := deep.Equal(d.MergePatch(), map[string]interface{}{"to": expectChanges[0].New}); diff != nil {
+			t.Errorf("unexpected merge patch: %v", diff)
+		}
+	})
+	t.Run("reordered list", func(t *testing.T) {
+		// Setup
+		collection := toType(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/collection",
+  "type": "OrderedCollection",
+  "orderedItems": [
+    "https://example.com/notes/1",
+    {"id": "https://example.com/notes/2", "type": "Note", "name": "second"},
+    "https://example.com/notes/3"
+  ]
+}`).(vocab.ActivityStreamsOrderedCollection)
+		reversed := Clone(collection).(vocab.ActivityStreamsOrderedCollection)
+		reversed.GetActivityStreamsOrderedItems().Swap(0, 2)
+		expectChanges := []Change{
+			{Kind: Changed, Path: "/orderedItems/0", Old: "https://example.com/notes/1", New: "https://example.com/notes/3"},
+			{Kind: Changed, Path: "/orderedItems/2", Old: "https://example.com/notes/3", New: "https://example.com/notes/1"},
+		}
+		// Run the test
+		d, err := Diff(collection, reversed)
+		// Verify results
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := deep.Equal(d.Changes, expectChanges); diff != nil {
+			t.Errorf("unexpected changes: %v", diff)
+		}
+		if collection.Equals(reversed) || d.Empty() {
+			t.Error("expected the reversed collection to differ")
+		}
+		patch := d.MergePatch()
+		if len(patch) != 1 {
+			t.Errorf("expected only orderedItems in the merge patch, got %v", patch)
+		} else if items, ok := patch["orderedItems"].([]interface{}); !ok || len(items) != 3 || items[0] != "https://example.com/notes/3" {
+			t.Errorf("expected orderedItems to be replaced, got %v", patch["orderedItems"])
+		}
+	})
+	t.Run("unchanged", func(t *testing.T) {
+		// Run the test
+		d, err := Diff(after, Clone(after))
+		// Verify results
+		if err != nil {
+			t.Fatal(err)
+		} else if !d.Empty() {
+			t.Errorf("expected no changes, got %v", d.Changes)
+		} else if p := d.MergePatch(); len(p) != 0 {
+			t.Errorf("expected an empty merge patch, got %v", p)
+		}
+	})
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		json   string
+		expect []Violation
+	}{
+		{
+			name: "valid",
+			json: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Create",
+  "actor": "https://example.com/users/sally",
+  "object": {
+    "type": "Note",
+    "content": "hello",
+    "tag": [{"type": "Mention", "href": "https://example.com/users/dakota"}]
+  }
+}`,
+		},
+		{
+			name: "activity without actor",
+			json: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Create",
+  "object": "https://example.com/notes/1"
+}`,
+			expect: []Violation{
+				{Path: "/actor", Message: "Create has no actor"},
+			},
+		},
+		{
+			name: "embedded activity without object",
+			json: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Announce",
+  "actor": "https://example.com/users/sally",
+  "object": {
+    "type": "Like",
+    "actor": "https://example.com/users/dakota"
+  }
+}`,
+			expect: []Violation{
+				{Path: "/object/0/object", Message: "Like has no object"},
+			},
+		},
+		{
+			name: "question with oneOf and anyOf",
+			json: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Question",
+  "actor": "https://example.com/users/sally",
+  "oneOf": [{"type": "Note", "name": "yes"}],
+  "anyOf": [{"type": "Note", "name": "no"}]
+}`,
+			expect: []Violation{
+				{Path: "/anyOf", Message: "Question has both oneOf and anyOf"},
+			},
+		},
+		{
+			name: "negative totalItems",
+			json: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Collection",
+  "totalItems": -3
+}`,
+			expect: []Violation{
+				{Path: "/totalItems", Message: "value is not in the range of totalItems"},
+			},
+		},
+		{
+			name: "link without href",
+			json: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "tag": [{"type": "Mention", "name": "@dakota"}, {"type": "Hashtag", "href": "https://example.com/tags/go", "name": "#go"}]
+}`,
+			expect: []Violation{
+				{Path: "/tag/0/href", Message: "Mention has no href"},
+			},
+		},
+		{
+			name: "disjoint types",
+			json: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": ["Note", "Link"],
+  "content": "hello"
+}`,
+			expect: []Violation{
+				{Path: "/type", Message: "Link is disjoint with Note"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Setup
+			var m map[string]interface{}
+			if err := json.Unmarshal([]byte(test.json), &m); err != nil {
+				t.Fatal(err)
+			}
+			v, err := ToType(context.Background(), m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Run the test
+			violations := Validate(v)
+			// Verify results
+			if !reflect.DeepEqual(violations, test.expect) {
+				t.Errorf("expected violations %v, got %v", test.expect, violations)
+			}
+		})
+	}
+}
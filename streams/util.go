@@ -2,6 +2,7 @@ package streams
 
 import (
 	"github.com/go-fed/activity/streams/vocab"
+	"sort"
 )
 
 const (
@@ -19,9 +20,31 @@ func Serialize(a vocab.Type) (m map[string]interface{}, e error) {
 	if e != nil {
 		return
 	}
-	v := a.JSONLDContext()
-	// Transform the map of vocabulary-to-aliases into a context payload,
-	// but do so in a way that at least keeps it readable for other humans.
+	v := make(map[string]string)
+	for vocab, alias := range a.JSONLDContext() {
+		v[vocab] = alias
+	}
+	addExtensionContext(v, m)
+	// TODO: Update the context instead if it already exists
+	m[jsonLDContext] = toContextValue(v)
+	// Delete any existing `@context` in child maps.
+	var cleanFnRecur func(map[string]interface{})
+	cleanFnRecur = func(r map[string]interface{}) {
+		for _, v := range r {
+			if n, ok := v.(map[string]interface{}); ok {
+				delete(n, jsonLDContext)
+				cleanFnRecur(n)
+			}
+		}
+	}
+	cleanFnRecur(m)
+	return
+}
+
+// toContextValue transforms the map of vocabulary-to-aliases into a context
+// payload, but does so in a way that at least keeps it readable for other
+// humans.
+func toContextValue(v map[string]string) interface{} {
 	var contextValue interface{}
 	if len(v) == 1 {
 		for vocab, alias := range v {
@@ -34,10 +57,16 @@ func Serialize(a vocab.Type) (m map[string]interface{}, e error) {
 			}
 		}
 	} else {
+		// Sort the vocabularies so that serializing is deterministic.
+		vocabs := make([]string, 0, len(v))
+		for vocab := range v {
+			vocabs = append(vocabs, vocab)
+		}
+		sort.Strings(vocabs)
 		var arr []interface{}
 		aliases := make(map[string]string)
-		for vocab, alias := range v {
-			if len(alias) == 0 {
+		for _, vocab := range vocabs {
+			if alias := v[vocab]; len(alias) == 0 {
 				arr = append(arr, vocab)
 			} else {
 				aliases[alias] = vocab
@@ -48,19 +77,5 @@ func Serialize(a vocab.Type) (m map[string]interface{}, e error) {
 		}
 		contextValue = arr
 	}
-	// TODO: Update the context instead if it already exists
-	m[jsonLDContext] = contextValue
-	// TODO: Sort the context based on arbitrary order.
-	// Delete any existing `@context` in child maps.
-	var cleanFnRecur func(map[string]interface{})
-	cleanFnRecur = func(r map[string]interface{}) {
-		for _, v := range r {
-			if n, ok := v.(map[string]interface{}); ok {
-				delete(n, jsonLDContext)
-				cleanFnRecur(n)
-			}
-		}
-	}
-	cleanFnRecur(m)
-	return
+	return contextValue
 }
@@ -0,0 +1,65 @@
+package streams
+
+import (
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+func init() {
+	validationRules = append(validationRules, validateActivityStreams)
+}
+
+// actorer is an ActivityStreams type with an 'actor' property.
+type actorer interface {
+	GetActivityStreamsActor() vocab.ActivityStreamsActorProperty
+}
+
+// objecter is an ActivityStreams type with an 'object' property.
+type objecter interface {
+	GetActivityStreamsObject() vocab.ActivityStreamsObjectProperty
+}
+
+// hrefer is an ActivityStreams type with an 'href' property.
+type hrefer interface {
+	GetActivityStreamsHref() vocab.ActivityStreamsHrefProperty
+}
+
+// validateActivityStreams returns the violations of the rules of the
+// ActivityStreams and ActivityPub specifications that its ontology does not
+// express.
+func validateActivityStreams(t vocab.Type) (violations []Violation) {
+	if IsOrExtendsActivityStreamsActivity(t) {
+		if a, ok := t.(actorer); !ok || a.GetActivityStreamsActor() == nil || a.GetActivityStreamsActor().Len() == 0 {
+			violations = append(violations, Violation{
+				Path:    "/actor",
+				Message: t.GetTypeName() + " has no actor",
+			})
+		}
+	}
+	// Activities other than intransitive ones act upon an object.
+	if ActivityStreamsActivityIsExtendedBy(t) && !IsOrExtendsActivityStreamsIntransitiveActivity(t) {
+		if o, ok := t.(objecter); !ok || o.GetActivityStreamsObject() == nil || o.GetActivityStreamsObject().Len() == 0 {
+			violations = append(violations, Violation{
+				Path:    "/object",
+				Message: t.GetTypeName() + " has no object",
+			})
+		}
+	}
+	if q, ok := t.(vocab.ActivityStreamsQuestion); ok {
+		oneOf, anyOf := q.GetActivityStreamsOneOf(), q.GetActivityStreamsAnyOf()
+		if oneOf != nil && oneOf.Len() > 0 && anyOf != nil && anyOf.Len() > 0 {
+			violations = append(violations, Violation{
+				Path:    "/anyOf",
+				Message: "Question has both oneOf and anyOf",
+			})
+		}
+	}
+	if IsOrExtendsActivityStreamsLink(t) {
+		if h, ok := t.(hrefer); !ok || h.GetActivityStreamsHref() == nil || !h.GetActivityStreamsHref().HasAny() {
+			violations = append(violations, Violation{
+				Path:    "/href",
+				Message: t.GetTypeName() + " has no href",
+			})
+		}
+	}
+	return
+}
@@ -19,13 +19,15 @@ func DeserializeDateTime(this interface{}) (time.Time, error) {
 	var tmp time.Time
 	var err error
 	if s, ok := this.(string); ok {
-		tmp, err = time.Parse(time.RFC3339, s)
-		if err != nil {
-			tmp, err = time.Parse("2006-01-02T15:04Z07:00", s)
-			if err != nil {
-				err = fmt.Errorf("%v cannot be interpreted as xsd:datetime", this)
+		for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00", "2006-01-02T15:04:05Z0700", "2006-01-02 15:04:05Z07:00", "2006-01-02 15:04:05Z0700", "2006-01-02T15:04:05", "2006-01-02 15:04:05"} {
+			tmp, err = time.Parse(layout, s)
+			if err == nil {
+				break
 			}
 		}
+		if err != nil {
+			err = fmt.Errorf("%v cannot be interpreted as xsd:datetime", this)
+		}
 	} else {
 		err = fmt.Errorf("%v cannot be interpreted as a string for xsd:datetime", this)
 	}
@@ -16,7 +16,9 @@
 // the same as object oriented inheritance. While types also have a "LessThan"
 // method, it is an arbitrary sort. Do not use it if needing to sort on
 // specific properties, such as publish time. It is best used for normalizing
-// the type. Lastly, do not use the "GetUnknownProperties" method in an
+// the type. The "Clone" method returns a deep copy of a type, which can be
+// changed without changing the original, and the "Equals" method compares two
+// types deeply. Lastly, do not use the "GetUnknownProperties" method in an
 // application. Instead, use the go-fed tool to code generate the property
 // needed.
 //
@@ -32,9 +34,11 @@
 // an interface type, but applications should not typically use a property's
 // "Serialize" and instead should use a type's "Serialize" instead. Like
 // types, properties have an arbitrary "LessThan" comparison function that
-// should not be used if needing to sort on specific values. Finally,
-// applications should not use the "KindIndex" method as it is a comparison
-// mechanism only for those looking to write an alternate implementation.
+// should not be used if needing to sort on specific values, and a "Clone"
+// method returning a deep copy and an "Equals" method comparing them deeply.
+// Finally, applications should not use the "KindIndex" method as it is a
+// comparison mechanism only for those looking to write an alternate
+// implementation.
 //
 // Non-functional properties can have more than one value, so it has  "Len"
 // for getting its length, "At" for getting an iterator pointing to an
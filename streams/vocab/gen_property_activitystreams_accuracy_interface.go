@@ -19,6 +19,12 @@ type ActivityStreamsAccuracyProperty interface {
 	// Clear ensures no value of this property is set. Calling
 	// IsXMLSchemaFloat afterwards will return false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be changed
+	// without changing this one.
+	Clone() ActivityStreamsAccuracyProperty
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsAccuracyProperty) bool
 	// Get returns the value of this property. When IsXMLSchemaFloat returns
 	// false, Get will return any arbitrary value.
 	Get() float64
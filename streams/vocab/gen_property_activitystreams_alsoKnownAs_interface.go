@@ -0,0 +1,141 @@
+// Code generated by astool. DO NOT EDIT.
+
+package vocab
+
+import "net/url"
+
+// ActivityStreamsAlsoKnownAsPropertyIterator represents a single value for the
+// "alsoKnownAs" property.
+type ActivityStreamsAlsoKnownAsPropertyIterator interface {
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsAlsoKnownAsPropertyIterator) bool
+	// Get returns the value of this property. When IsXMLSchemaAnyURI returns
+	// false, Get will return any arbitrary value.
+	Get() *url.URL
+	// GetIRI returns the IRI of this property. When IsIRI returns false,
+	// GetIRI will return any arbitrary value.
+	GetIRI() *url.URL
+	// HasAny returns true if the value or IRI is set.
+	HasAny() bool
+	// IsIRI returns true if this property is an IRI.
+	IsIRI() bool
+	// IsXMLSchemaAnyURI returns true if this property is set and not an IRI.
+	IsXMLSchemaAnyURI() bool
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this property and the specific values that are set. The value
+	// in the map is the alias used to import the property's value or
+	// values.
+	JSONLDContext() map[string]string
+	// KindIndex computes an arbitrary value for indexing this kind of value.
+	// This is a leaky API detail only for folks looking to replace the
+	// go-fed implementation. Applications should not use this method.
+	KindIndex() int
+	// LessThan compares two instances of this property with an arbitrary but
+	// stable comparison. Applications should not use this because it is
+	// only meant to help alternative implementations to go-fed to be able
+	// to normalize nonfunctional properties.
+	LessThan(o ActivityStreamsAlsoKnownAsPropertyIterator) bool
+	// Name returns the name of this property: "ActivityStreamsAlsoKnownAs".
+	Name() string
+	// Next returns the next iterator, or nil if there is no next iterator.
+	Next() ActivityStreamsAlsoKnownAsPropertyIterator
+	// Prev returns the previous iterator, or nil if there is no previous
+	// iterator.
+	Prev() ActivityStreamsAlsoKnownAsPropertyIterator
+	// Set sets the value of this property. Calling IsXMLSchemaAnyURI
+	// afterwards will return true.
+	Set(v *url.URL)
+	// SetIRI sets the value of this property. Calling IsIRI afterwards will
+	// return true.
+	SetIRI(v *url.URL)
+}
+
+// Other actors that are the same entity as this actor, such as the accounts it
+// moved from. This term is widely used in the as: namespace by federated
+// software, although it is not in the ActivityStreams Vocabulary.
+type ActivityStreamsAlsoKnownAsProperty interface {
+	// AppendIRI appends an IRI value to the back of a list of the property
+	// "alsoKnownAs"
+	AppendIRI(v *url.URL)
+	// AppendXMLSchemaAnyURI appends a anyURI value to the back of a list of
+	// the property "alsoKnownAs". Invalidates iterators that are
+	// traversing using Prev.
+	AppendXMLSchemaAnyURI(v *url.URL)
+	// At returns the property value for the specified index. Panics if the
+	// index is out of bounds.
+	At(index int) ActivityStreamsAlsoKnownAsPropertyIterator
+	// Begin returns the first iterator, or nil if empty. Can be used with the
+	// iterator's Next method and this property's End method to iterate
+	// from front to back through all values.
+	Begin() ActivityStreamsAlsoKnownAsPropertyIterator
+	// Clone returns a deep copy of this property and its values, which can be
+	// changed without changing this one.
+	Clone() ActivityStreamsAlsoKnownAsProperty
+	// Empty returns returns true if there are no elements.
+	Empty() bool
+	// End returns beyond-the-last iterator, which is nil. Can be used with
+	// the iterator's Next method and this property's Begin method to
+	// iterate from front to back through all values.
+	End() ActivityStreamsAlsoKnownAsPropertyIterator
+	// Equals determines whether this property has the same values as another
+	// in the same order, comparing any types they hold deeply.
+	Equals(o ActivityStreamsAlsoKnownAsProperty) bool
+	// Insert inserts an IRI value at the specified index for a property
+	// "alsoKnownAs". Existing elements at that index and higher are
+	// shifted back once. Invalidates all iterators.
+	InsertIRI(idx int, v *url.URL)
+	// InsertXMLSchemaAnyURI inserts a anyURI value at the specified index for
+	// a property "alsoKnownAs". Existing elements at that index and
+	// higher are shifted back once. Invalidates all iterators.
+	InsertXMLSchemaAnyURI(idx int, v *url.URL)
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this property and the specific values that are set. The value
+	// in the map is the alias used to import the property's value or
+	// values.
+	JSONLDContext() map[string]string
+	// KindIndex computes an arbitrary value for indexing this kind of value.
+	// This is a leaky API method specifically needed only for alternate
+	// implementations for go-fed. Applications should not use this
+	// method. Panics if the index is out of bounds.
+	KindIndex(idx int) int
+	// Len returns the number of values that exist for the "alsoKnownAs"
+	// property.
+	Len() (length int)
+	// Less computes whether another property is less than this one. Mixing
+	// types results in a consistent but arbitrary ordering
+	Less(i, j int) bool
+	// LessThan compares two instances of this property with an arbitrary but
+	// stable comparison. Applications should not use this because it is
+	// only meant to help alternative implementations to go-fed to be able
+	// to normalize nonfunctional properties.
+	LessThan(o ActivityStreamsAlsoKnownAsProperty) bool
+	// Name returns the name of this property ("alsoKnownAs") with any alias.
+	Name() string
+	// PrependIRI prepends an IRI value to the front of a list of the property
+	// "alsoKnownAs".
+	PrependIRI(v *url.URL)
+	// PrependXMLSchemaAnyURI prepends a anyURI value to the front of a list
+	// of the property "alsoKnownAs". Invalidates all iterators.
+	PrependXMLSchemaAnyURI(v *url.URL)
+	// Remove deletes an element at the specified index from a list of the
+	// property "alsoKnownAs", regardless of its type. Panics if the index
+	// is out of bounds. Invalidates all iterators.
+	Remove(idx int)
+	// Serialize converts this into an interface representation suitable for
+	// marshalling into a text or binary format. Applications should not
+	// need this function as most typical use cases serialize types
+	// instead of individual properties. It is exposed for alternatives to
+	// go-fed implementations to use.
+	Serialize() (interface{}, error)
+	// Set sets a anyURI value to be at the specified index for the property
+	// "alsoKnownAs". Panics if the index is out of bounds. Invalidates
+	// all iterators.
+	Set(idx int, v *url.URL)
+	// SetIRI sets an IRI value to be at the specified index for the property
+	// "alsoKnownAs". Panics if the index is out of bounds.
+	SetIRI(idx int, v *url.URL)
+	// Swap swaps the location of values at two indices for the "alsoKnownAs"
+	// property.
+	Swap(i, j int)
+}
@@ -21,6 +21,12 @@ type ActivityStreamsAltitudeProperty interface {
 	// Clear ensures no value of this property is set. Calling
 	// IsXMLSchemaFloat afterwards will return false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be changed
+	// without changing this one.
+	Clone() ActivityStreamsAltitudeProperty
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsAltitudeProperty) bool
 	// Get returns the value of this property. When IsXMLSchemaFloat returns
 	// false, Get will return any arbitrary value.
 	Get() float64
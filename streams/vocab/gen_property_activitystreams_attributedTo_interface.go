@@ -7,6 +7,9 @@ import "net/url"
 // ActivityStreamsAttributedToPropertyIterator represents a single value for the
 // "attributedTo" property.
 type ActivityStreamsAttributedToPropertyIterator interface {
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsAttributedToPropertyIterator) bool
 	// GetActivityStreamsAccept returns the value of this property. When
 	// IsActivityStreamsAccept returns false, GetActivityStreamsAccept
 	// will return an arbitrary value.
@@ -83,6 +86,10 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// IsActivityStreamsGroup returns false, GetActivityStreamsGroup will
 	// return an arbitrary value.
 	GetActivityStreamsGroup() ActivityStreamsGroup
+	// GetActivityStreamsHashtag returns the value of this property. When
+	// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag
+	// will return an arbitrary value.
+	GetActivityStreamsHashtag() ActivityStreamsHashtag
 	// GetActivityStreamsIgnore returns the value of this property. When
 	// IsActivityStreamsIgnore returns false, GetActivityStreamsIgnore
 	// will return an arbitrary value.
@@ -225,9 +232,39 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// IsActivityStreamsView returns false, GetActivityStreamsView will
 	// return an arbitrary value.
 	GetActivityStreamsView() ActivityStreamsView
+	// GetForgeFedCommit returns the value of this property. When
+	// IsForgeFedCommit returns false, GetForgeFedCommit will return an
+	// arbitrary value.
+	GetForgeFedCommit() ForgeFedCommit
+	// GetForgeFedPatch returns the value of this property. When
+	// IsForgeFedPatch returns false, GetForgeFedPatch will return an
+	// arbitrary value.
+	GetForgeFedPatch() ForgeFedPatch
+	// GetForgeFedPush returns the value of this property. When IsForgeFedPush
+	// returns false, GetForgeFedPush will return an arbitrary value.
+	GetForgeFedPush() ForgeFedPush
+	// GetForgeFedRepository returns the value of this property. When
+	// IsForgeFedRepository returns false, GetForgeFedRepository will
+	// return an arbitrary value.
+	GetForgeFedRepository() ForgeFedRepository
+	// GetForgeFedTicket returns the value of this property. When
+	// IsForgeFedTicket returns false, GetForgeFedTicket will return an
+	// arbitrary value.
+	GetForgeFedTicket() ForgeFedTicket
+	// GetForgeFedTicketDependency returns the value of this property. When
+	// IsForgeFedTicketDependency returns false,
+	// GetForgeFedTicketDependency will return an arbitrary value.
+	GetForgeFedTicketDependency() ForgeFedTicketDependency
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
 	// GetIRI will return an arbitrary value.
 	GetIRI() *url.URL
+	// GetSchemaPropertyValue returns the value of this property. When
+	// IsSchemaPropertyValue returns false, GetSchemaPropertyValue will
+	// return an arbitrary value.
+	GetSchemaPropertyValue() SchemaPropertyValue
+	// GetTootEmoji returns the value of this property. When IsTootEmoji
+	// returns false, GetTootEmoji will return an arbitrary value.
+	GetTootEmoji() TootEmoji
 	// GetType returns the value in this property as a Type. Returns nil if
 	// the value is not an ActivityStreams type, such as an IRI or another
 	// value.
@@ -314,6 +351,10 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// "Group". When true, use the GetActivityStreamsGroup and
 	// SetActivityStreamsGroup methods to access and set this property.
 	IsActivityStreamsGroup() bool
+	// IsActivityStreamsHashtag returns true if this property has a type of
+	// "Hashtag". When true, use the GetActivityStreamsHashtag and
+	// SetActivityStreamsHashtag methods to access and set this property.
+	IsActivityStreamsHashtag() bool
 	// IsActivityStreamsIgnore returns true if this property has a type of
 	// "Ignore". When true, use the GetActivityStreamsIgnore and
 	// SetActivityStreamsIgnore methods to access and set this property.
@@ -466,9 +507,42 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// "View". When true, use the GetActivityStreamsView and
 	// SetActivityStreamsView methods to access and set this property.
 	IsActivityStreamsView() bool
+	// IsForgeFedCommit returns true if this property has a type of "Commit".
+	// When true, use the GetForgeFedCommit and SetForgeFedCommit methods
+	// to access and set this property.
+	IsForgeFedCommit() bool
+	// IsForgeFedPatch returns true if this property has a type of "Patch".
+	// When true, use the GetForgeFedPatch and SetForgeFedPatch methods to
+	// access and set this property.
+	IsForgeFedPatch() bool
+	// IsForgeFedPush returns true if this property has a type of "Push". When
+	// true, use the GetForgeFedPush and SetForgeFedPush methods to access
+	// and set this property.
+	IsForgeFedPush() bool
+	// IsForgeFedRepository returns true if this property has a type of
+	// "Repository". When true, use the GetForgeFedRepository and
+	// SetForgeFedRepository methods to access and set this property.
+	IsForgeFedRepository() bool
+	// IsForgeFedTicket returns true if this property has a type of "Ticket".
+	// When true, use the GetForgeFedTicket and SetForgeFedTicket methods
+	// to access and set this property.
+	IsForgeFedTicket() bool
+	// IsForgeFedTicketDependency returns true if this property has a type of
+	// "TicketDependency". When true, use the GetForgeFedTicketDependency
+	// and SetForgeFedTicketDependency methods to access and set this
+	// property.
+	IsForgeFedTicketDependency() bool
 	// IsIRI returns true if this property is an IRI. When true, use GetIRI
 	// and SetIRI to access and set this property
 	IsIRI() bool
+	// IsSchemaPropertyValue returns true if this property has a type of
+	// "PropertyValue". When true, use the GetSchemaPropertyValue and
+	// SetSchemaPropertyValue methods to access and set this property.
+	IsSchemaPropertyValue() bool
+	// IsTootEmoji returns true if this property has a type of "Emoji". When
+	// true, use the GetTootEmoji and SetTootEmoji methods to access and
+	// set this property.
+	IsTootEmoji() bool
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
 	// in the map is the alias used to import the property's value or
@@ -547,6 +621,9 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// SetActivityStreamsGroup sets the value of this property. Calling
 	// IsActivityStreamsGroup afterwards returns true.
 	SetActivityStreamsGroup(v ActivityStreamsGroup)
+	// SetActivityStreamsHashtag sets the value of this property. Calling
+	// IsActivityStreamsHashtag afterwards returns true.
+	SetActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// SetActivityStreamsIgnore sets the value of this property. Calling
 	// IsActivityStreamsIgnore afterwards returns true.
 	SetActivityStreamsIgnore(v ActivityStreamsIgnore)
@@ -654,9 +731,33 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// SetActivityStreamsView sets the value of this property. Calling
 	// IsActivityStreamsView afterwards returns true.
 	SetActivityStreamsView(v ActivityStreamsView)
+	// SetForgeFedCommit sets the value of this property. Calling
+	// IsForgeFedCommit afterwards returns true.
+	SetForgeFedCommit(v ForgeFedCommit)
+	// SetForgeFedPatch sets the value of this property. Calling
+	// IsForgeFedPatch afterwards returns true.
+	SetForgeFedPatch(v ForgeFedPatch)
+	// SetForgeFedPush sets the value of this property. Calling IsForgeFedPush
+	// afterwards returns true.
+	SetForgeFedPush(v ForgeFedPush)
+	// SetForgeFedRepository sets the value of this property. Calling
+	// IsForgeFedRepository afterwards returns true.
+	SetForgeFedRepository(v ForgeFedRepository)
+	// SetForgeFedTicket sets the value of this property. Calling
+	// IsForgeFedTicket afterwards returns true.
+	SetForgeFedTicket(v ForgeFedTicket)
+	// SetForgeFedTicketDependency sets the value of this property. Calling
+	// IsForgeFedTicketDependency afterwards returns true.
+	SetForgeFedTicketDependency(v ForgeFedTicketDependency)
 	// SetIRI sets the value of this property. Calling IsIRI afterwards
 	// returns true.
 	SetIRI(v *url.URL)
+	// SetSchemaPropertyValue sets the value of this property. Calling
+	// IsSchemaPropertyValue afterwards returns true.
+	SetSchemaPropertyValue(v SchemaPropertyValue)
+	// SetTootEmoji sets the value of this property. Calling IsTootEmoji
+	// afterwards returns true.
+	SetTootEmoji(v TootEmoji)
 	// SetType attempts to set the property for the arbitrary type. Returns an
 	// error if it is not a valid type to set on this property.
 	SetType(t Type) error
@@ -767,6 +868,10 @@ type ActivityStreamsAttributedToProperty interface {
 	// of the property "attributedTo". Invalidates iterators that are
 	// traversing using Prev.
 	AppendActivityStreamsGroup(v ActivityStreamsGroup)
+	// AppendActivityStreamsHashtag appends a Hashtag value to the back of a
+	// list of the property "attributedTo". Invalidates iterators that are
+	// traversing using Prev.
+	AppendActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// AppendActivityStreamsIgnore appends a Ignore value to the back of a
 	// list of the property "attributedTo". Invalidates iterators that are
 	// traversing using Prev.
@@ -909,9 +1014,41 @@ type ActivityStreamsAttributedToProperty interface {
 	// the property "attributedTo". Invalidates iterators that are
 	// traversing using Prev.
 	AppendActivityStreamsView(v ActivityStreamsView)
+	// AppendForgeFedCommit appends a Commit value to the back of a list of
+	// the property "attributedTo". Invalidates iterators that are
+	// traversing using Prev.
+	AppendForgeFedCommit(v ForgeFedCommit)
+	// AppendForgeFedPatch appends a Patch value to the back of a list of the
+	// property "attributedTo". Invalidates iterators that are traversing
+	// using Prev.
+	AppendForgeFedPatch(v ForgeFedPatch)
+	// AppendForgeFedPush appends a Push value to the back of a list of the
+	// property "attributedTo". Invalidates iterators that are traversing
+	// using Prev.
+	AppendForgeFedPush(v ForgeFedPush)
+	// AppendForgeFedRepository appends a Repository value to the back of a
+	// list of the property "attributedTo". Invalidates iterators that are
+	// traversing using Prev.
+	AppendForgeFedRepository(v ForgeFedRepository)
+	// AppendForgeFedTicket appends a Ticket value to the back of a list of
+	// the property "attributedTo". Invalidates iterators that are
+	// traversing using Prev.
+	AppendForgeFedTicket(v ForgeFedTicket)
+	// AppendForgeFedTicketDependency appends a TicketDependency value to the
+	// back of a list of the property "attributedTo". Invalidates
+	// iterators that are traversing using Prev.
+	AppendForgeFedTicketDependency(v ForgeFedTicketDependency)
 	// AppendIRI appends an IRI value to the back of a list of the property
 	// "attributedTo"
 	AppendIRI(v *url.URL)
+	// AppendSchemaPropertyValue appends a PropertyValue value to the back of
+	// a list of the property "attributedTo". Invalidates iterators that
+	// are traversing using Prev.
+	AppendSchemaPropertyValue(v SchemaPropertyValue)
+	// AppendTootEmoji appends a Emoji value to the back of a list of the
+	// property "attributedTo". Invalidates iterators that are traversing
+	// using Prev.
+	AppendTootEmoji(v TootEmoji)
 	// PrependType prepends an arbitrary type value to the front of a list of
 	// the property "attributedTo". Invalidates iterators that are
 	// traversing using Prev. Returns an error if the type is not a valid
@@ -924,12 +1061,18 @@ type ActivityStreamsAttributedToProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsAttributedToPropertyIterator
+	// Clone returns a deep copy of this property and its values, which can be
+	// changed without changing this one.
+	Clone() ActivityStreamsAttributedToProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsAttributedToPropertyIterator
+	// Equals determines whether this property has the same values as another
+	// in the same order, comparing any types they hold deeply.
+	Equals(o ActivityStreamsAttributedToProperty) bool
 	// InsertActivityStreamsAccept inserts a Accept value at the specified
 	// index for a property "attributedTo". Existing elements at that
 	// index and higher are shifted back once. Invalidates all iterators.
@@ -1009,6 +1152,10 @@ type ActivityStreamsAttributedToProperty interface {
 	// for a property "attributedTo". Existing elements at that index and
 	// higher are shifted back once. Invalidates all iterators.
 	InsertActivityStreamsGroup(idx int, v ActivityStreamsGroup)
+	// InsertActivityStreamsHashtag inserts a Hashtag value at the specified
+	// index for a property "attributedTo". Existing elements at that
+	// index and higher are shifted back once. Invalidates all iterators.
+	InsertActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// InsertActivityStreamsIgnore inserts a Ignore value at the specified
 	// index for a property "attributedTo". Existing elements at that
 	// index and higher are shifted back once. Invalidates all iterators.
@@ -1157,10 +1304,44 @@ type ActivityStreamsAttributedToProperty interface {
 	// for a property "attributedTo". Existing elements at that index and
 	// higher are shifted back once. Invalidates all iterators.
 	InsertActivityStreamsView(idx int, v ActivityStreamsView)
+	// InsertForgeFedCommit inserts a Commit value at the specified index for
+	// a property "attributedTo". Existing elements at that index and
+	// higher are shifted back once. Invalidates all iterators.
+	InsertForgeFedCommit(idx int, v ForgeFedCommit)
+	// InsertForgeFedPatch inserts a Patch value at the specified index for a
+	// property "attributedTo". Existing elements at that index and higher
+	// are shifted back once. Invalidates all iterators.
+	InsertForgeFedPatch(idx int, v ForgeFedPatch)
+	// InsertForgeFedPush inserts a Push value at the specified index for a
+	// property "attributedTo". Existing elements at that index and higher
+	// are shifted back once. Invalidates all iterators.
+	InsertForgeFedPush(idx int, v ForgeFedPush)
+	// InsertForgeFedRepository inserts a Repository value at the specified
+	// index for a property "attributedTo". Existing elements at that
+	// index and higher are shifted back once. Invalidates all iterators.
+	InsertForgeFedRepository(idx int, v ForgeFedRepository)
+	// InsertForgeFedTicket inserts a Ticket value at the specified index for
+	// a property "attributedTo". Existing elements at that index and
+	// higher are shifted back once. Invalidates all iterators.
+	InsertForgeFedTicket(idx int, v ForgeFedTicket)
+	// InsertForgeFedTicketDependency inserts a TicketDependency value at the
+	// specified index for a property "attributedTo". Existing elements at
+	// that index and higher are shifted back once. Invalidates all
+	// iterators.
+	InsertForgeFedTicketDependency(idx int, v ForgeFedTicketDependency)
 	// Insert inserts an IRI value at the specified index for a property
 	// "attributedTo". Existing elements at that index and higher are
 	// shifted back once. Invalidates all iterators.
 	InsertIRI(idx int, v *url.URL)
+	// InsertSchemaPropertyValue inserts a PropertyValue value at the
+	// specified index for a property "attributedTo". Existing elements at
+	// that index and higher are shifted back once. Invalidates all
+	// iterators.
+	InsertSchemaPropertyValue(idx int, v SchemaPropertyValue)
+	// InsertTootEmoji inserts a Emoji value at the specified index for a
+	// property "attributedTo". Existing elements at that index and higher
+	// are shifted back once. Invalidates all iterators.
+	InsertTootEmoji(idx int, v TootEmoji)
 	// PrependType prepends an arbitrary type value to the front of a list of
 	// the property "attributedTo". Invalidates all iterators. Returns an
 	// error if the type is not a valid one to set for this property.
@@ -1248,6 +1429,9 @@ type ActivityStreamsAttributedToProperty interface {
 	// PrependActivityStreamsGroup prepends a Group value to the front of a
 	// list of the property "attributedTo". Invalidates all iterators.
 	PrependActivityStreamsGroup(v ActivityStreamsGroup)
+	// PrependActivityStreamsHashtag prepends a Hashtag value to the front of
+	// a list of the property "attributedTo". Invalidates all iterators.
+	PrependActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// PrependActivityStreamsIgnore prepends a Ignore value to the front of a
 	// list of the property "attributedTo". Invalidates all iterators.
 	PrependActivityStreamsIgnore(v ActivityStreamsIgnore)
@@ -1360,9 +1544,34 @@ type ActivityStreamsAttributedToProperty interface {
 	// PrependActivityStreamsView prepends a View value to the front of a list
 	// of the property "attributedTo". Invalidates all iterators.
 	PrependActivityStreamsView(v ActivityStreamsView)
+	// PrependForgeFedCommit prepends a Commit value to the front of a list of
+	// the property "attributedTo". Invalidates all iterators.
+	PrependForgeFedCommit(v ForgeFedCommit)
+	// PrependForgeFedPatch prepends a Patch value to the front of a list of
+	// the property "attributedTo". Invalidates all iterators.
+	PrependForgeFedPatch(v ForgeFedPatch)
+	// PrependForgeFedPush prepends a Push value to the front of a list of the
+	// property "attributedTo". Invalidates all iterators.
+	PrependForgeFedPush(v ForgeFedPush)
+	// PrependForgeFedRepository prepends a Repository value to the front of a
+	// list of the property "attributedTo". Invalidates all iterators.
+	PrependForgeFedRepository(v ForgeFedRepository)
+	// PrependForgeFedTicket prepends a Ticket value to the front of a list of
+	// the property "attributedTo". Invalidates all iterators.
+	PrependForgeFedTicket(v ForgeFedTicket)
+	// PrependForgeFedTicketDependency prepends a TicketDependency value to
+	// the front of a list of the property "attributedTo". Invalidates all
+	// iterators.
+	PrependForgeFedTicketDependency(v ForgeFedTicketDependency)
 	// PrependIRI prepends an IRI value to the front of a list of the property
 	// "attributedTo".
 	PrependIRI(v *url.URL)
+	// PrependSchemaPropertyValue prepends a PropertyValue value to the front
+	// of a list of the property "attributedTo". Invalidates all iterators.
+	PrependSchemaPropertyValue(v SchemaPropertyValue)
+	// PrependTootEmoji prepends a Emoji value to the front of a list of the
+	// property "attributedTo". Invalidates all iterators.
+	PrependTootEmoji(v TootEmoji)
 	// PrependType prepends an arbitrary type value to the front of a list of
 	// the property "attributedTo". Invalidates all iterators. Returns an
 	// error if the type is not a valid one to set for this property.
@@ -1453,6 +1662,10 @@ type ActivityStreamsAttributedToProperty interface {
 	// for the property "attributedTo". Panics if the index is out of
 	// bounds. Invalidates all iterators.
 	SetActivityStreamsGroup(idx int, v ActivityStreamsGroup)
+	// SetActivityStreamsHashtag sets a Hashtag value to be at the specified
+	// index for the property "attributedTo". Panics if the index is out
+	// of bounds. Invalidates all iterators.
+	SetActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// SetActivityStreamsIgnore sets a Ignore value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
 	// of bounds. Invalidates all iterators.
@@ -1593,9 +1806,41 @@ type ActivityStreamsAttributedToProperty interface {
 	// for the property "attributedTo". Panics if the index is out of
 	// bounds. Invalidates all iterators.
 	SetActivityStreamsView(idx int, v ActivityStreamsView)
+	// SetForgeFedCommit sets a Commit value to be at the specified index for
+	// the property "attributedTo". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetForgeFedCommit(idx int, v ForgeFedCommit)
+	// SetForgeFedPatch sets a Patch value to be at the specified index for
+	// the property "attributedTo". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetForgeFedPatch(idx int, v ForgeFedPatch)
+	// SetForgeFedPush sets a Push value to be at the specified index for the
+	// property "attributedTo". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetForgeFedPush(idx int, v ForgeFedPush)
+	// SetForgeFedRepository sets a Repository value to be at the specified
+	// index for the property "attributedTo". Panics if the index is out
+	// of bounds. Invalidates all iterators.
+	SetForgeFedRepository(idx int, v ForgeFedRepository)
+	// SetForgeFedTicket sets a Ticket value to be at the specified index for
+	// the property "attributedTo". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetForgeFedTicket(idx int, v ForgeFedTicket)
+	// SetForgeFedTicketDependency sets a TicketDependency value to be at the
+	// specified index for the property "attributedTo". Panics if the
+	// index is out of bounds. Invalidates all iterators.
+	SetForgeFedTicketDependency(idx int, v ForgeFedTicketDependency)
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "attributedTo". Panics if the index is out of bounds.
 	SetIRI(idx int, v *url.URL)
+	// SetSchemaPropertyValue sets a PropertyValue value to be at the
+	// specified index for the property "attributedTo". Panics if the
+	// index is out of bounds. Invalidates all iterators.
+	SetSchemaPropertyValue(idx int, v SchemaPropertyValue)
+	// SetTootEmoji sets a Emoji value to be at the specified index for the
+	// property "attributedTo". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetTootEmoji(idx int, v TootEmoji)
 	// SetType sets an arbitrary type value to the specified index of the
 	// property "attributedTo". Invalidates all iterators. Returns an
 	// error if the type is not a valid one to set for this property.
@@ -24,6 +24,12 @@ type ActivityStreamsDurationProperty interface {
 	// Clear ensures no value of this property is set. Calling
 	// IsXMLSchemaDuration afterwards will return false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be changed
+	// without changing this one.
+	Clone() ActivityStreamsDurationProperty
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsDurationProperty) bool
 	// Get returns the value of this property. When IsXMLSchemaDuration
 	// returns false, Get will return any arbitrary value.
 	Get() time.Duration
@@ -17,6 +17,12 @@ type ActivityStreamsHrefProperty interface {
 	// Clear ensures no value of this property is set. Calling
 	// IsXMLSchemaAnyURI afterwards will return false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be changed
+	// without changing this one.
+	Clone() ActivityStreamsHrefProperty
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsHrefProperty) bool
 	// Get returns the value of this property. When IsXMLSchemaAnyURI returns
 	// false, Get will return any arbitrary value.
 	Get() *url.URL
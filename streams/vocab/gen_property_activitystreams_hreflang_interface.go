@@ -19,6 +19,12 @@ type ActivityStreamsHreflangProperty interface {
 	// Clear ensures no value of this property is set. Calling IsRFCBcp47
 	// afterwards will return false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be changed
+	// without changing this one.
+	Clone() ActivityStreamsHreflangProperty
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsHreflangProperty) bool
 	// Get returns the value of this property. When IsRFCBcp47 returns false,
 	// Get will return any arbitrary value.
 	Get() string
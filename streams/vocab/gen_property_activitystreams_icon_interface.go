@@ -7,6 +7,13 @@ import "net/url"
 // ActivityStreamsIconPropertyIterator represents a single value for the "icon"
 // property.
 type ActivityStreamsIconPropertyIterator interface {
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsIconPropertyIterator) bool
+	// GetActivityStreamsHashtag returns the value of this property. When
+	// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag
+	// will return an arbitrary value.
+	GetActivityStreamsHashtag() ActivityStreamsHashtag
 	// GetActivityStreamsImage returns the value of this property. When
 	// IsActivityStreamsImage returns false, GetActivityStreamsImage will
 	// return an arbitrary value.
@@ -28,6 +35,10 @@ type ActivityStreamsIconPropertyIterator interface {
 	GetType() Type
 	// HasAny returns true if any of the different values is set.
 	HasAny() bool
+	// IsActivityStreamsHashtag returns true if this property has a type of
+	// "Hashtag". When true, use the GetActivityStreamsHashtag and
+	// SetActivityStreamsHashtag methods to access and set this property.
+	IsActivityStreamsHashtag() bool
 	// IsActivityStreamsImage returns true if this property has a type of
 	// "Image". When true, use the GetActivityStreamsImage and
 	// SetActivityStreamsImage methods to access and set this property.
@@ -64,6 +75,9 @@ type ActivityStreamsIconPropertyIterator interface {
 	// Prev returns the previous iterator, or nil if there is no previous
 	// iterator.
 	Prev() ActivityStreamsIconPropertyIterator
+	// SetActivityStreamsHashtag sets the value of this property. Calling
+	// IsActivityStreamsHashtag afterwards returns true.
+	SetActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// SetActivityStreamsImage sets the value of this property. Calling
 	// IsActivityStreamsImage afterwards returns true.
 	SetActivityStreamsImage(v ActivityStreamsImage)
@@ -122,6 +136,10 @@ type ActivityStreamsIconPropertyIterator interface {
 //     "type": "Note"
 //   }
 type ActivityStreamsIconProperty interface {
+	// AppendActivityStreamsHashtag appends a Hashtag value to the back of a
+	// list of the property "icon". Invalidates iterators that are
+	// traversing using Prev.
+	AppendActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// AppendActivityStreamsImage appends a Image value to the back of a list
 	// of the property "icon". Invalidates iterators that are traversing
 	// using Prev.
@@ -149,12 +167,22 @@ type ActivityStreamsIconProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsIconPropertyIterator
+	// Clone returns a deep copy of this property and its values, which can be
+	// changed without changing this one.
+	Clone() ActivityStreamsIconProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsIconPropertyIterator
+	// Equals determines whether this property has the same values as another
+	// in the same order, comparing any types they hold deeply.
+	Equals(o ActivityStreamsIconProperty) bool
+	// InsertActivityStreamsHashtag inserts a Hashtag value at the specified
+	// index for a property "icon". Existing elements at that index and
+	// higher are shifted back once. Invalidates all iterators.
+	InsertActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// InsertActivityStreamsImage inserts a Image value at the specified index
 	// for a property "icon". Existing elements at that index and higher
 	// are shifted back once. Invalidates all iterators.
@@ -197,6 +225,9 @@ type ActivityStreamsIconProperty interface {
 	LessThan(o ActivityStreamsIconProperty) bool
 	// Name returns the name of this property ("icon") with any alias.
 	Name() string
+	// PrependActivityStreamsHashtag prepends a Hashtag value to the front of
+	// a list of the property "icon". Invalidates all iterators.
+	PrependActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// PrependActivityStreamsImage prepends a Image value to the front of a
 	// list of the property "icon". Invalidates all iterators.
 	PrependActivityStreamsImage(v ActivityStreamsImage)
@@ -223,6 +254,10 @@ type ActivityStreamsIconProperty interface {
 	// instead of individual properties. It is exposed for alternatives to
 	// go-fed implementations to use.
 	Serialize() (interface{}, error)
+	// SetActivityStreamsHashtag sets a Hashtag value to be at the specified
+	// index for the property "icon". Panics if the index is out of
+	// bounds. Invalidates all iterators.
+	SetActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// SetActivityStreamsImage sets a Image value to be at the specified index
 	// for the property "icon". Panics if the index is out of bounds.
 	// Invalidates all iterators.
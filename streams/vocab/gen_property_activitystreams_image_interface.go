@@ -7,6 +7,13 @@ import "net/url"
 // ActivityStreamsImagePropertyIterator represents a single value for the "image"
 // property.
 type ActivityStreamsImagePropertyIterator interface {
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsImagePropertyIterator) bool
+	// GetActivityStreamsHashtag returns the value of this property. When
+	// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag
+	// will return an arbitrary value.
+	GetActivityStreamsHashtag() ActivityStreamsHashtag
 	// GetActivityStreamsImage returns the value of this property. When
 	// IsActivityStreamsImage returns false, GetActivityStreamsImage will
 	// return an arbitrary value.
@@ -28,6 +35,10 @@ type ActivityStreamsImagePropertyIterator interface {
 	GetType() Type
 	// HasAny returns true if any of the different values is set.
 	HasAny() bool
+	// IsActivityStreamsHashtag returns true if this property has a type of
+	// "Hashtag". When true, use the GetActivityStreamsHashtag and
+	// SetActivityStreamsHashtag methods to access and set this property.
+	IsActivityStreamsHashtag() bool
 	// IsActivityStreamsImage returns true if this property has a type of
 	// "Image". When true, use the GetActivityStreamsImage and
 	// SetActivityStreamsImage methods to access and set this property.
@@ -64,6 +75,9 @@ type ActivityStreamsImagePropertyIterator interface {
 	// Prev returns the previous iterator, or nil if there is no previous
 	// iterator.
 	Prev() ActivityStreamsImagePropertyIterator
+	// SetActivityStreamsHashtag sets the value of this property. Calling
+	// IsActivityStreamsHashtag afterwards returns true.
+	SetActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// SetActivityStreamsImage sets the value of this property. Calling
 	// IsActivityStreamsImage afterwards returns true.
 	SetActivityStreamsImage(v ActivityStreamsImage)
@@ -115,6 +129,10 @@ type ActivityStreamsImagePropertyIterator interface {
 //     "type": "Note"
 //   }
 type ActivityStreamsImageProperty interface {
+	// AppendActivityStreamsHashtag appends a Hashtag value to the back of a
+	// list of the property "image". Invalidates iterators that are
+	// traversing using Prev.
+	AppendActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// AppendActivityStreamsImage appends a Image value to the back of a list
 	// of the property "image". Invalidates iterators that are traversing
 	// using Prev.
@@ -142,12 +160,22 @@ type ActivityStreamsImageProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsImagePropertyIterator
+	// Clone returns a deep copy of this property and its values, which can be
+	// changed without changing this one.
+	Clone() ActivityStreamsImageProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsImagePropertyIterator
+	// Equals determines whether this property has the same values as another
+	// in the same order, comparing any types they hold deeply.
+	Equals(o ActivityStreamsImageProperty) bool
+	// InsertActivityStreamsHashtag inserts a Hashtag value at the specified
+	// index for a property "image". Existing elements at that index and
+	// higher are shifted back once. Invalidates all iterators.
+	InsertActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// InsertActivityStreamsImage inserts a Image value at the specified index
 	// for a property "image". Existing elements at that index and higher
 	// are shifted back once. Invalidates all iterators.
@@ -190,6 +218,9 @@ type ActivityStreamsImageProperty interface {
 	LessThan(o ActivityStreamsImageProperty) bool
 	// Name returns the name of this property ("image") with any alias.
 	Name() string
+	// PrependActivityStreamsHashtag prepends a Hashtag value to the front of
+	// a list of the property "image". Invalidates all iterators.
+	PrependActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// PrependActivityStreamsImage prepends a Image value to the front of a
 	// list of the property "image". Invalidates all iterators.
 	PrependActivityStreamsImage(v ActivityStreamsImage)
@@ -216,6 +247,10 @@ type ActivityStreamsImageProperty interface {
 	// instead of individual properties. It is exposed for alternatives to
 	// go-fed implementations to use.
 	Serialize() (interface{}, error)
+	// SetActivityStreamsHashtag sets a Hashtag value to be at the specified
+	// index for the property "image". Panics if the index is out of
+	// bounds. Invalidates all iterators.
+	SetActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// SetActivityStreamsImage sets a Image value to be at the specified index
 	// for the property "image". Panics if the index is out of bounds.
 	// Invalidates all iterators.
@@ -33,6 +33,12 @@ type ActivityStreamsInboxProperty interface {
 	// Clear ensures no value of this property is set. Calling HasAny or any
 	// of the 'Is' methods afterwards will return false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be changed
+	// without changing this one.
+	Clone() ActivityStreamsInboxProperty
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsInboxProperty) bool
 	// GetActivityStreamsOrderedCollection returns the value of this property.
 	// When IsActivityStreamsOrderedCollection returns false,
 	// GetActivityStreamsOrderedCollection will return an arbitrary value.
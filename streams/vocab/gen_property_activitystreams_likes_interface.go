@@ -9,6 +9,12 @@ type ActivityStreamsLikesProperty interface {
 	// Clear ensures no value of this property is set. Calling HasAny or any
 	// of the 'Is' methods afterwards will return false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be changed
+	// without changing this one.
+	Clone() ActivityStreamsLikesProperty
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsLikesProperty) bool
 	// GetActivityStreamsCollection returns the value of this property. When
 	// IsActivityStreamsCollection returns false,
 	// GetActivityStreamsCollection will return an arbitrary value.
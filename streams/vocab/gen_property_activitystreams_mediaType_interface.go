@@ -21,6 +21,12 @@ type ActivityStreamsMediaTypeProperty interface {
 	// Clear ensures no value of this property is set. Calling IsRFCRfc2045
 	// afterwards will return false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be changed
+	// without changing this one.
+	Clone() ActivityStreamsMediaTypeProperty
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsMediaTypeProperty) bool
 	// Get returns the value of this property. When IsRFCRfc2045 returns
 	// false, Get will return any arbitrary value.
 	Get() string
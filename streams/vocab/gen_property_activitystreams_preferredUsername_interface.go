@@ -11,6 +11,12 @@ type ActivityStreamsPreferredUsernameProperty interface {
 	// Calling HasAny or any of the 'Is' methods afterwards will return
 	// false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be changed
+	// without changing this one.
+	Clone() ActivityStreamsPreferredUsernameProperty
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsPreferredUsernameProperty) bool
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
 	// GetIRI will return an arbitrary value.
 	GetIRI() *url.URL
@@ -7,6 +7,9 @@ import "net/url"
 // ActivityStreamsRelPropertyIterator represents a single value for the "rel"
 // property.
 type ActivityStreamsRelPropertyIterator interface {
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsRelPropertyIterator) bool
 	// Get returns the value of this property. When IsRFCRfc5988 returns
 	// false, Get will return any arbitrary value.
 	Get() string
@@ -81,12 +84,18 @@ type ActivityStreamsRelProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsRelPropertyIterator
+	// Clone returns a deep copy of this property and its values, which can be
+	// changed without changing this one.
+	Clone() ActivityStreamsRelProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsRelPropertyIterator
+	// Equals determines whether this property has the same values as another
+	// in the same order, comparing any types they hold deeply.
+	Equals(o ActivityStreamsRelProperty) bool
 	// Insert inserts an IRI value at the specified index for a property
 	// "rel". Existing elements at that index and higher are shifted back
 	// once. Invalidates all iterators.
@@ -27,6 +27,12 @@ type ActivityStreamsStartIndexProperty interface {
 	// Clear ensures no value of this property is set. Calling
 	// IsXMLSchemaNonNegativeInteger afterwards will return false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be changed
+	// without changing this one.
+	Clone() ActivityStreamsStartIndexProperty
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsStartIndexProperty) bool
 	// Get returns the value of this property. When
 	// IsXMLSchemaNonNegativeInteger returns false, Get will return any
 	// arbitrary value.
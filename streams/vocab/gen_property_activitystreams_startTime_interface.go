@@ -22,6 +22,12 @@ type ActivityStreamsStartTimeProperty interface {
 	// Clear ensures no value of this property is set. Calling
 	// IsXMLSchemaDateTime afterwards will return false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be changed
+	// without changing this one.
+	Clone() ActivityStreamsStartTimeProperty
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsStartTimeProperty) bool
 	// Get returns the value of this property. When IsXMLSchemaDateTime
 	// returns false, Get will return any arbitrary value.
 	Get() time.Time
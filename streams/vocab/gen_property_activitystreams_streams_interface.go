@@ -7,6 +7,9 @@ import "net/url"
 // ActivityStreamsStreamsPropertyIterator represents a single value for the
 // "streams" property.
 type ActivityStreamsStreamsPropertyIterator interface {
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsStreamsPropertyIterator) bool
 	// GetActivityStreamsCollection returns the value of this property. When
 	// IsActivityStreamsCollection returns false,
 	// GetActivityStreamsCollection will return an arbitrary value.
@@ -134,12 +137,18 @@ type ActivityStreamsStreamsProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsStreamsPropertyIterator
+	// Clone returns a deep copy of this property and its values, which can be
+	// changed without changing this one.
+	Clone() ActivityStreamsStreamsProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsStreamsPropertyIterator
+	// Equals determines whether this property has the same values as another
+	// in the same order, comparing any types they hold deeply.
+	Equals(o ActivityStreamsStreamsProperty) bool
 	// InsertActivityStreamsCollection inserts a Collection value at the
 	// specified index for a property "streams". Existing elements at that
 	// index and higher are shifted back once. Invalidates all iterators.
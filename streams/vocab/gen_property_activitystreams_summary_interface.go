@@ -7,6 +7,9 @@ import "net/url"
 // ActivityStreamsSummaryPropertyIterator represents a single value for the
 // "summary" property.
 type ActivityStreamsSummaryPropertyIterator interface {
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o ActivityStreamsSummaryPropertyIterator) bool
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
 	// GetIRI will return an arbitrary value.
 	GetIRI() *url.URL
@@ -122,12 +125,18 @@ type ActivityStreamsSummaryProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsSummaryPropertyIterator
+	// Clone returns a deep copy of this property and its values, which can be
+	// changed without changing this one.
+	Clone() ActivityStreamsSummaryProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsSummaryPropertyIterator
+	// Equals determines whether this property has the same values as another
+	// in the same order, comparing any types they hold deeply.
+	Equals(o ActivityStreamsSummaryProperty) bool
 	// Insert inserts an IRI value at the specified index for a property
 	// "summary". Existing elements at that index and higher are shifted
 	// back once. Invalidates all iterators.
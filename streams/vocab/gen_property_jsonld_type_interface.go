@@ -6,6 +6,9 @@ import "net/url"
 
 // JSONLDTypePropertyIterator represents a single value for the "type" property.
 type JSONLDTypePropertyIterator interface {
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o JSONLDTypePropertyIterator) bool
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
 	// GetIRI will return an arbitrary value.
 	GetIRI() *url.URL
@@ -82,12 +85,18 @@ type JSONLDTypeProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() JSONLDTypePropertyIterator
+	// Clone returns a deep copy of this property and its values, which can be
+	// changed without changing this one.
+	Clone() JSONLDTypeProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() JSONLDTypePropertyIterator
+	// Equals determines whether this property has the same values as another
+	// in the same order, comparing any types they hold deeply.
+	Equals(o JSONLDTypeProperty) bool
 	// Insert inserts an IRI value at the specified index for a property
 	// "type". Existing elements at that index and higher are shifted back
 	// once. Invalidates all iterators.
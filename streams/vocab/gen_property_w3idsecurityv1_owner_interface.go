@@ -9,6 +9,12 @@ type W3IDSecurityV1OwnerProperty interface {
 	// Clear ensures no value of this property is set. Calling
 	// IsXMLSchemaAnyURI afterwards will return false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be changed
+	// without changing this one.
+	Clone() W3IDSecurityV1OwnerProperty
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o W3IDSecurityV1OwnerProperty) bool
 	// Get returns the value of this property. When IsXMLSchemaAnyURI returns
 	// false, Get will return any arbitrary value.
 	Get() *url.URL
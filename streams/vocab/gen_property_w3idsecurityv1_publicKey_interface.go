@@ -7,6 +7,9 @@ import "net/url"
 // W3IDSecurityV1PublicKeyPropertyIterator represents a single value for the
 // "publicKey" property.
 type W3IDSecurityV1PublicKeyPropertyIterator interface {
+	// Equals determines whether this property has the same value as another,
+	// comparing any types it holds deeply.
+	Equals(o W3IDSecurityV1PublicKeyPropertyIterator) bool
 	// Get returns the value of this property. When IsW3IDSecurityV1PublicKey
 	// returns false, Get will return any arbitrary value.
 	Get() W3IDSecurityV1PublicKey
@@ -79,12 +82,18 @@ type W3IDSecurityV1PublicKeyProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() W3IDSecurityV1PublicKeyPropertyIterator
+	// Clone returns a deep copy of this property and its values, which can be
+	// changed without changing this one.
+	Clone() W3IDSecurityV1PublicKeyProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() W3IDSecurityV1PublicKeyPropertyIterator
+	// Equals determines whether this property has the same values as another
+	// in the same order, comparing any types they hold deeply.
+	Equals(o W3IDSecurityV1PublicKeyProperty) bool
 	// Insert inserts an IRI value at the specified index for a property
 	// "publicKey". Existing elements at that index and higher are shifted
 	// back once. Invalidates all iterators.
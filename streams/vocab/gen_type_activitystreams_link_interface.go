@@ -19,6 +19,13 @@ package vocab
 //     "url": "http://example.org/abc"
 //   }
 type ActivityStreamsLink interface {
+	// Clone returns a deep copy of this Link and its properties, which can be
+	// changed without changing this one.
+	Clone() ActivityStreamsLink
+	// Equals determines whether this Link has the same properties as another,
+	// comparing them deeply. The @context of deserialized values is
+	// ignored.
+	Equals(o ActivityStreamsLink) bool
 	// GetActivityStreamsAttributedTo returns the "attributedTo" property if
 	// it exists, and nil otherwise.
 	GetActivityStreamsAttributedTo() ActivityStreamsAttributedToProperty
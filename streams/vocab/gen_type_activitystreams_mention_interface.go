@@ -12,6 +12,13 @@ package vocab
 //     "url": "http://example.org/joe"
 //   }
 type ActivityStreamsMention interface {
+	// Clone returns a deep copy of this Mention and its properties, which can
+	// be changed without changing this one.
+	Clone() ActivityStreamsMention
+	// Equals determines whether this Mention has the same properties as
+	// another, comparing them deeply. The @context of deserialized values
+	// is ignored.
+	Equals(o ActivityStreamsMention) bool
 	// GetActivityStreamsAttributedTo returns the "attributedTo" property if
 	// it exists, and nil otherwise.
 	GetActivityStreamsAttributedTo() ActivityStreamsAttributedToProperty
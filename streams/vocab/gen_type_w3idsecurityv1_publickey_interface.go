@@ -4,6 +4,13 @@ package vocab
 
 // A public key represents a public cryptographical key for a user
 type W3IDSecurityV1PublicKey interface {
+	// Clone returns a deep copy of this PublicKey and its properties, which
+	// can be changed without changing this one.
+	Clone() W3IDSecurityV1PublicKey
+	// Equals determines whether this PublicKey has the same properties as
+	// another, comparing them deeply. The @context of deserialized values
+	// is ignored.
+	Equals(o W3IDSecurityV1PublicKey) bool
 	// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
 	GetJSONLDId() JSONLDIdProperty
 	// GetTypeName returns the name of this type.